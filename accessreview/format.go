@@ -0,0 +1,59 @@
+package accessreview
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"time"
+)
+
+// csvHeader are the columns of WriteCSV(), named after the JSON attributes of an Entry.
+var csvHeader = []string{"appId", "appLabel", "userId", "login", "userStatus", "via", "groupId", "groupName", "since"}
+
+// WriteJSON writes the dataset to w as a JSON object.
+func (d *Dataset) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(d)
+}
+
+// WriteCSV writes the entries of the dataset to w as CSV, with a header row. Times are RFC 3339.
+func (d *Dataset) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, e := range d.Entries {
+		since := ""
+		if !e.Since.IsZero() {
+			since = e.Since.UTC().Format(time.RFC3339)
+		}
+		row := []string{e.AppID, e.AppLabel, e.UserID, e.Login, e.UserStatus, string(e.Via), e.GroupID, e.GroupName, since}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// sortEntries sorts entries by application, login, then path of access, so that the datasets of
+// two campaigns can be diffed.
+func sortEntries(entries []*Entry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		switch {
+		case a.AppLabel != b.AppLabel:
+			return a.AppLabel < b.AppLabel
+		case a.AppID != b.AppID:
+			return a.AppID < b.AppID
+		case a.Login != b.Login:
+			return a.Login < b.Login
+		case a.Via != b.Via:
+			return a.Via == ViaDirect
+		default:
+			return a.GroupName < b.GroupName
+		}
+	})
+}
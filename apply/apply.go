@@ -0,0 +1,249 @@
+// Package apply converges an Okta org to a desired state.
+//
+// The desired state is an export.Snapshot, usually a snapshot of another org or a hand edited
+// one. Plan() compares it to the live org and returns the changes needed to converge, which
+// Apply() makes. Groups, group rules, apps and network zones are managed.
+//
+// Resources are matched by name: groups by profile name, apps by label, and group rules and
+// network zones by name. Only the attributes set in the desired state are compared. The group
+// IDs referenced by desired group rules are translated to the IDs of the groups with the same
+// name in the org, so a snapshot of one org can be applied to another.
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/austinylin/go-okta/export"
+	"github.com/austinylin/go-okta/okta"
+)
+
+// ManagedResources is the list of the resources Apply can converge.
+var ManagedResources = []export.Resource{
+	export.ResourceGroups,
+	export.ResourceNetworkZones,
+	export.ResourceApps,
+	export.ResourceGroupRules,
+}
+
+// builtInApps are the apps every org has, which are never deleted.
+var builtInApps = map[okta.AppName]bool{
+	"saasure":             true,
+	"okta_enduser":        true,
+	"okta_browser_plugin": true,
+	"okta_flow_sso":       true,
+	"flow":                true,
+}
+
+// Applier converges an org to a desired state.
+type Applier struct {
+	Client *okta.Client
+	// Prune deletes the managed resources of the org that aren't in the desired state. Built in
+	// groups, apps and network zones are never deleted.
+	Prune bool
+	// DryRun makes Apply return the plan without making any change.
+	DryRun bool
+	// Checkpointer, if set, records the changes made by Apply. An interrupted Apply resumes by
+	// planning again, against an org in which the changes already made no longer show up, and
+	// returns them along with the new ones.
+	Checkpointer export.Checkpointer
+}
+
+// applyJob is the export.Checkpoint.Job of Apply.
+const applyJob = "apply"
+
+// NewApplier is a helper method to create a new Applier.
+func NewApplier(client *okta.Client) *Applier {
+	return &Applier{Client: client}
+}
+
+// Plan compares desired to the org, and returns the changes needed to converge.
+func (a *Applier) Plan(ctx context.Context, desired *export.Snapshot) (*Plan, error) {
+	live, err := a.live(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p := new(Plan)
+	if err := a.planGroups(p, desired, live); err != nil {
+		return nil, err
+	}
+	if err := a.planNetworkZones(p, desired, live); err != nil {
+		return nil, err
+	}
+	if err := a.planApps(p, desired, live); err != nil {
+		return nil, err
+	}
+	if err := a.planGroupRules(p, desired, live); err != nil {
+		return nil, err
+	}
+
+	p.Changes = p.orderedForApply()
+	return p, nil
+}
+
+// Apply converges the org to desired, and returns the plan it followed. If DryRun is set the plan
+// is returned without making any change.
+//
+// Changes are made in the order of the plan, and Apply stops at the first error. The returned
+// plan then holds the changes that were made.
+func (a *Applier) Apply(ctx context.Context, desired *export.Snapshot) (*Plan, error) {
+	p, err := a.Plan(ctx, desired)
+	if err != nil || a.DryRun {
+		return p, err
+	}
+
+	cp, done, err := a.resume(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Group rules reference groups by ID, which are only known once the groups exist.
+	groupIDs, err := a.groupIDsByName(ctx)
+	if err != nil {
+		return nil, err
+	}
+	desiredGroupNames := make(map[string]string)
+	for _, g := range desired.Groups {
+		desiredGroupNames[g.ID] = g.Profile.Name
+	}
+
+	for _, c := range p.Changes {
+		if c.Resource == export.ResourceGroupRules && c.Action != ActionDelete {
+			c.desired = translateGroupRule(c.desired.(*okta.GroupRule), desiredGroupNames, groupIDs)
+		}
+
+		id, err := a.apply(ctx, c)
+		if err != nil {
+			return done, fmt.Errorf("%s: %v", c, err)
+		}
+		done.Changes = append(done.Changes, c)
+
+		if c.Resource == export.ResourceGroups && c.Action == ActionCreate {
+			groupIDs[c.Name] = id
+		}
+
+		cp.LastID = id
+		if err := a.checkpoint(ctx, cp, done); err != nil {
+			return done, err
+		}
+	}
+
+	if a.Checkpointer != nil {
+		if err := a.Checkpointer.Clear(ctx); err != nil {
+			return done, err
+		}
+	}
+	return done, nil
+}
+
+// resume returns the checkpoint of an interrupted Apply and the changes it made, or a new
+// checkpoint and an empty plan if there is nothing to resume.
+func (a *Applier) resume(ctx context.Context) (*export.Checkpoint, *Plan, error) {
+	fresh := &export.Checkpoint{Job: applyJob}
+	if a.Checkpointer == nil {
+		return fresh, new(Plan), nil
+	}
+
+	cp, err := a.Checkpointer.Load(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cp == nil || cp.Job != applyJob {
+		return fresh, new(Plan), nil
+	}
+
+	done := new(Plan)
+	if err := json.Unmarshal(cp.State, &done.Changes); err != nil {
+		return nil, nil, fmt.Errorf("decoding the checkpoint state: %v", err)
+	}
+	return cp, done, nil
+}
+
+// checkpoint saves cp, with the changes made so far, if the Applier has a Checkpointer.
+func (a *Applier) checkpoint(ctx context.Context, cp *export.Checkpoint, done *Plan) error {
+	if a.Checkpointer == nil {
+		return nil
+	}
+
+	state, err := json.Marshal(done.Changes)
+	if err != nil {
+		return err
+	}
+	cp.State = state
+	cp.Updated = time.Now()
+	return a.Checkpointer.Save(ctx, cp)
+}
+
+// orderedForApply returns the changes with the deletions last, in the reverse order of the
+// dependencies: group rules, apps, network zones and groups.
+func (p *Plan) orderedForApply() []*Change {
+	var changes, deletions []*Change
+	for _, c := range p.Changes {
+		if c.Action == ActionDelete {
+			deletions = append(deletions, c)
+		} else {
+			changes = append(changes, c)
+		}
+	}
+
+	for i := len(ManagedResources) - 1; i >= 0; i-- {
+		for _, c := range deletions {
+			if c.Resource == ManagedResources[i] {
+				changes = append(changes, c)
+			}
+		}
+	}
+	return changes
+}
+
+// live exports the managed resources of the org.
+func (a *Applier) live(ctx context.Context) (*export.Snapshot, error) {
+	exporter := export.NewExporter(a.Client)
+	exporter.Resources = ManagedResources
+	return exporter.Export(ctx)
+}
+
+// groupIDsByName returns the IDs of the groups of the org by name.
+func (a *Applier) groupIDsByName(ctx context.Context) (map[string]string, error) {
+	page, _, err := a.Client.Groups.List(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	groups, _, err := page.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]string, len(groups))
+	for _, g := range groups {
+		ids[g.Profile.Name] = g.ID
+	}
+	return ids, nil
+}
+
+// translateGroupRule returns a copy of rule whose group IDs, those of the desired state, are
+// replaced by the IDs of the groups with the same name in the org.
+func translateGroupRule(rule *okta.GroupRule, desiredGroupNames, groupIDs map[string]string) *okta.GroupRule {
+	translate := func(ids []string) []string {
+		out := make([]string, 0, len(ids))
+		for _, id := range ids {
+			if liveID, ok := groupIDs[desiredGroupNames[id]]; ok {
+				id = liveID
+			}
+			out = append(out, id)
+		}
+		return out
+	}
+
+	r := *rule
+	r.Actions.AssignUserToGroups.GroupIDs = translate(rule.Actions.AssignUserToGroups.GroupIDs)
+	if rule.Conditions.People != nil {
+		people := *rule.Conditions.People
+		people.Groups.Exclude = translate(people.Groups.Exclude)
+		r.Conditions.People = &people
+	}
+	return &r
+}
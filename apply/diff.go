@@ -0,0 +1,58 @@
+package apply
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/austinylin/go-okta/export"
+)
+
+// ignoredAttributes are not compared, as they are either assigned by Okta or changed through
+// lifecycle operations rather than updates.
+var ignoredAttributes = map[string]bool{
+	"id":     true,
+	"status": true,
+}
+
+// diff returns the paths of the attributes of desired that differ from live. Attributes that
+// aren't set in desired are not compared, so that the desired state only needs to describe
+// what it manages.
+func diff(desired, live interface{}) ([]string, error) {
+	d, err := export.Normalize(desired)
+	if err != nil {
+		return nil, err
+	}
+	l, err := export.Normalize(live)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []string
+	diffValues("", d, l, &fields)
+	sort.Strings(fields)
+	return fields, nil
+}
+
+// diffValues appends the paths under path at which desired isn't a subset of live to fields.
+func diffValues(path string, desired, live interface{}, fields *[]string) {
+	desiredMap, ok := desired.(map[string]interface{})
+	if !ok {
+		if !reflect.DeepEqual(desired, live) {
+			*fields = append(*fields, path)
+		}
+		return
+	}
+
+	liveMap, _ := live.(map[string]interface{})
+	for k, v := range desiredMap {
+		if path == "" && ignoredAttributes[k] {
+			continue
+		}
+		childPath := k
+		if path != "" {
+			childPath = strings.Join([]string{path, k}, ".")
+		}
+		diffValues(childPath, v, liveMap[k], fields)
+	}
+}
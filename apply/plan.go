@@ -0,0 +1,79 @@
+package apply
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/austinylin/go-okta/export"
+)
+
+// Action is the type of a Change.
+type Action string
+
+// Action Constants
+const (
+	ActionCreate Action = "create"
+	ActionUpdate        = "update"
+	ActionDelete        = "delete"
+)
+
+// Change represents a single change needed to converge the org to the desired state.
+type Change struct {
+	Action   Action
+	Resource export.Resource
+	// Name is the name, or label, of the resource.
+	Name string
+	// ID is the ID of the resource in the org. It is empty for creations.
+	ID string
+	// Fields are the paths of the attributes that differ, for updates.
+	Fields []string
+
+	// desired is the desired resource, for creations and updates.
+	desired interface{}
+	// statusOnly is set for updates that only change the status of the resource.
+	statusOnly bool
+}
+
+func (c *Change) String() string {
+	symbol := map[Action]string{ActionCreate: "+", ActionUpdate: "~", ActionDelete: "-"}[c.Action]
+	s := fmt.Sprintf("%s %s %q", symbol, c.Resource, c.Name)
+	if c.ID != "" {
+		s += fmt.Sprintf(" (%s)", c.ID)
+	}
+	if len(c.Fields) > 0 {
+		s += ": " + strings.Join(c.Fields, ", ")
+	}
+	return s
+}
+
+// Plan represents the changes needed to converge the org to the desired state, in the order
+// they are applied.
+type Plan struct {
+	Changes []*Change
+}
+
+// Empty reports whether the org is already in the desired state.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// Write writes the plan in a human readable form, one change per line.
+func (p *Plan) Write(w io.Writer) error {
+	if p.Empty() {
+		_, err := fmt.Fprintln(w, "No changes. The org matches the desired state.")
+		return err
+	}
+
+	counts := make(map[Action]int)
+	for _, c := range p.Changes {
+		if _, err := fmt.Fprintln(w, c); err != nil {
+			return err
+		}
+		counts[c.Action]++
+	}
+
+	_, err := fmt.Fprintf(w, "\nPlan: %d to create, %d to update, %d to delete.\n",
+		counts[ActionCreate], counts[ActionUpdate], counts[ActionDelete])
+	return err
+}
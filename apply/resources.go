@@ -0,0 +1,339 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/austinylin/go-okta/export"
+	"github.com/austinylin/go-okta/okta"
+)
+
+// planChange appends the change converging live to desired, if any, to p. live is nil when the
+// resource doesn't exist in the org.
+func planChange(p *Plan, resource export.Resource, name string, desired, live interface{}, desiredStatus, liveStatus, liveID string) error {
+	if live == nil {
+		p.Changes = append(p.Changes, &Change{Action: ActionCreate, Resource: resource, Name: name, desired: desired})
+		return nil
+	}
+
+	fields, err := diff(desired, live)
+	if err != nil {
+		return err
+	}
+	statusOnly := len(fields) == 0
+	if desiredStatus != "" && desiredStatus != liveStatus {
+		fields = append(fields, "status")
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	p.Changes = append(p.Changes, &Change{
+		Action:     ActionUpdate,
+		Resource:   resource,
+		Name:       name,
+		ID:         liveID,
+		Fields:     fields,
+		desired:    desired,
+		statusOnly: statusOnly,
+	})
+	return nil
+}
+
+func (a *Applier) planGroups(p *Plan, desired, live *export.Snapshot) error {
+	liveByName := make(map[string]*okta.Group)
+	for _, g := range live.Groups {
+		liveByName[g.Profile.Name] = g
+	}
+
+	seen := make(map[string]bool)
+	for _, g := range desired.Groups {
+		// Only groups mastered in Okta can be managed.
+		if g.Type != "" && g.Type != okta.GroupTypeOkta {
+			continue
+		}
+		seen[g.Profile.Name] = true
+
+		l, ok := liveByName[g.Profile.Name]
+		if !ok {
+			if err := planChange(p, export.ResourceGroups, g.Profile.Name, g, nil, "", "", ""); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := planChange(p, export.ResourceGroups, g.Profile.Name, g, l, "", "", l.ID); err != nil {
+			return err
+		}
+	}
+
+	if a.Prune {
+		for _, g := range live.Groups {
+			if !seen[g.Profile.Name] && g.Type == okta.GroupTypeOkta {
+				p.Changes = append(p.Changes, &Change{Action: ActionDelete, Resource: export.ResourceGroups, Name: g.Profile.Name, ID: g.ID})
+			}
+		}
+	}
+	return nil
+}
+
+func (a *Applier) planNetworkZones(p *Plan, desired, live *export.Snapshot) error {
+	liveByName := make(map[string]*okta.NetworkZone)
+	for _, z := range live.NetworkZones {
+		liveByName[z.Name] = z
+	}
+
+	seen := make(map[string]bool)
+	for _, z := range desired.NetworkZones {
+		seen[z.Name] = true
+
+		l, ok := liveByName[z.Name]
+		if !ok {
+			if err := planChange(p, export.ResourceNetworkZones, z.Name, z, nil, "", "", ""); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := planChange(p, export.ResourceNetworkZones, z.Name, z, l, z.Status, l.Status, l.ID); err != nil {
+			return err
+		}
+	}
+
+	if a.Prune {
+		for _, z := range live.NetworkZones {
+			if !seen[z.Name] && !z.System {
+				p.Changes = append(p.Changes, &Change{Action: ActionDelete, Resource: export.ResourceNetworkZones, Name: z.Name, ID: z.ID})
+			}
+		}
+	}
+	return nil
+}
+
+func (a *Applier) planApps(p *Plan, desired, live *export.Snapshot) error {
+	liveByLabel := make(map[string]*okta.App)
+	for _, app := range live.Apps {
+		liveByLabel[app.Label] = app
+	}
+
+	seen := make(map[string]bool)
+	for _, app := range desired.Apps {
+		if builtInApps[app.Name] {
+			continue
+		}
+		seen[app.Label] = true
+
+		l, ok := liveByLabel[app.Label]
+		if !ok {
+			if err := planChange(p, export.ResourceApps, app.Label, app, nil, "", "", ""); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := planChange(p, export.ResourceApps, app.Label, app, l, string(app.Status), string(l.Status), l.ID); err != nil {
+			return err
+		}
+	}
+
+	if a.Prune {
+		for _, app := range live.Apps {
+			if !seen[app.Label] && !builtInApps[app.Name] {
+				p.Changes = append(p.Changes, &Change{Action: ActionDelete, Resource: export.ResourceApps, Name: app.Label, ID: app.ID})
+			}
+		}
+	}
+	return nil
+}
+
+func (a *Applier) planGroupRules(p *Plan, desired, live *export.Snapshot) error {
+	liveByName := make(map[string]*okta.GroupRule)
+	for _, r := range live.GroupRules {
+		liveByName[r.Name] = r
+	}
+
+	desiredGroupNames := make(map[string]string)
+	for _, g := range desired.Groups {
+		desiredGroupNames[g.ID] = g.Profile.Name
+	}
+	liveGroupIDs := make(map[string]string)
+	for _, g := range live.Groups {
+		liveGroupIDs[g.Profile.Name] = g.ID
+	}
+
+	seen := make(map[string]bool)
+	for _, r := range desired.GroupRules {
+		seen[r.Name] = true
+		r = translateGroupRule(r, desiredGroupNames, liveGroupIDs)
+
+		l, ok := liveByName[r.Name]
+		if !ok {
+			if err := planChange(p, export.ResourceGroupRules, r.Name, r, nil, "", "", ""); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := planChange(p, export.ResourceGroupRules, r.Name, r, l, r.Status, l.Status, l.ID); err != nil {
+			return err
+		}
+	}
+
+	if a.Prune {
+		for _, r := range live.GroupRules {
+			if !seen[r.Name] {
+				p.Changes = append(p.Changes, &Change{Action: ActionDelete, Resource: export.ResourceGroupRules, Name: r.Name, ID: r.ID})
+			}
+		}
+	}
+	return nil
+}
+
+// apply makes a single change, and returns the ID of the resource.
+func (a *Applier) apply(ctx context.Context, c *Change) (string, error) {
+	switch c.Resource {
+	case export.ResourceGroups:
+		return a.applyGroup(ctx, c)
+	case export.ResourceNetworkZones:
+		return a.applyNetworkZone(ctx, c)
+	case export.ResourceApps:
+		return a.applyApp(ctx, c)
+	case export.ResourceGroupRules:
+		return a.applyGroupRule(ctx, c)
+	}
+	return "", fmt.Errorf("unsupported resource %q", c.Resource)
+}
+
+func (a *Applier) applyGroup(ctx context.Context, c *Change) (string, error) {
+	switch c.Action {
+	case ActionCreate:
+		g := c.desired.(*okta.Group)
+		created, _, err := a.Client.Groups.Add(ctx, &g.Profile)
+		if err != nil {
+			return "", err
+		}
+		return created.ID, nil
+	case ActionUpdate:
+		g := c.desired.(*okta.Group)
+		_, _, err := a.Client.Groups.Update(ctx, c.ID, &g.Profile)
+		return c.ID, err
+	default:
+		_, err := a.Client.Groups.Remove(ctx, c.ID)
+		return c.ID, err
+	}
+}
+
+func (a *Applier) applyNetworkZone(ctx context.Context, c *Change) (string, error) {
+	switch c.Action {
+	case ActionCreate:
+		z := *c.desired.(*okta.NetworkZone)
+		z.ID = ""
+		created, _, err := a.Client.NetworkZones.Add(ctx, &z)
+		if err != nil {
+			return "", err
+		}
+		if z.Status == "INACTIVE" {
+			_, _, err = a.Client.NetworkZones.Deactivate(ctx, created.ID)
+		}
+		return created.ID, err
+	case ActionUpdate:
+		z := *c.desired.(*okta.NetworkZone)
+		z.ID = c.ID
+		if !c.statusOnly {
+			if _, _, err := a.Client.NetworkZones.Update(ctx, c.ID, &z); err != nil {
+				return c.ID, err
+			}
+		}
+		var err error
+		switch z.Status {
+		case "ACTIVE":
+			_, _, err = a.Client.NetworkZones.Activate(ctx, c.ID)
+		case "INACTIVE":
+			_, _, err = a.Client.NetworkZones.Deactivate(ctx, c.ID)
+		}
+		return c.ID, err
+	default:
+		if _, _, err := a.Client.NetworkZones.Deactivate(ctx, c.ID); err != nil {
+			return c.ID, err
+		}
+		_, err := a.Client.NetworkZones.Remove(ctx, c.ID)
+		return c.ID, err
+	}
+}
+
+func (a *Applier) applyApp(ctx context.Context, c *Change) (string, error) {
+	switch c.Action {
+	case ActionCreate:
+		app := *c.desired.(*okta.App)
+		app.ID = ""
+		created, _, err := a.Client.Apps.Add(ctx, &app, app.Status != "INACTIVE")
+		if err != nil {
+			return "", err
+		}
+		return created.ID, nil
+	case ActionUpdate:
+		app := *c.desired.(*okta.App)
+		app.ID = c.ID
+		if !c.statusOnly {
+			if _, _, err := a.Client.Apps.Update(ctx, c.ID, &app); err != nil {
+				return c.ID, err
+			}
+		}
+		var err error
+		switch app.Status {
+		case "ACTIVE":
+			_, err = a.Client.Apps.Activate(ctx, c.ID)
+		case "INACTIVE":
+			_, err = a.Client.Apps.Deactivate(ctx, c.ID)
+		}
+		return c.ID, err
+	default:
+		if _, err := a.Client.Apps.Deactivate(ctx, c.ID); err != nil {
+			return c.ID, err
+		}
+		_, err := a.Client.Apps.Remove(ctx, c.ID)
+		return c.ID, err
+	}
+}
+
+func (a *Applier) applyGroupRule(ctx context.Context, c *Change) (string, error) {
+	switch c.Action {
+	case ActionCreate:
+		r := *c.desired.(*okta.GroupRule)
+		r.ID, r.Status = "", ""
+		created, _, err := a.Client.Groups.AddRule(ctx, &r)
+		if err != nil {
+			return "", err
+		}
+		if c.desired.(*okta.GroupRule).Status != "INACTIVE" {
+			_, err = a.Client.Groups.ActivateRule(ctx, created.ID)
+		}
+		return created.ID, err
+	case ActionUpdate:
+		r := *c.desired.(*okta.GroupRule)
+		desiredStatus := r.Status
+		r.ID, r.Status = c.ID, ""
+
+		// Active group rules can't be modified.
+		if !c.statusOnly {
+			if _, err := a.Client.Groups.DeactivateRule(ctx, c.ID); err != nil {
+				return c.ID, err
+			}
+			if _, _, err := a.Client.Groups.UpdateRule(ctx, c.ID, &r); err != nil {
+				return c.ID, err
+			}
+		}
+		var err error
+		switch desiredStatus {
+		case "INACTIVE":
+			if c.statusOnly {
+				_, err = a.Client.Groups.DeactivateRule(ctx, c.ID)
+			}
+		default:
+			_, err = a.Client.Groups.ActivateRule(ctx, c.ID)
+		}
+		return c.ID, err
+	default:
+		if _, err := a.Client.Groups.DeactivateRule(ctx, c.ID); err != nil {
+			return c.ID, err
+		}
+		_, err := a.Client.Groups.RemoveRule(ctx, c.ID, false)
+		return c.ID, err
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/austinylin/go-okta/okta"
+)
+
+func appsAssign(ctx context.Context, client *okta.Client, args []string, stdout io.Writer) error {
+	fs, format := newFlagSet("apps assign")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		return errors.New("usage: okta apps assign <app id> <user id>")
+	}
+
+	appUser, _, err := client.Apps.AssignUser(ctx, fs.Arg(0), fs.Arg(1), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	t := &table{
+		header: []string{"APP ID", "USER ID", "STATUS", "SCOPE", "USERNAME"},
+		rows:   [][]string{{fs.Arg(0), appUser.ID, appUser.Status, appUser.Scope, appUser.Credentials.UserName}},
+	}
+	return write(stdout, *format, appUser, t)
+}
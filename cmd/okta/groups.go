@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/austinylin/go-okta/okta"
+)
+
+func groupsAddMember(ctx context.Context, client *okta.Client, args []string, stdout io.Writer) error {
+	fs, format := newFlagSet("groups add-member")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		return errors.New("usage: okta groups add-member <group id> <user id>")
+	}
+	groupID, userID := fs.Arg(0), fs.Arg(1)
+
+	if _, err := client.Groups.AddUser(ctx, groupID, userID); err != nil {
+		return err
+	}
+
+	result := map[string]string{"groupId": groupID, "userId": userID}
+	t := &table{
+		header: []string{"GROUP ID", "USER ID"},
+		rows:   [][]string{{groupID, userID}},
+	}
+	return write(stdout, *format, result, t)
+}
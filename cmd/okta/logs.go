@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/austinylin/go-okta/okta"
+)
+
+func logsTail(ctx context.Context, client *okta.Client, args []string, stdout io.Writer) error {
+	fs, format := newFlagSet("logs tail")
+	since := fs.Duration("since", 15*time.Minute, "how far back to start")
+	filter := fs.String("filter", "", "filter expression, e.g. 'eventType eq \"user.session.start\"'")
+	interval := fs.Duration("interval", 10*time.Second, "how often to poll for new events")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	out, err := newEventWriter(stdout, *format)
+	if err != nil {
+		return err
+	}
+
+	poller := okta.NewLogPoller(client, &okta.LogListParams{
+		Since:  time.Now().Add(-*since),
+		Filter: *filter,
+	})
+	poller.Interval = *interval
+
+	err = poller.Run(ctx, func(events []*okta.LogEvent) error {
+		for _, e := range events {
+			if err := out(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// newEventWriter returns a function writing log events as they arrive, one per line for json
+// and csv, and as fixed width columns for table.
+func newEventWriter(w io.Writer, format string) (func(*okta.LogEvent) error, error) {
+	row := func(e *okta.LogEvent) []string {
+		outcome := ""
+		if e.Outcome != nil {
+			outcome = e.Outcome.Result
+		}
+		return []string{e.Published.Format(time.RFC3339), e.EventType, e.Actor.AlternateID, outcome, e.DisplayMessage}
+	}
+	header := []string{"PUBLISHED", "EVENT TYPE", "ACTOR", "OUTCOME", "MESSAGE"}
+
+	switch format {
+	case formatJSON:
+		enc := json.NewEncoder(w)
+		return func(e *okta.LogEvent) error { return enc.Encode(e) }, nil
+	case formatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(header); err != nil {
+			return nil, err
+		}
+		cw.Flush()
+		return func(e *okta.LogEvent) error {
+			cw.Write(row(e))
+			cw.Flush()
+			return cw.Error()
+		}, nil
+	case formatTable:
+		return func(e *okta.LogEvent) error {
+			r := row(e)
+			_, err := fmt.Fprintf(w, "%s  %-40s  %-30s  %-8s  %s\n", r[0], r[1], r[2], r[3], r[4])
+			return err
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q", format)
+	}
+}
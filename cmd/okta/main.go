@@ -0,0 +1,189 @@
+// Command okta is a command line client for the Okta API built on the go-okta library.
+//
+// Usage:
+//
+//	okta <resource> <action> [flags]
+//
+// Commands:
+//
+//	users list [-q query] [-filter expr] [-search expr] [-sort login|email|created]
+//	users get <id or login>
+//	users create -login login -email email -first-name name -last-name name [-activate]
+//	groups add-member <group id> <user id>
+//	apps assign <app id> <user id>
+//	logs tail [-since duration] [-filter expr] [-interval duration]
+//
+// Every command accepts -o to select the output format: table (the default), json or csv.
+//
+// The API token and base URL are read from the OKTA_API_TOKEN and OKTA_BASE_URL environment
+// variables. Values that aren't set are read from a JSON config file, by default
+// go-okta/config.json in the user config directory, or the file named by OKTA_CONFIG:
+//
+//	{"baseUrl": "https://example.okta.com/", "apiToken": "..."}
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/austinylin/go-okta/okta"
+)
+
+const usage = `Usage: okta <resource> <action> [flags]
+
+Commands:
+  users list [-q query] [-filter expr] [-search expr] [-sort login|email|created]
+  users get <id or login>
+  users create -login login -email email -first-name name -last-name name [-activate]
+  groups add-member <group id> <user id>
+  apps assign <app id> <user id>
+  logs tail [-since duration] [-filter expr] [-interval duration]
+
+Every command accepts -o table|json|csv.
+
+Credentials are read from OKTA_API_TOKEN and OKTA_BASE_URL, or from the
+config file named by OKTA_CONFIG (default: <user config dir>/go-okta/config.json).
+`
+
+// requestTimeout is the time limit of each request of the CLI.
+const requestTimeout = time.Minute
+
+// command is a single <resource> <action> of the CLI.
+type command func(ctx context.Context, client *okta.Client, args []string, stdout io.Writer) error
+
+var commands = map[string]map[string]command{
+	"users": {
+		"list":   usersList,
+		"get":    usersGet,
+		"create": usersCreate,
+	},
+	"groups": {
+		"add-member": groupsAddMember,
+	},
+	"apps": {
+		"assign": appsAssign,
+	},
+	"logs": {
+		"tail": logsTail,
+	},
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "okta:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	if len(args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		return errors.New("missing command")
+	}
+
+	cmd, ok := commands[args[0]][args[1]]
+	if !ok {
+		fmt.Fprint(os.Stderr, usage)
+		return fmt.Errorf("unknown command %q", strings.Join(args[:2], " "))
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	client, err := okta.NewClient(cfg.APIToken, cfg.BaseURL, nil)
+	if err != nil {
+		return err
+	}
+	client.Timeout = requestTimeout
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	return cmd(ctx, client, args[2:], stdout)
+}
+
+// config holds the credentials of the CLI.
+type config struct {
+	BaseURL  string `json:"baseUrl"`
+	APIToken string `json:"apiToken"`
+}
+
+// loadConfig reads the credentials from the environment, falling back to the config file.
+func loadConfig() (*config, error) {
+	cfg := &config{
+		BaseURL:  os.Getenv("OKTA_BASE_URL"),
+		APIToken: os.Getenv("OKTA_API_TOKEN"),
+	}
+
+	if cfg.BaseURL == "" || cfg.APIToken == "" {
+		path, err := configPath()
+		if err != nil {
+			return nil, err
+		}
+
+		fileCfg := new(config)
+		data, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if err == nil {
+			if err := json.Unmarshal(data, fileCfg); err != nil {
+				return nil, fmt.Errorf("reading %s: %v", path, err)
+			}
+		}
+
+		if cfg.BaseURL == "" {
+			cfg.BaseURL = fileCfg.BaseURL
+		}
+		if cfg.APIToken == "" {
+			cfg.APIToken = fileCfg.APIToken
+		}
+	}
+
+	if cfg.BaseURL == "" || cfg.APIToken == "" {
+		return nil, errors.New("no credentials, set OKTA_BASE_URL and OKTA_API_TOKEN or create a config file")
+	}
+
+	cfg.BaseURL = normalizeBaseURL(cfg.BaseURL)
+	return cfg, nil
+}
+
+// configPath returns the path of the config file.
+func configPath() (string, error) {
+	if path := os.Getenv("OKTA_CONFIG"); path != "" {
+		return path, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "go-okta", "config.json"), nil
+}
+
+// normalizeBaseURL accepts an org URL such as https://example.okta.com and returns the API base
+// URL expected by okta.NewClient, e.g. https://example.okta.com/api/v1/.
+func normalizeBaseURL(baseURL string) string {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	if !strings.HasSuffix(baseURL, "/api/v1") {
+		baseURL += "/api/v1"
+	}
+	return baseURL + "/"
+}
+
+// newFlagSet creates the flag set of a command, with the shared -o flag.
+func newFlagSet(name string) (*flag.FlagSet, *string) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	format := fs.String("o", formatTable, "output format: table, json or csv")
+	return fs, format
+}
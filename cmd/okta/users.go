@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/austinylin/go-okta/okta"
+)
+
+func usersList(ctx context.Context, client *okta.Client, args []string, stdout io.Writer) error {
+	fs, format := newFlagSet("users list")
+	params := new(okta.UserListParams)
+	fs.StringVar(&params.Q, "q", "", "match the beginning of login, name or email")
+	fs.StringVar(&params.Filter, "filter", "", "filter expression, e.g. 'status eq \"ACTIVE\"'")
+	fs.StringVar(&params.Search, "search", "", "search expression, e.g. 'profile.department eq \"Engineering\"'")
+	sortBy := fs.String("sort", "", "sort by login, email or created")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	opts := &okta.CollectOptions[*okta.User]{Key: func(u *okta.User) string { return u.ID }}
+	switch *sortBy {
+	case "":
+	case "login":
+		opts.Less = func(a, b *okta.User) bool { return a.Profile.Login < b.Profile.Login }
+	case "email":
+		opts.Less = func(a, b *okta.User) bool { return a.Profile.Email < b.Profile.Email }
+	case "created":
+		opts.Less = func(a, b *okta.User) bool { return a.Created.Before(b.Created) }
+	default:
+		return fmt.Errorf("invalid -sort %q, must be login, email or created", *sortBy)
+	}
+
+	users, _, err := okta.Collect(ctx, func(ctx context.Context) (*okta.Collection[*okta.User], *okta.Response, error) {
+		return client.Users.List(ctx, params)
+	}, opts)
+	if err != nil {
+		return err
+	}
+
+	return write(stdout, *format, users, usersTable(users...))
+}
+
+func usersGet(ctx context.Context, client *okta.Client, args []string, stdout io.Writer) error {
+	fs, format := newFlagSet("users get")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("usage: okta users get <id or login>")
+	}
+
+	// The get user endpoint accepts a login in place of the ID.
+	user, _, err := client.Users.GetByID(ctx, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	return write(stdout, *format, user, usersTable(user))
+}
+
+func usersCreate(ctx context.Context, client *okta.Client, args []string, stdout io.Writer) error {
+	fs, format := newFlagSet("users create")
+	profile := new(okta.UserProfile)
+	fs.StringVar(&profile.Login, "login", "", "login (required)")
+	fs.StringVar(&profile.Email, "email", "", "email (required)")
+	fs.StringVar(&profile.FirstName, "first-name", "", "first name (required)")
+	fs.StringVar(&profile.LastName, "last-name", "", "last name (required)")
+	activate := fs.Bool("activate", false, "activate the user and send the activation email")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if profile.Login == "" || profile.Email == "" || profile.FirstName == "" || profile.LastName == "" {
+		return errors.New("-login, -email, -first-name and -last-name are required")
+	}
+
+	user, _, err := client.Users.Add(ctx, profile, *activate)
+	if err != nil {
+		return err
+	}
+
+	return write(stdout, *format, user, usersTable(user))
+}
+
+func usersTable(users ...*okta.User) *table {
+	t := &table{header: []string{"ID", "STATUS", "LOGIN", "FIRST NAME", "LAST NAME", "EMAIL"}}
+	for _, u := range users {
+		t.rows = append(t.rows, []string{u.ID, u.Status, u.Profile.Login, u.Profile.FirstName, u.Profile.LastName, u.Profile.Email})
+	}
+	return t
+}
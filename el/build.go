@@ -0,0 +1,136 @@
+package el
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Expr is an expression, built with the functions of this package.
+type Expr string
+
+func (e Expr) String() string {
+	return string(e)
+}
+
+// Attr returns a reference to an attribute, e.g. Attr("user.department").
+func Attr(path string) Expr {
+	return Expr(path)
+}
+
+// Quote returns the string literal of s.
+func Quote(s string) Expr {
+	return Expr(`"` + strings.Replace(s, `"`, `""`, -1) + `"`)
+}
+
+// Bool returns the literal of b.
+func Bool(b bool) Expr {
+	return Expr(strconv.FormatBool(b))
+}
+
+// Int returns the literal of n.
+func Int(n int) Expr {
+	return Expr(strconv.Itoa(n))
+}
+
+// Eq returns a == b.
+func Eq(a, b Expr) Expr {
+	return binary(a, "==", b)
+}
+
+// Ne returns a != b.
+func Ne(a, b Expr) Expr {
+	return binary(a, "!=", b)
+}
+
+// Lt returns a < b.
+func Lt(a, b Expr) Expr {
+	return binary(a, "<", b)
+}
+
+// Le returns a <= b.
+func Le(a, b Expr) Expr {
+	return binary(a, "<=", b)
+}
+
+// Gt returns a > b.
+func Gt(a, b Expr) Expr {
+	return binary(a, ">", b)
+}
+
+// Ge returns a >= b.
+func Ge(a, b Expr) Expr {
+	return binary(a, ">=", b)
+}
+
+// And returns the conjunction of exprs, parenthesized when there are several.
+func And(exprs ...Expr) Expr {
+	return join(exprs, " AND ")
+}
+
+// Or returns the disjunction of exprs, parenthesized when there are several.
+func Or(exprs ...Expr) Expr {
+	return join(exprs, " OR ")
+}
+
+// Not returns the negation of e.
+func Not(e Expr) Expr {
+	return Expr("!(" + e + ")")
+}
+
+// If returns the conditional expression cond ? then : otherwise.
+func If(cond, then, otherwise Expr) Expr {
+	return Expr("(" + cond + " ? " + then + " : " + otherwise + ")")
+}
+
+// List returns the list literal of exprs.
+func List(exprs ...Expr) Expr {
+	return Expr("{" + string(join(exprs, ", ")) + "}")
+}
+
+// Call returns a call of the function fn, e.g. Call("String.toLowerCase", Attr("user.email")).
+func Call(fn string, args ...Expr) Expr {
+	parts := make([]string, len(args))
+	for i, a := range args {
+		parts[i] = string(a)
+	}
+	return Expr(fn + "(" + strings.Join(parts, ", ") + ")")
+}
+
+// StringContains returns String.stringContains(e, substring).
+func StringContains(e Expr, substring string) Expr {
+	return Call("String.stringContains", e, Quote(substring))
+}
+
+// IsMemberOfGroup returns isMemberOfGroup(groupID).
+func IsMemberOfGroup(groupID string) Expr {
+	return Call("isMemberOfGroup", Quote(groupID))
+}
+
+// IsMemberOfGroupName returns isMemberOfGroupName(name).
+func IsMemberOfGroupName(name string) Expr {
+	return Call("isMemberOfGroupName", Quote(name))
+}
+
+// IsMemberOfAnyGroup returns isMemberOfAnyGroup(groupIDs...).
+func IsMemberOfAnyGroup(groupIDs ...string) Expr {
+	args := make([]Expr, len(groupIDs))
+	for i, id := range groupIDs {
+		args[i] = Quote(id)
+	}
+	return Call("isMemberOfAnyGroup", args...)
+}
+
+func binary(a Expr, op string, b Expr) Expr {
+	return Expr(a + " " + Expr(op) + " " + b)
+}
+
+func join(exprs []Expr, sep string) Expr {
+	parts := make([]string, len(exprs))
+	for i, e := range exprs {
+		parts[i] = string(e)
+	}
+	if len(parts) < 2 || sep == ", " {
+		return Expr(strings.Join(parts, sep))
+	}
+	return Expr("(" + strings.Join(parts, sep) + ")")
+}
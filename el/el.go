@@ -0,0 +1,199 @@
+// Package el builds and lints Okta Expression Language expressions, as used in group rules,
+// username templates, profile mappings and token claims.
+//
+// Okta only reports a broken expression once it is deployed, and sometimes not even then: a
+// group rule with an unknown function is saved, and silently never matches. Lint catches
+// unbalanced parentheses, unterminated strings, unknown functions, wrong numbers of arguments
+// and references to attributes that aren't available where the expression is used:
+//
+//	if err := el.Validate(`String.stringContains(user.department, "Eng"`, el.ContextGroupRule); err != nil {
+//		// el: 1:45: missing ")" to close "(" at 1:22
+//	}
+//
+// The builder functions produce well formed expressions, quoting string literals as needed:
+//
+//	expr := el.And(el.Eq(el.Attr("user.department"), el.Quote("Engineering")), el.IsMemberOfGroupName("Staff"))
+//
+// Okta has no API to evaluate an expression, so Eval and MatchGroupRule approximate it locally,
+// to test a group rule against sample users before activating it:
+//
+//	ok, err := el.MatchGroupRule(rule, user, groups)
+//
+// EvaluateGroupRule applies it to the users of an org, listed with a search filter derived from
+// the expression, e.g. to replace a rule with a static group before retiring it:
+//
+//	evaluation, err := el.EvaluateGroupRule(ctx, client, rule)
+//	if err != nil {
+//		return err
+//	}
+//	group, result, err := evaluation.Materialize(ctx, client, &okta.GroupProfile{Name: "Engineering"})
+//
+// https://developer.okta.com/docs/reference/okta-expression-language/
+package el
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Context is where an expression is used, which determines the attributes it can reference.
+type Context string
+
+// Context Constants
+const (
+	// ContextAny accepts every attribute reference.
+	ContextAny Context = "any"
+	// ContextGroupRule is the expression of a group rule, which can only reference the user.
+	ContextGroupRule = "groupRule"
+	// ContextUserNameTemplate is the username template of an application.
+	ContextUserNameTemplate = "userNameTemplate"
+	// ContextProfileMapping is an attribute of a profile mapping, or of an Identity Provider.
+	ContextProfileMapping = "profileMapping"
+	// ContextClaim is the value of a claim of an authorization server.
+	ContextClaim = "claim"
+)
+
+// contextRoots are the attribute references each Context can start with.
+var contextRoots = map[Context][]string{
+	ContextGroupRule:        {"user"},
+	ContextUserNameTemplate: {"user", "app", "org"},
+	ContextProfileMapping:   {"user", "appuser", "idpuser", "source", "app", "org"},
+	ContextClaim:            {"user", "app", "org", "access", "session", "appuser"},
+}
+
+// arity is the number of arguments of a function, max being -1 for variadic functions.
+type arity struct{ min, max int }
+
+// functions are the functions of the Expression Language, by class. The functions without a
+// class are under "".
+//
+// https://developer.okta.com/docs/reference/okta-expression-language/#functions
+var functions = map[string]map[string]arity{
+	"String": {
+		"append":          {2, 2},
+		"join":            {1, -1},
+		"len":             {1, 1},
+		"removeSpaces":    {1, 1},
+		"replace":         {3, 3},
+		"replaceFirst":    {3, 3},
+		"stringContains":  {2, 2},
+		"stringSwitch":    {3, -1},
+		"substring":       {3, 3},
+		"substringAfter":  {2, 2},
+		"substringBefore": {2, 2},
+		"toLowerCase":     {1, 1},
+		"toUpperCase":     {1, 1},
+	},
+	"Arrays": {
+		"add":         {2, 2},
+		"remove":      {2, 2},
+		"clear":       {1, 1},
+		"get":         {2, 2},
+		"flatten":     {1, -1},
+		"contains":    {2, 2},
+		"size":        {1, 1},
+		"isEmpty":     {1, 1},
+		"toCsvString": {1, 1},
+	},
+	"Convert": {
+		"toInt": {1, 1},
+		"toNum": {1, 1},
+	},
+	"Iso3166Convert": {
+		"toAlpha2":  {1, 1},
+		"toAlpha3":  {1, 1},
+		"toNumeric": {1, 1},
+		"toName":    {1, 1},
+	},
+	"Time": {
+		"now":                  {0, 1},
+		"fromWindowsToIso8601": {1, 1},
+		"fromUnixToIso8601":    {1, 1},
+		"fromStringToIso8601":  {2, 2},
+		"fromIso8601ToWindows": {1, 1},
+		"fromIso8601ToUnix":    {1, 1},
+		"fromIso8601ToString":  {2, 2},
+	},
+	"Groups": {
+		"contains":   {3, 3},
+		"startsWith": {3, 3},
+		"endsWith":   {3, 3},
+	},
+	"": {
+		"hasDirectoryUser":              {0, 0},
+		"hasWorkdayUser":                {0, 0},
+		"findDirectoryUser":             {0, 1},
+		"findWorkdayUser":               {0, 0},
+		"isMemberOfGroup":               {1, 1},
+		"isMemberOfGroupName":           {1, 1},
+		"isMemberOfAnyGroup":            {1, -1},
+		"isMemberOfGroupNameStartsWith": {1, 1},
+		"isMemberOfGroupNameContains":   {1, 1},
+		"isMemberOfGroupNameRegex":      {1, 1},
+		"getFilteredGroups":             {3, 3},
+		"getManagerUser":                {1, 1},
+		"getManagerAppUser":             {2, 2},
+		"getAssistantUser":              {1, 1},
+		"getAssistantAppUser":           {2, 2},
+	},
+}
+
+// userMethods are the methods that can be called on the user.
+var userMethods = map[string]arity{
+	"isMemberOf":          {1, 2},
+	"getInternalProperty": {1, 1},
+	"getLinkedObject":     {1, 1},
+}
+
+// Issue represents a problem found in an expression.
+type Issue struct {
+	// Pos is the byte offset of the problem in the expression.
+	Pos     int
+	Message string
+}
+
+// LintError is returned by Validate() for an expression with issues.
+type LintError struct {
+	Expression string
+	Issues     []*Issue
+}
+
+func (e *LintError) Error() string {
+	messages := make([]string, 0, len(e.Issues))
+	for _, issue := range e.Issues {
+		messages = append(messages, fmt.Sprintf("%s: %s", position(e.Expression, issue.Pos), issue.Message))
+	}
+	return "el: " + strings.Join(messages, "; ")
+}
+
+// Lint returns the issues of expr, used in ctx. A syntax error stops the linting, so at most one
+// is reported.
+func Lint(expr string, ctx Context) []*Issue {
+	p := &parser{ctx: ctx}
+	if roots, ok := contextRoots[ctx]; ok {
+		p.roots = make(map[string]bool, len(roots))
+		for _, r := range roots {
+			p.roots[r] = true
+		}
+	}
+	p.lint(expr)
+	return p.issues
+}
+
+// Validate returns a *LintError if expr, used in ctx, has issues.
+func Validate(expr string, ctx Context) error {
+	if issues := Lint(expr, ctx); len(issues) > 0 {
+		return &LintError{Expression: expr, Issues: issues}
+	}
+	return nil
+}
+
+// position returns the line and column of the byte offset pos in s, e.g. "1:12".
+func position(s string, pos int) string {
+	if pos > len(s) {
+		pos = len(s)
+	}
+	line := 1 + strings.Count(s[:pos], "\n")
+	col := pos - strings.LastIndex(s[:pos], "\n")
+	return fmt.Sprintf("%d:%d", line, col)
+}
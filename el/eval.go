@@ -0,0 +1,741 @@
+package el
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Env is the environment of a local evaluation: the user an expression is evaluated for, and the
+// groups the user is a member of.
+type Env struct {
+	// User are the profile attributes of the user, referenced as user.<attribute>.
+	User map[string]interface{}
+	// Internal are the internal properties of the user, e.g. id and status, returned by
+	// user.getInternalProperty().
+	Internal map[string]interface{}
+	// Groups are the groups the user is a member of.
+	Groups []Group
+}
+
+// Group represents a group in an Env.
+type Group struct {
+	ID   string
+	Name string
+}
+
+// EvalError is returned by Eval() for an expression that can't be evaluated locally.
+type EvalError struct {
+	Expression string
+	Issue      *Issue
+}
+
+func (e *EvalError) Error() string {
+	return fmt.Sprintf("el: %s: %s", position(e.Expression, e.Issue.Pos), e.Issue.Message)
+}
+
+// Eval evaluates expr for the user of env, and returns its value: a string, a float64, a bool,
+// nil, a []interface{} or a map[string]interface{}.
+//
+// Okta has no API to evaluate an expression, so Eval is a local approximation, meant to test
+// group rules before they are activated. It supports the operators, the String, Arrays and
+// Convert functions, the group membership functions and user.getInternalProperty(). Other
+// functions, which need data of the org such as the manager of the user, return an *EvalError.
+// As in Okta, a reference to an attribute the user doesn't have is null, and null is an empty
+// string for the String functions. Comparisons of strings and numbers are done as in Go, which
+// may differ from Okta in edge cases such as the comparison of a string with a number.
+func Eval(expr string, env *Env) (value interface{}, err error) {
+	tokens, issue := lex(expr)
+	if issue != nil {
+		return nil, &EvalError{Expression: expr, Issue: issue}
+	}
+	if env == nil {
+		env = new(Env)
+	}
+	e := &evaluator{parser: parser{expr: expr, tokens: tokens}, env: env}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err2, ok := r.(syntaxError)
+			if !ok {
+				panic(r)
+			}
+			value, err = nil, &EvalError{Expression: expr, Issue: err2.issue}
+		}
+	}()
+	value = e.evalExpr()
+	if t := e.peek(); t.kind != tokenEOF {
+		e.fail(t.pos, fmt.Sprintf("unexpected %s", describe(t)))
+	}
+	return value, nil
+}
+
+// Matches evaluates expr, e.g. the expression of a group rule, for the user of env and reports
+// whether it's true. An expression whose value isn't a bool returns an *EvalError, as Okta never
+// matches it.
+func Matches(expr string, env *Env) (bool, error) {
+	value, err := Eval(expr, env)
+	if err != nil {
+		return false, err
+	}
+	b, ok := value.(bool)
+	if !ok {
+		return false, &EvalError{Expression: expr, Issue: &Issue{Pos: 0, Message: fmt.Sprintf("expression is a %s, not a boolean", typeName(value))}}
+	}
+	return b, nil
+}
+
+// evaluator is a recursive descent interpreter of expressions, following the grammar of parser.
+type evaluator struct {
+	parser
+	env *Env
+	// skip is positive while evaluating an operand whose value isn't used, e.g. the right side of
+	// false && x, in which errors are ignored.
+	skip int
+}
+
+func (e *evaluator) failf(pos int, format string, args ...interface{}) {
+	if e.skip == 0 {
+		e.fail(pos, fmt.Sprintf(format, args...))
+	}
+}
+
+func (e *evaluator) evalExpr() interface{} {
+	cond := e.evalBinary(0)
+	if t := e.peek(); e.accept(tokenOperator, "?") {
+		b := e.truth(t.pos, cond)
+		then := e.evalSkipped(!b, e.evalExpr)
+		if !e.accept(tokenOperator, ":") {
+			t := e.peek()
+			e.fail(t.pos, fmt.Sprintf("missing \":\" of the conditional, found %s", describe(t)))
+		}
+		otherwise := e.evalSkipped(b, e.evalExpr)
+		if b {
+			return then
+		}
+		return otherwise
+	}
+	return cond
+}
+
+// evalSkipped evaluates with eval, ignoring errors and returning nil if skip is set.
+func (e *evaluator) evalSkipped(skip bool, eval func() interface{}) interface{} {
+	if !skip {
+		return eval()
+	}
+	e.skip++
+	defer func() { e.skip-- }()
+	eval()
+	return nil
+}
+
+func (e *evaluator) evalBinary(level int) interface{} {
+	if level == len(binaryLevels) {
+		return e.evalUnary()
+	}
+	left := e.evalBinary(level + 1)
+	for {
+		t := e.peek()
+		if t.kind != tokenOperator || !contains(binaryLevels[level], t.text) {
+			return left
+		}
+		e.next()
+		switch t.text {
+		case "&&":
+			l := e.truth(t.pos, left)
+			r := e.evalSkipped(!l, func() interface{} { return e.evalBinary(level + 1) })
+			left = l && e.truth(t.pos, r)
+		case "||":
+			l := e.truth(t.pos, left)
+			r := e.evalSkipped(l, func() interface{} { return e.evalBinary(level + 1) })
+			left = l || e.truth(t.pos, r)
+		default:
+			left = e.binary(t, left, e.evalBinary(level+1))
+		}
+	}
+}
+
+// truth returns the value of a boolean operand, false for the operands skipped.
+func (e *evaluator) truth(pos int, v interface{}) bool {
+	b, ok := v.(bool)
+	if !ok && v != nil {
+		e.failf(pos, "expected a boolean, found a %s", typeName(v))
+	}
+	return b
+}
+
+func (e *evaluator) binary(op token, a, b interface{}) interface{} {
+	switch op.text {
+	case "==":
+		return equal(a, b)
+	case "!=":
+		return !equal(a, b)
+	case "+":
+		if as, ok := a.(string); ok {
+			return as + toString(b)
+		}
+		if bs, ok := b.(string); ok {
+			return toString(a) + bs
+		}
+	}
+
+	if as, ok := a.(string); ok {
+		if bs, ok := b.(string); ok {
+			switch op.text {
+			case "<":
+				return as < bs
+			case ">":
+				return as > bs
+			case "<=":
+				return as <= bs
+			case ">=":
+				return as >= bs
+			}
+		}
+	}
+
+	x, xok := a.(float64)
+	y, yok := b.(float64)
+	if !xok || !yok {
+		e.failf(op.pos, "%s can't be applied to a %s and a %s", op.text, typeName(a), typeName(b))
+		return nil
+	}
+	switch op.text {
+	case "<":
+		return x < y
+	case ">":
+		return x > y
+	case "<=":
+		return x <= y
+	case ">=":
+		return x >= y
+	case "+":
+		return x + y
+	case "-":
+		return x - y
+	case "*":
+		return x * y
+	case "/":
+		return x / y
+	default:
+		return math.Mod(x, y)
+	}
+}
+
+func (e *evaluator) evalUnary() interface{} {
+	t := e.peek()
+	if t.kind == tokenOperator && (t.text == "!" || t.text == "-") {
+		e.next()
+		v := e.evalUnary()
+		if t.text == "!" {
+			return !e.truth(t.pos, v)
+		}
+		n, ok := v.(float64)
+		if !ok {
+			e.failf(t.pos, "- can't be applied to a %s", typeName(v))
+		}
+		return -n
+	}
+	return e.evalPostfix()
+}
+
+func (e *evaluator) evalPostfix() interface{} {
+	t := e.next()
+	var value interface{}
+	var path []string
+	switch {
+	case t.kind == tokenString:
+		value = t.text
+	case t.kind == tokenNumber:
+		n, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			e.fail(t.pos, fmt.Sprintf("invalid number %q", t.text))
+		}
+		value = n
+	case t.kind == tokenIdent:
+		switch t.text {
+		case "true":
+			value = true
+		case "false":
+			value = false
+		case "null":
+		default:
+			path = []string{t.text}
+		}
+	case t.kind == tokenPunct && t.text == "(":
+		value = e.evalExpr()
+		e.expectClose("(", ")", t.pos)
+	case t.kind == tokenPunct && t.text == "{":
+		value = e.evalList(t)
+	case t.kind == tokenEOF:
+		e.fail(t.pos, "unexpected end of expression")
+	default:
+		e.fail(t.pos, fmt.Sprintf("unexpected %s", describe(t)))
+	}
+
+	// path is the chain of identifiers read so far, nil once it is no longer a plain reference.
+	for {
+		switch {
+		case e.accept(tokenPunct, "."):
+			name := e.next()
+			if name.kind != tokenIdent {
+				e.fail(name.pos, fmt.Sprintf("expected an attribute name after \".\", found %s", describe(name)))
+			}
+			if path != nil {
+				path = append(path, name.text)
+			} else {
+				value = index(value, name.text)
+			}
+		case e.peek().kind == tokenPunct && e.peek().text == "(":
+			open := e.next()
+			args := e.evalArgs(open)
+			if path == nil {
+				e.failf(open.pos, "a %s can't be called", typeName(value))
+			} else {
+				value = e.call(t.pos, path, args)
+			}
+			path = nil
+		case e.peek().kind == tokenPunct && e.peek().text == "[":
+			open := e.next()
+			if path != nil {
+				value = e.resolve(t.pos, path)
+				path = nil
+			}
+			key := e.evalExpr()
+			e.expectClose("[", "]", open.pos)
+			value = index(value, key)
+		default:
+			if path != nil {
+				value = e.resolve(t.pos, path)
+			}
+			return value
+		}
+	}
+}
+
+// evalList evaluates a list, {a, b}, or a map, {'key': value}, literal after its opening brace.
+func (e *evaluator) evalList(open token) interface{} {
+	var list []interface{}
+	var m map[string]interface{}
+	if e.accept(tokenPunct, "}") {
+		return list
+	}
+	for {
+		v := e.evalExpr()
+		if e.accept(tokenOperator, ":") {
+			if m == nil {
+				m = make(map[string]interface{})
+			}
+			m[toString(v)] = e.evalExpr()
+		} else {
+			list = append(list, v)
+		}
+		if !e.accept(tokenPunct, ",") {
+			break
+		}
+	}
+	e.expectClose("{", "}", open.pos)
+	if m != nil {
+		return m
+	}
+	return list
+}
+
+func (e *evaluator) evalArgs(open token) []interface{} {
+	var args []interface{}
+	if e.accept(tokenPunct, ")") {
+		return args
+	}
+	for {
+		args = append(args, e.evalExpr())
+		if !e.accept(tokenPunct, ",") {
+			break
+		}
+	}
+	e.expectClose("(", ")", open.pos)
+	return args
+}
+
+// resolve returns the value of the attribute at path.
+func (e *evaluator) resolve(pos int, path []string) interface{} {
+	if path[0] != "user" {
+		e.failf(pos, "unknown reference %q, only user can be evaluated locally", path[0])
+		return nil
+	}
+	if len(path) == 1 {
+		e.failf(pos, "user must be followed by an attribute, e.g. user.login")
+		return nil
+	}
+	var value interface{} = e.env.User[path[1]]
+	for _, name := range path[2:] {
+		value = index(value, name)
+	}
+	return normalize(value)
+}
+
+// call calls the function at path with args.
+func (e *evaluator) call(pos int, path []string, args []interface{}) interface{} {
+	name := strings.Join(path, ".")
+	var a arity
+	var ok bool
+	switch {
+	case len(path) == 1:
+		a, ok = functions[""][path[0]]
+	case len(path) == 2 && functions[path[0]] != nil:
+		a, ok = functions[path[0]][path[1]]
+	case len(path) == 2 && path[0] == "user":
+		a, ok = userMethods[path[1]]
+	}
+	if !ok {
+		e.failf(pos, "unknown function %s%s", name, suggest(path))
+		return nil
+	}
+	if len(args) < a.min || a.max >= 0 && len(args) > a.max {
+		e.failf(pos, "%s takes %s, not %d", name, describeArity(a), len(args))
+		return nil
+	}
+
+	fn, ok := builtins[name]
+	if !ok {
+		e.failf(pos, "%s can't be evaluated locally", name)
+		return nil
+	}
+	value, err := fn(e.env, args)
+	if err != nil {
+		e.failf(pos, "%s: %v", name, err)
+		return nil
+	}
+	return value
+}
+
+// builtins are the functions supported by Eval, by name.
+var builtins = map[string]func(env *Env, args []interface{}) (interface{}, error){
+	"String.append": func(env *Env, args []interface{}) (interface{}, error) {
+		return toString(args[0]) + toString(args[1]), nil
+	},
+	"String.join": func(env *Env, args []interface{}) (interface{}, error) {
+		parts := make([]string, 0, len(args)-1)
+		for _, arg := range args[1:] {
+			parts = append(parts, toString(arg))
+		}
+		return strings.Join(parts, toString(args[0])), nil
+	},
+	"String.len": func(env *Env, args []interface{}) (interface{}, error) {
+		return float64(len([]rune(toString(args[0])))), nil
+	},
+	"String.removeSpaces": func(env *Env, args []interface{}) (interface{}, error) {
+		return strings.Replace(toString(args[0]), " ", "", -1), nil
+	},
+	"String.replace": func(env *Env, args []interface{}) (interface{}, error) {
+		re, err := regexp.Compile(toString(args[1]))
+		if err != nil {
+			return nil, err
+		}
+		return re.ReplaceAllString(toString(args[0]), toString(args[2])), nil
+	},
+	"String.replaceFirst": func(env *Env, args []interface{}) (interface{}, error) {
+		re, err := regexp.Compile(toString(args[1]))
+		if err != nil {
+			return nil, err
+		}
+		s := toString(args[0])
+		loc := re.FindStringSubmatchIndex(s)
+		if loc == nil {
+			return s, nil
+		}
+		return s[:loc[0]] + string(re.ExpandString(nil, toString(args[2]), s, loc)) + s[loc[1]:], nil
+	},
+	"String.stringContains": func(env *Env, args []interface{}) (interface{}, error) {
+		return args[0] != nil && strings.Contains(toString(args[0]), toString(args[1])), nil
+	},
+	"String.stringSwitch": func(env *Env, args []interface{}) (interface{}, error) {
+		s := toString(args[0])
+		for i := 2; i+1 < len(args); i += 2 {
+			if strings.Contains(s, toString(args[i])) {
+				return args[i+1], nil
+			}
+		}
+		return args[1], nil
+	},
+	"String.substring": func(env *Env, args []interface{}) (interface{}, error) {
+		s := []rune(toString(args[0]))
+		start, end := toInt(args[1]), toInt(args[2])
+		if start < 0 || end > len(s) || start > end {
+			return nil, fmt.Errorf("range [%d, %d) out of bounds of a string of length %d", start, end, len(s))
+		}
+		return string(s[start:end]), nil
+	},
+	"String.substringAfter": func(env *Env, args []interface{}) (interface{}, error) {
+		s, sep := toString(args[0]), toString(args[1])
+		if i := strings.Index(s, sep); i >= 0 {
+			return s[i+len(sep):], nil
+		}
+		return "", nil
+	},
+	"String.substringBefore": func(env *Env, args []interface{}) (interface{}, error) {
+		s, sep := toString(args[0]), toString(args[1])
+		if i := strings.Index(s, sep); i >= 0 {
+			return s[:i], nil
+		}
+		return "", nil
+	},
+	"String.toLowerCase": func(env *Env, args []interface{}) (interface{}, error) {
+		return strings.ToLower(toString(args[0])), nil
+	},
+	"String.toUpperCase": func(env *Env, args []interface{}) (interface{}, error) {
+		return strings.ToUpper(toString(args[0])), nil
+	},
+	"Arrays.contains": func(env *Env, args []interface{}) (interface{}, error) {
+		for _, v := range toList(args[0]) {
+			if equal(v, args[1]) {
+				return true, nil
+			}
+		}
+		return false, nil
+	},
+	"Arrays.size": func(env *Env, args []interface{}) (interface{}, error) {
+		return float64(len(toList(args[0]))), nil
+	},
+	"Arrays.isEmpty": func(env *Env, args []interface{}) (interface{}, error) {
+		return len(toList(args[0])) == 0, nil
+	},
+	"Arrays.get": func(env *Env, args []interface{}) (interface{}, error) {
+		return index(toList(args[0]), args[1]), nil
+	},
+	"Arrays.add": func(env *Env, args []interface{}) (interface{}, error) {
+		return append(append([]interface{}(nil), toList(args[0])...), args[1]), nil
+	},
+	"Arrays.remove": func(env *Env, args []interface{}) (interface{}, error) {
+		var list []interface{}
+		for _, v := range toList(args[0]) {
+			if !equal(v, args[1]) {
+				list = append(list, v)
+			}
+		}
+		return list, nil
+	},
+	"Arrays.clear": func(env *Env, args []interface{}) (interface{}, error) {
+		return []interface{}{}, nil
+	},
+	"Arrays.flatten": func(env *Env, args []interface{}) (interface{}, error) {
+		var list []interface{}
+		var flatten func(v interface{})
+		flatten = func(v interface{}) {
+			if l, ok := v.([]interface{}); ok {
+				for _, item := range l {
+					flatten(item)
+				}
+				return
+			}
+			list = append(list, v)
+		}
+		for _, arg := range args {
+			flatten(arg)
+		}
+		return list, nil
+	},
+	"Arrays.toCsvString": func(env *Env, args []interface{}) (interface{}, error) {
+		var parts []string
+		for _, v := range toList(args[0]) {
+			parts = append(parts, toString(v))
+		}
+		return strings.Join(parts, ","), nil
+	},
+	"Convert.toInt": func(env *Env, args []interface{}) (interface{}, error) {
+		n, err := toNumber(args[0])
+		return math.Round(n), err
+	},
+	"Convert.toNum": func(env *Env, args []interface{}) (interface{}, error) {
+		return toNumber(args[0])
+	},
+	"isMemberOfGroup": func(env *Env, args []interface{}) (interface{}, error) {
+		return env.memberOf(func(g Group) bool { return g.ID == toString(args[0]) }), nil
+	},
+	"isMemberOfAnyGroup": func(env *Env, args []interface{}) (interface{}, error) {
+		ids := make(map[string]bool)
+		for _, arg := range args {
+			for _, id := range toList(arg) {
+				ids[toString(id)] = true
+			}
+		}
+		return env.memberOf(func(g Group) bool { return ids[g.ID] }), nil
+	},
+	"isMemberOfGroupName": func(env *Env, args []interface{}) (interface{}, error) {
+		return env.memberOf(func(g Group) bool { return g.Name == toString(args[0]) }), nil
+	},
+	"isMemberOfGroupNameStartsWith": func(env *Env, args []interface{}) (interface{}, error) {
+		return env.memberOf(func(g Group) bool { return strings.HasPrefix(g.Name, toString(args[0])) }), nil
+	},
+	"isMemberOfGroupNameContains": func(env *Env, args []interface{}) (interface{}, error) {
+		return env.memberOf(func(g Group) bool { return strings.Contains(g.Name, toString(args[0])) }), nil
+	},
+	"isMemberOfGroupNameRegex": func(env *Env, args []interface{}) (interface{}, error) {
+		re, err := regexp.Compile("^(?:" + toString(args[0]) + ")$")
+		if err != nil {
+			return nil, err
+		}
+		return env.memberOf(func(g Group) bool { return re.MatchString(g.Name) }), nil
+	},
+	"user.isMemberOf": func(env *Env, args []interface{}) (interface{}, error) {
+		criteria, ok := args[0].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected a map of criteria, found a %s", typeName(args[0]))
+		}
+		match := func(attribute, value string) bool { return attribute == value }
+		switch operator := toString(criteria["operator"]); operator {
+		case "", "EXACT":
+		case "STARTS_WITH":
+			match = strings.HasPrefix
+		case "CONTAINS":
+			match = strings.Contains
+		default:
+			return nil, fmt.Errorf("unknown operator %q", operator)
+		}
+		for _, key := range []string{"group.id", "group.profile.name"} {
+			values, ok := criteria[key]
+			if !ok {
+				continue
+			}
+			return env.memberOf(func(g Group) bool {
+				attribute := g.ID
+				if key == "group.profile.name" {
+					attribute = g.Name
+				}
+				for _, v := range toList(values) {
+					if match(attribute, toString(v)) {
+						return true
+					}
+				}
+				return false
+			}), nil
+		}
+		return nil, fmt.Errorf("criteria must have a group.id or a group.profile.name")
+	},
+	"user.getInternalProperty": func(env *Env, args []interface{}) (interface{}, error) {
+		return normalize(env.Internal[toString(args[0])]), nil
+	},
+}
+
+// memberOf reports whether the user is a member of a group matching match.
+func (env *Env) memberOf(match func(g Group) bool) bool {
+	for _, g := range env.Groups {
+		if match(g) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize converts the numbers and lists of the attributes of an Env to the float64 and
+// []interface{} of the values of expressions.
+func normalize(v interface{}) interface{} {
+	switch v := v.(type) {
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case float32:
+		return float64(v)
+	case []string:
+		list := make([]interface{}, len(v))
+		for i, s := range v {
+			list[i] = s
+		}
+		return list
+	case []interface{}:
+		list := make([]interface{}, len(v))
+		for i, item := range v {
+			list[i] = normalize(item)
+		}
+		return list
+	}
+	return v
+}
+
+// index returns the element key of a list or map, or nil if there is none.
+func index(v interface{}, key interface{}) interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		i := toInt(key)
+		if i < 0 || i >= len(v) {
+			return nil
+		}
+		return normalize(v[i])
+	case map[string]interface{}:
+		return normalize(v[toString(key)])
+	}
+	return nil
+}
+
+func equal(a, b interface{}) bool {
+	a, b = normalize(a), normalize(b)
+	switch a.(type) {
+	case []interface{}, map[string]interface{}:
+		return fmt.Sprint(a) == fmt.Sprint(b)
+	}
+	switch b.(type) {
+	case []interface{}, map[string]interface{}:
+		return false
+	}
+	return a == b
+}
+
+func toString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
+
+func toInt(v interface{}) int {
+	n, _ := toNumber(v)
+	return int(n)
+}
+
+func toNumber(v interface{}) (float64, error) {
+	switch v := normalize(v).(type) {
+	case float64:
+		return v, nil
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(v), 64)
+	case nil:
+		return 0, nil
+	}
+	return 0, fmt.Errorf("can't convert a %s to a number", typeName(v))
+}
+
+func toList(v interface{}) []interface{} {
+	switch v := normalize(v).(type) {
+	case []interface{}:
+		return v
+	case nil:
+		return nil
+	default:
+		return []interface{}{v}
+	}
+}
+
+func typeName(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case []interface{}:
+		return "list"
+	case map[string]interface{}:
+		return "map"
+	}
+	return fmt.Sprintf("%T", v)
+}
@@ -0,0 +1,119 @@
+package el
+
+import (
+	"context"
+	"strings"
+
+	"github.com/austinylin/go-okta/okta"
+)
+
+// RuleEvaluation represents the users a group rule matches in an org, see EvaluateGroupRule().
+type RuleEvaluation struct {
+	Rule *okta.GroupRule
+	// Filter is the search the candidates were listed with, see SearchFilter(), empty if every
+	// user was a candidate.
+	Filter string
+	// Candidates is the number of users the rule was evaluated for.
+	Candidates int
+	// Matched are the users the rule assigns to its groups.
+	Matched []*okta.User
+	// Errors are the errors of the evaluation by user ID, e.g. of an expression calling a function
+	// which can't be evaluated locally. These users aren't matched.
+	Errors map[string]error
+}
+
+// UserIDs returns the IDs of the users the rule matches.
+func (e *RuleEvaluation) UserIDs() []string {
+	ids := make([]string, len(e.Matched))
+	for i, user := range e.Matched {
+		ids[i] = user.ID
+	}
+	return ids
+}
+
+// Materialize creates a group with profile whose members are the users the rule matches, e.g. to
+// replace the rule with a static group before retiring it. The group is returned even if some of
+// the users couldn't be added, which the result reports, or ctx is done, along with the error of
+// AddUsers().
+func (e *RuleEvaluation) Materialize(ctx context.Context, client *okta.Client, profile *okta.GroupProfile) (*okta.Group, *okta.BulkResult, error) {
+	group, _, err := client.Groups.Add(ctx, profile)
+	if err != nil {
+		return nil, nil, err
+	}
+	result, err := client.Groups.AddUsers(ctx, group.ID, e.UserIDs())
+	return group, result, err
+}
+
+// EvaluateGroupRule evaluates rule for the users of the org, and returns those it matches, e.g.
+// to review the members of its groups, or to debug why a user isn't one.
+//
+// The candidates are listed with the search filter of the expression of the rule, see
+// SearchFilter(), and the rule is evaluated locally for each of them with MatchGroupRule(). The
+// groups of the candidates are only fetched if the rule depends on them.
+func EvaluateGroupRule(ctx context.Context, client *okta.Client, rule *okta.GroupRule) (*RuleEvaluation, error) {
+	e := &RuleEvaluation{
+		Rule:   rule,
+		Filter: SearchFilter(rule.Conditions.Expression.Value),
+		Errors: make(map[string]error),
+	}
+	page, _, err := client.Users.List(ctx, &okta.UserListParams{Search: e.Filter})
+	if err != nil {
+		return nil, err
+	}
+	users, _, err := page.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	e.Candidates = len(users)
+	for _, user := range users {
+		matched, err := matchGroupRule(ctx, client, rule, user)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			e.Errors[user.ID] = err
+			continue
+		}
+		if matched {
+			e.Matched = append(e.Matched, user)
+		}
+	}
+	return e, nil
+}
+
+// MatchGroupRuleByID reports whether rule assigns the user userID to its groups, like
+// MatchGroupRule() for a user and groups fetched from the org.
+func MatchGroupRuleByID(ctx context.Context, client *okta.Client, rule *okta.GroupRule, userID string) (bool, error) {
+	user, _, err := client.Users.GetByID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return matchGroupRule(ctx, client, rule, user)
+}
+
+// matchGroupRule is a helper function for MatchGroupRule() which fetches the groups of user if rule
+// depends on them.
+func matchGroupRule(ctx context.Context, client *okta.Client, rule *okta.GroupRule, user *okta.User) (bool, error) {
+	var groups []*okta.Group
+	if dependsOnGroups(rule) {
+		page, _, err := client.Users.ListGroups(ctx, user.ID)
+		if err != nil {
+			return false, err
+		}
+		if groups, _, err = page.All(ctx); err != nil {
+			return false, err
+		}
+	}
+	return MatchGroupRule(rule, user, groups)
+}
+
+// dependsOnGroups reports whether rule excludes groups, or its expression calls a group
+// membership function.
+func dependsOnGroups(rule *okta.GroupRule) bool {
+	if people := rule.Conditions.People; people != nil && len(people.Groups.Exclude) > 0 {
+		return true
+	}
+	expr := rule.Conditions.Expression.Value
+	return strings.Contains(expr, "isMemberOf") || strings.Contains(expr, "Groups.") || strings.Contains(expr, "getFilteredGroups")
+}
@@ -0,0 +1,89 @@
+package el
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/austinylin/go-okta/okta"
+)
+
+// ValidateGroupRule returns a *LintError if the expression of rule has issues.
+func ValidateGroupRule(rule *okta.GroupRule) error {
+	return Validate(rule.Conditions.Expression.Value, ContextGroupRule)
+}
+
+// ValidateClaim returns a *LintError if the value of claim is an expression with issues.
+func ValidateClaim(claim *okta.AuthorizationServerClaim) error {
+	if claim.ValueType != okta.AuthorizationServerClaimValueTypeExpression {
+		return nil
+	}
+	return Validate(claim.Value, ContextClaim)
+}
+
+// ValidateApp returns an error if the username template of app is invalid, or a *LintError if its
+// custom template has issues.
+func ValidateApp(app *okta.App) error {
+	template := app.Credentials.UserNameTemplate
+	if err := template.Validate(); err != nil {
+		return err
+	}
+	if template.Type != okta.UserNameTemplateTypeCustom {
+		return nil
+	}
+	return Validate(template.Template, ContextUserNameTemplate)
+}
+
+// NewEnv is a helper method to create a new Env for user, a member of groups.
+func NewEnv(user *okta.User, groups []*okta.Group) (*Env, error) {
+	data, err := json.Marshal(user.Profile)
+	if err != nil {
+		return nil, err
+	}
+	env := &Env{
+		Internal: map[string]interface{}{
+			"id":          user.ID,
+			"status":      user.Status,
+			"created":     user.Created.Format(time.RFC3339),
+			"lastUpdated": user.LastUpdated.Format(time.RFC3339),
+		},
+	}
+	if err := json.Unmarshal(data, &env.User); err != nil {
+		return nil, err
+	}
+	// The attributes Okta returns as null are empty strings in a UserProfile.
+	for name, value := range env.User {
+		if value == "" {
+			delete(env.User, name)
+		}
+	}
+	for _, g := range groups {
+		env.Groups = append(env.Groups, Group{ID: g.ID, Name: g.Profile.Name})
+	}
+	return env, nil
+}
+
+// MatchGroupRule reports whether rule assigns user, a member of groups, to its groups, so that a
+// rule can be tested before it's activated. The expression is evaluated locally, see Eval(), and
+// the users and groups excluded by the rule are honored.
+func MatchGroupRule(rule *okta.GroupRule, user *okta.User, groups []*okta.Group) (bool, error) {
+	if people := rule.Conditions.People; people != nil {
+		for _, id := range people.Users.Exclude {
+			if id == user.ID {
+				return false, nil
+			}
+		}
+		for _, id := range people.Groups.Exclude {
+			for _, g := range groups {
+				if g.ID == id {
+					return false, nil
+				}
+			}
+		}
+	}
+
+	env, err := NewEnv(user, groups)
+	if err != nil {
+		return false, err
+	}
+	return Matches(rule.Conditions.Expression.Value, env)
+}
@@ -0,0 +1,430 @@
+package el
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenPunct
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// keywordOperators are the operators spelled as words, in any case.
+var keywordOperators = map[string]string{
+	"AND": "&&",
+	"OR":  "||",
+	"NOT": "!",
+}
+
+// lex splits expr into tokens. It returns the issue of the first invalid token, if any.
+func lex(expr string) ([]token, *Issue) {
+	var tokens []token
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case isIdentStart(c):
+			start := i
+			for i < len(expr) && isIdentPart(expr[i]) {
+				i++
+			}
+			text := expr[start:i]
+			if op, ok := keywordOperators[strings.ToUpper(text)]; ok {
+				tokens = append(tokens, token{tokenOperator, op, start})
+			} else {
+				tokens = append(tokens, token{tokenIdent, text, start})
+			}
+		case c >= '0' && c <= '9':
+			start := i
+			for i < len(expr) && (expr[i] >= '0' && expr[i] <= '9' || expr[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{tokenNumber, expr[start:i], start})
+		case c == '"' || c == '\'':
+			// A quote inside a string is escaped by doubling it.
+			start := i
+			i++
+			var b strings.Builder
+			for {
+				if i >= len(expr) {
+					return nil, &Issue{Pos: start, Message: "unterminated string"}
+				}
+				if expr[i] == c {
+					if i+1 < len(expr) && expr[i+1] == c {
+						b.WriteByte(c)
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				b.WriteByte(expr[i])
+				i++
+			}
+			tokens = append(tokens, token{tokenString, b.String(), start})
+		case strings.ContainsRune("()[]{},.", rune(c)):
+			tokens = append(tokens, token{tokenPunct, string(c), i})
+			i++
+		default:
+			op := ""
+			for _, candidate := range []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "+", "-", "*", "/", "%", "?", ":"} {
+				if strings.HasPrefix(expr[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, &Issue{Pos: i, Message: fmt.Sprintf("unexpected character %q", c)}
+			}
+			tokens = append(tokens, token{tokenOperator, op, i})
+			i += len(op)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(expr)}), nil
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c == '$' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || c >= '0' && c <= '9'
+}
+
+// syntaxError stops the parsing.
+type syntaxError struct {
+	issue *Issue
+}
+
+// parser is a recursive descent parser of expressions, which reports the issues it finds along
+// the way.
+type parser struct {
+	ctx    Context
+	roots  map[string]bool
+	expr   string
+	tokens []token
+	i      int
+	issues []*Issue
+}
+
+func (p *parser) lint(expr string) {
+	p.expr = expr
+	if strings.TrimSpace(expr) == "" {
+		p.issues = append(p.issues, &Issue{Pos: 0, Message: "empty expression"})
+		return
+	}
+
+	tokens, issue := lex(expr)
+	if issue != nil {
+		p.issues = append(p.issues, issue)
+		return
+	}
+	p.tokens = tokens
+
+	defer func() {
+		if r := recover(); r != nil {
+			err, ok := r.(syntaxError)
+			if !ok {
+				panic(r)
+			}
+			p.issues = append(p.issues, err.issue)
+		}
+	}()
+	p.parseExpr()
+	if t := p.peek(); t.kind != tokenEOF {
+		p.fail(t.pos, fmt.Sprintf("unexpected %s", describe(t)))
+	}
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.i]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.i]
+	if t.kind != tokenEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) accept(kind tokenKind, text string) bool {
+	if t := p.peek(); t.kind == kind && t.text == text {
+		p.i++
+		return true
+	}
+	return false
+}
+
+// expectClose consumes the closing punctuation of the opening one at openPos.
+func (p *parser) expectClose(open, close string, openPos int) {
+	if !p.accept(tokenPunct, close) {
+		t := p.peek()
+		p.fail(t.pos, fmt.Sprintf("missing %q to close %q at %s", close, open, position(p.expr, openPos)))
+	}
+}
+
+func (p *parser) fail(pos int, message string) {
+	panic(syntaxError{&Issue{Pos: pos, Message: message}})
+}
+
+func (p *parser) report(pos int, message string) {
+	p.issues = append(p.issues, &Issue{Pos: pos, Message: message})
+}
+
+func (p *parser) parseExpr() {
+	p.parseBinary(0)
+	if p.accept(tokenOperator, "?") {
+		p.parseExpr()
+		if !p.accept(tokenOperator, ":") {
+			t := p.peek()
+			p.fail(t.pos, fmt.Sprintf("missing \":\" of the conditional, found %s", describe(t)))
+		}
+		p.parseExpr()
+	}
+}
+
+// binaryLevels are the binary operators by increasing precedence.
+var binaryLevels = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!=", "<", ">", "<=", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *parser) parseBinary(level int) {
+	if level == len(binaryLevels) {
+		p.parseUnary()
+		return
+	}
+	p.parseBinary(level + 1)
+	for {
+		t := p.peek()
+		if t.kind != tokenOperator || !contains(binaryLevels[level], t.text) {
+			return
+		}
+		p.next()
+		p.parseBinary(level + 1)
+	}
+}
+
+func (p *parser) parseUnary() {
+	if t := p.peek(); t.kind == tokenOperator && (t.text == "!" || t.text == "-") {
+		p.next()
+		p.parseUnary()
+		return
+	}
+	p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() {
+	t := p.next()
+	var path []string
+	switch {
+	case t.kind == tokenString || t.kind == tokenNumber:
+	case t.kind == tokenIdent:
+		switch t.text {
+		case "true", "false", "null":
+		default:
+			path = []string{t.text}
+		}
+	case t.kind == tokenPunct && t.text == "(":
+		p.parseExpr()
+		p.expectClose("(", ")", t.pos)
+	case t.kind == tokenPunct && t.text == "{":
+		p.parseList(t)
+	case t.kind == tokenEOF:
+		p.fail(t.pos, "unexpected end of expression")
+	default:
+		p.fail(t.pos, fmt.Sprintf("unexpected %s", describe(t)))
+	}
+
+	// path is the chain of identifiers read so far, nil once it is no longer a plain reference.
+	for {
+		switch {
+		case p.accept(tokenPunct, "."):
+			name := p.next()
+			if name.kind != tokenIdent {
+				p.fail(name.pos, fmt.Sprintf("expected an attribute name after \".\", found %s", describe(name)))
+			}
+			if path != nil {
+				path = append(path, name.text)
+			}
+		case p.peek().kind == tokenPunct && p.peek().text == "(":
+			open := p.next()
+			args := p.parseArgs(open)
+			if path != nil {
+				p.checkCall(t.pos, path, args)
+			}
+			path = nil
+		case p.peek().kind == tokenPunct && p.peek().text == "[":
+			open := p.next()
+			p.parseExpr()
+			p.expectClose("[", "]", open.pos)
+			if path != nil {
+				p.checkReference(t.pos, path)
+			}
+			path = nil
+		default:
+			if path != nil {
+				p.checkReference(t.pos, path)
+			}
+			return
+		}
+	}
+}
+
+// parseList parses a list, {a, b}, or a map, {'key': value}, literal after its opening brace.
+func (p *parser) parseList(open token) {
+	if p.accept(tokenPunct, "}") {
+		return
+	}
+	for {
+		p.parseExpr()
+		if p.accept(tokenOperator, ":") {
+			p.parseExpr()
+		}
+		if !p.accept(tokenPunct, ",") {
+			break
+		}
+	}
+	p.expectClose("{", "}", open.pos)
+}
+
+// parseArgs parses the arguments of a call after its opening parenthesis, and returns their count.
+func (p *parser) parseArgs(open token) int {
+	if p.accept(tokenPunct, ")") {
+		return 0
+	}
+	n := 0
+	for {
+		p.parseExpr()
+		n++
+		if !p.accept(tokenPunct, ",") {
+			break
+		}
+	}
+	p.expectClose("(", ")", open.pos)
+	return n
+}
+
+// checkCall reports the issues of a call of the function at path with args arguments.
+func (p *parser) checkCall(pos int, path []string, args int) {
+	name := strings.Join(path, ".")
+	var a arity
+	var ok bool
+	switch {
+	case len(path) == 1:
+		a, ok = functions[""][path[0]]
+	case len(path) == 2 && functions[path[0]] != nil:
+		a, ok = functions[path[0]][path[1]]
+	case len(path) == 2 && path[0] == "user":
+		a, ok = userMethods[path[1]]
+		if ok {
+			p.checkRoot(pos, path[0])
+		}
+	}
+	if !ok {
+		p.report(pos, fmt.Sprintf("unknown function %s%s", name, suggest(path)))
+		return
+	}
+
+	if args < a.min || a.max >= 0 && args > a.max {
+		p.report(pos, fmt.Sprintf("%s takes %s, not %d", name, describeArity(a), args))
+	}
+}
+
+// checkReference reports the issues of a reference to the attribute at path.
+func (p *parser) checkReference(pos int, path []string) {
+	if functions[path[0]] != nil && path[0] != "" {
+		p.report(pos, fmt.Sprintf("%s is a function, not an attribute", strings.Join(path, ".")))
+		return
+	}
+	if !p.checkRoot(pos, path[0]) {
+		return
+	}
+	if len(path) == 1 {
+		p.report(pos, fmt.Sprintf("%s must be followed by an attribute, e.g. %s.login", path[0], path[0]))
+	}
+}
+
+// checkRoot reports a reference to root that isn't available in the context of the parser.
+func (p *parser) checkRoot(pos int, root string) bool {
+	if p.roots == nil || p.roots[root] {
+		return true
+	}
+	roots := make([]string, 0, len(p.roots))
+	for r := range p.roots {
+		roots = append(roots, r)
+	}
+	sort.Strings(roots)
+	p.report(pos, fmt.Sprintf("unknown reference %q, expected one of %s in a %s expression", root, strings.Join(roots, ", "), p.ctx))
+	return false
+}
+
+// suggest returns a hint for an unknown function of a known class.
+func suggest(path []string) string {
+	if len(path) != 2 || functions[path[0]] == nil {
+		return ""
+	}
+	lower := strings.ToLower(path[1])
+	for name := range functions[path[0]] {
+		if strings.ToLower(name) == lower {
+			return fmt.Sprintf(", did you mean %s.%s?", path[0], name)
+		}
+	}
+	return ""
+}
+
+func describeArity(a arity) string {
+	plural := func(n int) string {
+		if n == 1 {
+			return "1 argument"
+		}
+		return fmt.Sprintf("%d arguments", n)
+	}
+	switch {
+	case a.max < 0:
+		return "at least " + plural(a.min)
+	case a.min == a.max:
+		return plural(a.min)
+	default:
+		return fmt.Sprintf("%d to %d arguments", a.min, a.max)
+	}
+}
+
+func describe(t token) string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of expression"
+	case tokenString:
+		return "string"
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,199 @@
+package el
+
+import (
+	"strings"
+)
+
+// searchComparisons are the comparison operators of the Expression Language translated by
+// SearchFilter(), to the search operator and the one of the swapped operands.
+var searchComparisons = map[string][2]string{
+	"==": {"eq", "eq"},
+	"<":  {"lt", "gt"},
+	">":  {"gt", "lt"},
+	"<=": {"le", "ge"},
+	">=": {"ge", "le"},
+}
+
+// SearchFilter returns a search expression of the Users API, for UserListParams.Search, which
+// matches at least the users expr matches, e.g. `profile.department eq "Engineering"` for
+// user.department == "Engineering" && isMemberOfGroup("00g1"). It returns an empty string if it
+// can't narrow the users down, which means that every user is a candidate.
+//
+// The filter only narrows the candidates of an expression down, so that it can be evaluated for
+// fewer users: the comparisons of profile attributes with a string, or a number for the ordering
+// operators, are translated, as are && and ||; the terms of a && that can't be translated are left
+// out. Candidates must still be tested with Matches(), e.g. as a search is case insensitive.
+//
+// https://developer.okta.com/docs/reference/api/users/#list-users-with-search
+func SearchFilter(expr string) (filter string) {
+	tokens, issue := lex(expr)
+	if issue != nil {
+		return ""
+	}
+	t := &searchTranslator{parser: parser{expr: expr, tokens: tokens}}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(syntaxError); !ok {
+				panic(r)
+			}
+			filter = ""
+		}
+	}()
+	term := t.translateExpr()
+	if t.peek().kind != tokenEOF {
+		return ""
+	}
+	return term.filter
+}
+
+// searchTerm is the translation of an operand of an expression: a search filter for a boolean
+// term, a profile attribute for a reference to user.<attribute>, or a literal. All are empty if
+// it can't be translated.
+type searchTerm struct {
+	filter  string
+	attr    string
+	literal string
+	// number reports whether the literal is a number.
+	number bool
+}
+
+// searchTranslator is a recursive descent translator of expressions to search filters, following
+// the grammar of parser.
+type searchTranslator struct {
+	parser
+}
+
+func (t *searchTranslator) translateExpr() searchTerm {
+	term := t.translateBinary(0)
+	if t.accept(tokenOperator, "?") {
+		t.parseExpr()
+		if !t.accept(tokenOperator, ":") {
+			next := t.peek()
+			t.fail(next.pos, "missing \":\" of the conditional")
+		}
+		t.parseExpr()
+		return searchTerm{}
+	}
+	return term
+}
+
+func (t *searchTranslator) translateBinary(level int) searchTerm {
+	if level == len(binaryLevels) {
+		return t.translateUnary()
+	}
+	left := t.translateBinary(level + 1)
+	for {
+		op := t.peek()
+		if op.kind != tokenOperator || !contains(binaryLevels[level], op.text) {
+			return left
+		}
+		t.next()
+		right := t.translateBinary(level + 1)
+		switch op.text {
+		case "&&":
+			// A conjunction matches at most the users of each of its terms.
+			switch {
+			case left.filter == "":
+				left = searchTerm{filter: right.filter}
+			case right.filter != "":
+				left = searchTerm{filter: left.filter + " and " + right.filter}
+			default:
+				left = searchTerm{filter: left.filter}
+			}
+		case "||":
+			if left.filter == "" || right.filter == "" {
+				left = searchTerm{}
+			} else {
+				left = searchTerm{filter: "(" + left.filter + " or " + right.filter + ")"}
+			}
+		default:
+			left = searchComparison(op.text, left, right)
+		}
+	}
+}
+
+// searchComparison returns the filter of the comparison a op b, for an attribute and a literal.
+func searchComparison(op string, a, b searchTerm) searchTerm {
+	ops, ok := searchComparisons[op]
+	if !ok {
+		return searchTerm{}
+	}
+	if a.attr == "" {
+		a, b = b, a
+		ops[0] = ops[1]
+	}
+	if a.attr == "" || b.literal == "" || op != "==" && !b.number {
+		return searchTerm{}
+	}
+	return searchTerm{filter: "profile." + a.attr + " " + ops[0] + " " + b.literal}
+}
+
+func (t *searchTranslator) translateUnary() searchTerm {
+	if op := t.peek(); op.kind == tokenOperator && (op.text == "!" || op.text == "-") {
+		t.next()
+		t.translateUnary()
+		return searchTerm{}
+	}
+	return t.translatePostfix()
+}
+
+func (t *searchTranslator) translatePostfix() searchTerm {
+	first := t.peek()
+	var term searchTerm
+	switch {
+	case first.kind == tokenString:
+		t.next()
+		term = searchTerm{literal: `"` + strings.Replace(first.text, `"`, `\"`, -1) + `"`}
+	case first.kind == tokenNumber:
+		t.next()
+		term = searchTerm{literal: first.text, number: true}
+	case first.kind == tokenPunct && first.text == "(":
+		t.next()
+		term = t.translateExpr()
+		t.expectClose("(", ")", first.pos)
+	case first.kind == tokenIdent && first.text == "user":
+		t.next()
+		if !t.accept(tokenPunct, ".") {
+			t.skipPostfix()
+			return searchTerm{}
+		}
+		name := t.next()
+		if name.kind != tokenIdent {
+			t.fail(name.pos, "expected an attribute name after \".\"")
+		}
+		term = searchTerm{attr: name.text}
+	default:
+		t.parsePostfix()
+		return searchTerm{}
+	}
+
+	// An operand followed by an attribute name, a call or an index can't be translated.
+	if !t.skipPostfix() {
+		return term
+	}
+	return searchTerm{}
+}
+
+// skipPostfix consumes the attribute names, calls and indexes following an operand, and reports
+// whether there were any.
+func (t *searchTranslator) skipPostfix() bool {
+	skipped := false
+	for {
+		switch next := t.peek(); {
+		case t.accept(tokenPunct, "."):
+			if name := t.next(); name.kind != tokenIdent {
+				t.fail(name.pos, "expected an attribute name after \".\"")
+			}
+		case next.kind == tokenPunct && next.text == "(":
+			t.parseArgs(t.next())
+		case next.kind == tokenPunct && next.text == "[":
+			t.next()
+			t.parseExpr()
+			t.expectClose("[", "]", next.pos)
+		default:
+			return skipped
+		}
+		skipped = true
+	}
+}
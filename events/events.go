@@ -0,0 +1,245 @@
+// Package events delivers Okta events to application code, regardless of how they arrive.
+//
+// A Bus receives events either by polling the System Log or from Okta event hooks, depending on
+// its Config, and fans them out to subscriptions:
+//
+//	bus, err := events.New(events.Config{Transport: events.TransportPoll, Client: client})
+//	ch, err := bus.Subscribe("user.lifecycle.create", "user.lifecycle.deactivate")
+//	go bus.Run(ctx)
+//	for e := range ch {
+//		...
+//	}
+//
+// With TransportHook, Handler() must be served at the URL of the event hook configured in Okta.
+package events
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/austinylin/go-okta/okta"
+)
+
+// Transport is how a Bus receives events.
+type Transport string
+
+// Transport Constants
+const (
+	// TransportPoll polls the System Log.
+	TransportPoll Transport = "poll"
+	// TransportHook receives the deliveries of an Okta event hook.
+	TransportHook Transport = "hook"
+)
+
+// Event is an event delivered by a Bus.
+type Event struct {
+	*okta.LogEvent
+	// Transport is how the event arrived.
+	Transport Transport
+}
+
+// Config configures a Bus.
+type Config struct {
+	Transport Transport
+
+	// Client is used to poll the System Log, with TransportPoll.
+	Client *okta.Client
+	// Since is how far back polling starts. Defaults to now.
+	Since time.Time
+	// PollInterval is how often the System Log is polled once caught up.
+	PollInterval time.Duration
+
+	// Authorization is the expected value of the Authorization header of event hook deliveries,
+	// with TransportHook. If empty the header isn't checked.
+	Authorization string
+	// Secret, if set, is checked instead of Authorization, and can be rotated while the Bus runs,
+	// see okta.EventHooksService.RotateSecret().
+	Secret *okta.HookSecret
+
+	// BufferSize is the capacity of the channels returned by Subscribe. Defaults to 100.
+	BufferSize int
+}
+
+// Bus fans out Okta events to subscriptions.
+type Bus struct {
+	config Config
+
+	mu            sync.RWMutex
+	subscriptions []*subscription
+	closed        bool
+}
+
+// subscription is a single call to Subscribe.
+type subscription struct {
+	eventTypes map[string]bool
+	ch         chan Event
+	// done is closed when the bus closes, to release the deliveries blocked on ch before ch is
+	// closed; mu is held by the deliveries, and by close to close ch once they are done.
+	done   chan struct{}
+	mu     sync.RWMutex
+	closed bool
+}
+
+// New creates a new Bus.
+func New(config Config) (*Bus, error) {
+	switch config.Transport {
+	case TransportPoll:
+		if config.Client == nil {
+			return nil, errors.New("Invalid parameters, `Client` must be set with TransportPoll")
+		}
+	case TransportHook:
+	default:
+		return nil, errors.New("Invalid parameters, `Transport` must be TransportPoll or TransportHook")
+	}
+
+	if config.BufferSize <= 0 {
+		config.BufferSize = 100
+	}
+	if config.Since.IsZero() {
+		config.Since = time.Now()
+	}
+	return &Bus{config: config}, nil
+}
+
+// Subscribe returns a channel receiving the events of eventTypes, or all events if none are given.
+// The channel is closed when Run returns. Delivery blocks while the channel is full, so
+// subscribers must keep up.
+func (b *Bus) Subscribe(eventTypes ...string) (<-chan Event, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil, errors.New("bus is closed")
+	}
+
+	s := &subscription{ch: make(chan Event, b.config.BufferSize), done: make(chan struct{})}
+	if len(eventTypes) > 0 {
+		s.eventTypes = make(map[string]bool, len(eventTypes))
+		for _, t := range eventTypes {
+			s.eventTypes[t] = true
+		}
+	}
+	b.subscriptions = append(b.subscriptions, s)
+	return s.ch, nil
+}
+
+// Run receives events until ctx is done, and then closes the subscriptions. With TransportHook
+// events are received by Handler(), and Run only waits for ctx.
+func (b *Bus) Run(ctx context.Context) error {
+	defer b.close()
+
+	if b.config.Transport == TransportHook {
+		<-ctx.Done()
+		return nil
+	}
+
+	poller := okta.NewLogPoller(b.config.Client, &okta.LogListParams{Since: b.config.Since})
+	if b.config.PollInterval > 0 {
+		poller.Interval = b.config.PollInterval
+	}
+
+	err := poller.Run(ctx, func(events []*okta.LogEvent) error {
+		return b.publish(ctx, events, TransportPoll)
+	})
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// Handler returns the http.Handler receiving the deliveries of the event hook, including its one
+// time verification request.
+func (b *Bus) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if b.config.Secret != nil && !b.config.Secret.ValidateRequest(r) ||
+			b.config.Secret == nil && b.config.Authorization != "" &&
+				subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(b.config.Authorization)) != 1 {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(okta.EventHookVerificationResponse{
+				Verification: r.Header.Get(okta.EventHookVerificationHeader),
+			})
+		case http.MethodPost:
+			hookReq := new(okta.EventHookRequest)
+			if err := json.NewDecoder(r.Body).Decode(hookReq); err != nil {
+				http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+				return
+			}
+			if err := b.publish(r.Context(), hookReq.Data.Events, TransportHook); err != nil {
+				http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// publish delivers events to the matching subscriptions. The bus isn't locked while delivering,
+// so that a subscriber not keeping up doesn't hold back Subscribe, nor the closing of the bus.
+func (b *Bus) publish(ctx context.Context, events []*okta.LogEvent, transport Transport) error {
+	b.mu.RLock()
+	closed, subscriptions := b.closed, b.subscriptions
+	b.mu.RUnlock()
+	if closed {
+		return errors.New("bus is closed")
+	}
+
+	for _, e := range events {
+		for _, s := range subscriptions {
+			if s.eventTypes != nil && !s.eventTypes[e.EventType] {
+				continue
+			}
+			if err := s.send(ctx, Event{LogEvent: e, Transport: transport}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// send delivers e to the subscription, blocking while its channel is full, until ctx is done or
+// the bus closes.
+func (s *subscription) send(ctx context.Context, e Event) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return errors.New("bus is closed")
+	}
+
+	select {
+	case s.ch <- e:
+		return nil
+	case <-s.done:
+		return errors.New("bus is closed")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// close closes the subscriptions.
+func (b *Bus) close() {
+	b.mu.Lock()
+	b.closed = true
+	subscriptions := b.subscriptions
+	b.subscriptions = nil
+	b.mu.Unlock()
+
+	for _, s := range subscriptions {
+		close(s.done)
+		s.mu.Lock()
+		s.closed = true
+		close(s.ch)
+		s.mu.Unlock()
+	}
+}
@@ -0,0 +1,207 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/austinylin/go-okta/okta"
+)
+
+// Checkpoint represents the progress of a long running job, such as an Export or an Apply, so
+// that the job can resume after an interruption instead of restarting.
+type Checkpoint struct {
+	// Job identifies the job the checkpoint belongs to. A job ignores the checkpoints of others.
+	Job string `json:"job"`
+	// Stage is the unit of work in progress, e.g. the resource being exported.
+	Stage string `json:"stage,omitempty"`
+	// Done are the stages already completed.
+	Done []string `json:"done,omitempty"`
+	// Cursor is the position in the paginated list of Stage, as returned by
+	// okta.Collection.Cursor(). It is empty at the beginning of the stage.
+	Cursor string `json:"cursor,omitempty"`
+	// LastID is the ID of the last resource processed.
+	LastID string `json:"lastId,omitempty"`
+	// Rate is the rate limit reported by the last request, so that a resumed job doesn't start
+	// with a burst the org would reject.
+	Rate okta.Rate `json:"rate"`
+	// State is the job specific state, e.g. the resources exported so far.
+	State json.RawMessage `json:"state,omitempty"`
+	// Updated is when the checkpoint was saved.
+	Updated time.Time `json:"updated"`
+}
+
+// done reports whether stage is completed.
+func (c *Checkpoint) done(stage string) bool {
+	for _, s := range c.Done {
+		if s == stage {
+			return true
+		}
+	}
+	return false
+}
+
+// Checkpointer persists the Checkpoint of a job.
+type Checkpointer interface {
+	// Load returns the saved checkpoint, or nil if there is none.
+	Load(ctx context.Context) (*Checkpoint, error)
+	// Save persists cp, replacing the saved checkpoint.
+	Save(ctx context.Context, cp *Checkpoint) error
+	// Clear removes the saved checkpoint, once the job is completed.
+	Clear(ctx context.Context) error
+}
+
+// FileCheckpointer persists a Checkpoint as JSON in a file. The file is replaced atomically, so a
+// crash never leaves a partial checkpoint behind.
+type FileCheckpointer struct {
+	Path string
+}
+
+// NewFileCheckpointer is a helper method to create a new FileCheckpointer.
+func NewFileCheckpointer(path string) *FileCheckpointer {
+	return &FileCheckpointer{Path: path}
+}
+
+// Load implements the Checkpointer interface.
+func (c *FileCheckpointer) Load(ctx context.Context) (*Checkpoint, error) {
+	data, err := ioutil.ReadFile(c.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decodeCheckpoint(data)
+}
+
+// Save implements the Checkpointer interface.
+func (c *FileCheckpointer) Save(ctx context.Context, cp *Checkpoint) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(c.Path), filepath.Base(c.Path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), c.Path)
+}
+
+// Clear implements the Checkpointer interface.
+func (c *FileCheckpointer) Clear(ctx context.Context) error {
+	if err := os.Remove(c.Path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// CheckpointStore is a key value store, such as a database table, a Redis instance or an object
+// storage bucket, in which a StoreCheckpointer persists checkpoints.
+type CheckpointStore interface {
+	// Get returns the value of key, or nil if there is none.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Put sets the value of key.
+	Put(ctx context.Context, key string, value []byte) error
+	// Delete removes key. Deleting a missing key isn't an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// StoreCheckpointer persists a Checkpoint as JSON under a key of a CheckpointStore, so that
+// several jobs, or the same job on different hosts, can share a store.
+type StoreCheckpointer struct {
+	Store CheckpointStore
+	Key   string
+}
+
+// NewStoreCheckpointer is a helper method to create a new StoreCheckpointer.
+func NewStoreCheckpointer(store CheckpointStore, key string) *StoreCheckpointer {
+	return &StoreCheckpointer{Store: store, Key: key}
+}
+
+// Load implements the Checkpointer interface.
+func (c *StoreCheckpointer) Load(ctx context.Context) (*Checkpoint, error) {
+	data, err := c.Store.Get(ctx, c.Key)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return decodeCheckpoint(data)
+}
+
+// Save implements the Checkpointer interface.
+func (c *StoreCheckpointer) Save(ctx context.Context, cp *Checkpoint) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	return c.Store.Put(ctx, c.Key, data)
+}
+
+// Clear implements the Checkpointer interface.
+func (c *StoreCheckpointer) Clear(ctx context.Context) error {
+	return c.Store.Delete(ctx, c.Key)
+}
+
+// MemoryStore is a CheckpointStore in memory, for tests and for jobs that only need to survive
+// transient errors within a process.
+type MemoryStore struct {
+	mu     sync.Mutex
+	values map[string][]byte
+}
+
+// NewMemoryStore is a helper method to create a new MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{values: make(map[string][]byte)}
+}
+
+// Get implements the CheckpointStore interface.
+func (s *MemoryStore) Get(ctx context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.values[key], nil
+}
+
+// Put implements the CheckpointStore interface.
+func (s *MemoryStore) Put(ctx context.Context, key string, value []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values[key] = append([]byte(nil), value...)
+	return nil
+}
+
+// Delete implements the CheckpointStore interface.
+func (s *MemoryStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.values, key)
+	return nil
+}
+
+func decodeCheckpoint(data []byte) (*Checkpoint, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+	cp := new(Checkpoint)
+	if err := json.Unmarshal(data, cp); err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
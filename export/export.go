@@ -0,0 +1,331 @@
+// Package export takes snapshots of the configuration of an Okta org.
+//
+// A Snapshot is a normalized JSON, or YAML, document of the apps, groups, group rules, policies,
+// network zones and Identity Providers of an org. Volatile attributes such as timestamps and links
+// are left out and resources are sorted, so that two snapshots of the same configuration are
+// identical. Snapshots are suitable for backups, drift detection and comparing orgs.
+//
+// Exports of large orgs can take hours. An Exporter with a Checkpointer, e.g. a FileCheckpointer,
+// saves its progress after every page, and resumes an interrupted export where it stopped.
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/austinylin/go-okta/okta"
+)
+
+// Resource is a type of resource that can be exported.
+type Resource string
+
+// Resource Constants
+const (
+	ResourceApps              Resource = "apps"
+	ResourceGroups                     = "groups"
+	ResourceGroupRules                 = "groupRules"
+	ResourcePolicies                   = "policies"
+	ResourceNetworkZones               = "networkZones"
+	ResourceIdentityProviders          = "identityProviders"
+)
+
+// AllResources is the list of all the resources that can be exported.
+var AllResources = []Resource{
+	ResourceApps,
+	ResourceGroups,
+	ResourceGroupRules,
+	ResourcePolicies,
+	ResourceNetworkZones,
+	ResourceIdentityProviders,
+}
+
+// exportJob is the Checkpoint.Job of Export.
+const exportJob = "export"
+
+// Exporter takes snapshots of an org.
+type Exporter struct {
+	Client *okta.Client
+	// Resources are the resources to export. Defaults to AllResources.
+	Resources []Resource
+	// Checkpointer, if set, persists the progress of Export so that it can resume after an
+	// interruption.
+	Checkpointer Checkpointer
+	// MinRemaining is the number of requests left in the current rate limit window below which the
+	// exporter waits for the window to reset before making the next request, leaving room for
+	// other clients of the org.
+	MinRemaining int
+	// MaxRateLimitRetries is the number of times a request rejected by the rate limit is retried
+	// after the rate limit window resets.
+	MaxRateLimitRetries int
+	// Progress, if set, is called after every page of a resource is fetched, e.g. to report the
+	// progress of a long export. The counts of a resumed listing start over.
+	Progress func(r Resource, p okta.Progress)
+}
+
+// NewExporter is a helper method to create a new Exporter exporting all resources.
+func NewExporter(client *okta.Client) *Exporter {
+	return &Exporter{
+		Client:              client,
+		Resources:           AllResources,
+		MinRemaining:        5,
+		MaxRateLimitRetries: 3,
+	}
+}
+
+// Export takes a snapshot of the org. If the Exporter has a Checkpointer, its progress is saved
+// after every page and every resource, and an interrupted Export resumes where it stopped.
+func (e *Exporter) Export(ctx context.Context) (*Snapshot, error) {
+	s := &Snapshot{
+		Version: SnapshotVersion,
+		Org:     e.Client.BaseURL.Host,
+	}
+	cp, err := e.resume(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := e.Resources
+	if len(resources) == 0 {
+		resources = AllResources
+	}
+
+	for _, r := range resources {
+		if cp.done(string(r)) {
+			continue
+		}
+		if cp.Stage != string(r) {
+			cp.Stage, cp.Cursor, cp.LastID = string(r), "", ""
+		}
+
+		var err error
+		switch r {
+		case ResourceApps:
+			err = listAll(ctx, e, cp, s, &s.Apps, func(a *okta.App) string { return a.ID },
+				func() (*okta.Collection[*okta.App], *okta.Response, error) {
+					return e.Client.Apps.List(ctx, nil)
+				})
+		case ResourceGroups:
+			err = listAll(ctx, e, cp, s, &s.Groups, func(g *okta.Group) string { return g.ID },
+				func() (*okta.Collection[*okta.Group], *okta.Response, error) {
+					return e.Client.Groups.List(ctx, nil)
+				})
+		case ResourceGroupRules:
+			err = listAll(ctx, e, cp, s, &s.GroupRules, func(r *okta.GroupRule) string { return r.ID },
+				func() (*okta.Collection[*okta.GroupRule], *okta.Response, error) {
+					return e.Client.Groups.ListRules(ctx, "")
+				})
+		case ResourcePolicies:
+			s.Policies, err = e.exportPolicies(ctx)
+		case ResourceNetworkZones:
+			err = listAll(ctx, e, cp, s, &s.NetworkZones, func(z *okta.NetworkZone) string { return z.ID },
+				func() (*okta.Collection[*okta.NetworkZone], *okta.Response, error) {
+					return e.Client.NetworkZones.List(ctx)
+				})
+		case ResourceIdentityProviders:
+			err = listAll(ctx, e, cp, s, &s.IdentityProviders, func(p *okta.IdentityProvider) string { return p.ID },
+				func() (*okta.Collection[*okta.IdentityProvider], *okta.Response, error) {
+					return e.Client.IdentityProviders.List(ctx, nil)
+				})
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		cp.Done = append(cp.Done, cp.Stage)
+		cp.Stage, cp.Cursor, cp.LastID = "", "", ""
+		if err := e.checkpoint(ctx, cp, s); err != nil {
+			return nil, err
+		}
+	}
+
+	if e.Checkpointer != nil {
+		if err := e.Checkpointer.Clear(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	s.sort()
+	return s, nil
+}
+
+// resume loads the checkpoint of an interrupted Export of the same org into s, and waits for the
+// rate limit window to reset if the interrupted Export had exhausted it. It returns a new
+// checkpoint if there is nothing to resume.
+func (e *Exporter) resume(ctx context.Context, s *Snapshot) (*Checkpoint, error) {
+	fresh := &Checkpoint{Job: exportJob}
+	if e.Checkpointer == nil {
+		return fresh, nil
+	}
+
+	cp, err := e.Checkpointer.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cp == nil || cp.Job != exportJob {
+		return fresh, nil
+	}
+
+	saved := new(Snapshot)
+	if err := json.Unmarshal(cp.State, saved); err != nil {
+		return nil, fmt.Errorf("decoding the checkpoint state: %v", err)
+	}
+	if saved.Version != s.Version || saved.Org != s.Org {
+		return fresh, nil
+	}
+	*s = *saved
+
+	if cp.Rate.Limit > 0 && cp.Rate.Remaining <= e.MinRemaining {
+		if err := waitUntil(ctx, cp.Rate.Reset.Time); err != nil {
+			return nil, err
+		}
+	}
+	return cp, nil
+}
+
+// checkpoint saves cp, with the snapshot s taken so far, if the Exporter has a Checkpointer.
+func (e *Exporter) checkpoint(ctx context.Context, cp *Checkpoint, s *Snapshot) error {
+	if e.Checkpointer == nil {
+		return nil
+	}
+
+	state, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	cp.State = state
+	cp.Updated = time.Now()
+	return e.Checkpointer.Save(ctx, cp)
+}
+
+// exportPolicies exports the policies of every type, with their rules. Policy types that
+// aren't available in the org are skipped.
+func (e *Exporter) exportPolicies(ctx context.Context) ([]*Policy, error) {
+	var policies []*Policy
+	for _, policyType := range okta.PolicyTypes {
+		var typePolicies []*okta.Policy
+		err := e.call(ctx, func() (resp *okta.Response, err error) {
+			typePolicies, resp, err = e.Client.Policies.List(ctx, policyType)
+			return resp, err
+		})
+		if errResp, ok := err.(*okta.ErrorResponse); ok && errResp.Response.StatusCode == http.StatusBadRequest {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range typePolicies {
+			policy := &Policy{Policy: p}
+			err := e.call(ctx, func() (resp *okta.Response, err error) {
+				policy.Rules, resp, err = e.Client.Policies.ListRules(ctx, p.ID)
+				return resp, err
+			})
+			if err != nil {
+				return nil, err
+			}
+			policies = append(policies, policy)
+		}
+	}
+
+	return policies, nil
+}
+
+// call makes the requests of fn, pacing them by the rate limit of the org. Requests rejected by
+// the rate limit are retried once the rate limit window resets.
+func (e *Exporter) call(ctx context.Context, fn func() (*okta.Response, error)) error {
+	for retries := 0; ; retries++ {
+		resp, err := fn()
+		if rateErr, ok := err.(*okta.RateLimitError); ok && retries < e.MaxRateLimitRetries {
+			if err := waitUntil(ctx, rateErr.Rate.Reset.Time); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if resp != nil && resp.Rate.Limit > 0 && resp.Rate.Remaining <= e.MinRemaining {
+			return waitUntil(ctx, resp.Rate.Reset.Time)
+		}
+		return nil
+	}
+}
+
+// listAll fetches all pages of the collection returned by list into dst, pacing the requests with
+// call() and saving a checkpoint after every page. If cp has a cursor, the first page is fetched
+// again only to resume the listing at the cursor, its items being in dst already. If a request
+// fails, or ctx is done between pages, the error is an *okta.PartialResultError with the items
+// listed until then.
+func listAll[T any](ctx context.Context, e *Exporter, cp *Checkpoint, s *Snapshot, dst *[]T, id func(T) string, list func() (*okta.Collection[T], *okta.Response, error)) error {
+	if cp.Cursor == "" {
+		*dst = nil
+	}
+	var page *okta.Collection[T]
+	err := e.call(ctx, func() (resp *okta.Response, err error) {
+		page, resp, err = list()
+		return resp, err
+	})
+	if err != nil {
+		return &okta.PartialResultError[T]{Items: *dst, Err: err}
+	}
+
+	if cp.Cursor != "" {
+		page = page.Resume(cp.Cursor)
+	}
+
+	for {
+		if len(page.Items) > 0 {
+			*dst = append(*dst, page.Items...)
+			cp.Cursor = page.Cursor()
+			cp.LastID = id(page.Items[len(page.Items)-1])
+			if page.Response != nil {
+				cp.Rate = page.Response.Rate
+			}
+			if err := e.checkpoint(ctx, cp, s); err != nil {
+				return err
+			}
+		}
+		if e.Progress != nil {
+			e.Progress(Resource(cp.Stage), page.Progress())
+		}
+		if !page.HasNextPage() {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return &okta.PartialResultError[T]{Items: *dst, Err: err}
+		}
+
+		err := e.call(ctx, func() (*okta.Response, error) {
+			next, err := page.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+			page = next
+			return next.Response, nil
+		})
+		if err != nil {
+			return &okta.PartialResultError[T]{Items: *dst, Err: err}
+		}
+	}
+}
+
+// waitUntil blocks until t, or until ctx is done.
+func waitUntil(ctx context.Context, t time.Time) error {
+	d := time.Until(t)
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
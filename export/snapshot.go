@@ -0,0 +1,167 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/austinylin/go-okta/okta"
+)
+
+// SnapshotVersion is the version of the snapshot format written by this package.
+const SnapshotVersion = 1
+
+// volatileAttributes are left out of snapshots, as they change without the configuration changing.
+var volatileAttributes = map[string]bool{
+	"_links":                true,
+	"created":               true,
+	"lastUpdated":           true,
+	"lastMembershipUpdated": true,
+}
+
+// Snapshot represents the configuration of an org at a point in time.
+type Snapshot struct {
+	Version           int                      `json:"version"`
+	Org               string                   `json:"org"`
+	Apps              []*okta.App              `json:"apps,omitempty"`
+	Groups            []*okta.Group            `json:"groups,omitempty"`
+	GroupRules        []*okta.GroupRule        `json:"groupRules,omitempty"`
+	Policies          []*Policy                `json:"policies,omitempty"`
+	NetworkZones      []*okta.NetworkZone      `json:"networkZones,omitempty"`
+	IdentityProviders []*okta.IdentityProvider `json:"identityProviders,omitempty"`
+}
+
+// Policy represents a policy together with its rules.
+type Policy struct {
+	*okta.Policy
+	Rules []*okta.PolicyRule `json:"rules,omitempty"`
+}
+
+// Read decodes a snapshot written by WriteJSON.
+func Read(r io.Reader) (*Snapshot, error) {
+	s := new(Snapshot)
+	if err := json.NewDecoder(r).Decode(s); err != nil {
+		return nil, err
+	}
+	if s.Version != SnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d", s.Version)
+	}
+
+	s.sort()
+	return s, nil
+}
+
+// WriteJSON writes the normalized snapshot as indented JSON.
+func (s *Snapshot) WriteJSON(w io.Writer) error {
+	v, err := s.normalized()
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// WriteYAML writes the normalized snapshot as YAML.
+func (s *Snapshot) WriteYAML(w io.Writer) error {
+	v, err := s.normalized()
+	if err != nil {
+		return err
+	}
+
+	buf := new(bytes.Buffer)
+	writeYAML(buf, v, 0)
+	_, err = buf.WriteTo(w)
+	return err
+}
+
+// normalized returns the snapshot as generic JSON values, without the volatile attributes.
+func (s *Snapshot) normalized() (interface{}, error) {
+	return Normalize(s)
+}
+
+// Normalize converts v, usually a resource, to generic JSON values without the volatile attributes,
+// as they are written to snapshots. Numbers are converted to json.Number.
+func Normalize(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var generic interface{}
+	if err := dec.Decode(&generic); err != nil {
+		return nil, err
+	}
+
+	return stripVolatile(generic), nil
+}
+
+// stripVolatile removes the volatile attributes from v, recursively.
+func stripVolatile(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			if volatileAttributes[k] {
+				delete(v, k)
+				continue
+			}
+			v[k] = stripVolatile(child)
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = stripVolatile(child)
+		}
+	}
+	return v
+}
+
+// sort orders the resources of the snapshot by name, and then ID, so that snapshots are stable.
+func (s *Snapshot) sort() {
+	sort.SliceStable(s.Apps, func(i, j int) bool {
+		return less(s.Apps[i].Label, s.Apps[j].Label, s.Apps[i].ID, s.Apps[j].ID)
+	})
+	sort.SliceStable(s.Groups, func(i, j int) bool {
+		return less(s.Groups[i].Profile.Name, s.Groups[j].Profile.Name, s.Groups[i].ID, s.Groups[j].ID)
+	})
+	sort.SliceStable(s.GroupRules, func(i, j int) bool {
+		return less(s.GroupRules[i].Name, s.GroupRules[j].Name, s.GroupRules[i].ID, s.GroupRules[j].ID)
+	})
+	sort.SliceStable(s.Policies, func(i, j int) bool {
+		a, b := s.Policies[i], s.Policies[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Priority != b.Priority {
+			return a.Priority < b.Priority
+		}
+		return less(a.Name, b.Name, a.ID, b.ID)
+	})
+	for _, p := range s.Policies {
+		sort.SliceStable(p.Rules, func(i, j int) bool {
+			if p.Rules[i].Priority != p.Rules[j].Priority {
+				return p.Rules[i].Priority < p.Rules[j].Priority
+			}
+			return less(p.Rules[i].Name, p.Rules[j].Name, p.Rules[i].ID, p.Rules[j].ID)
+		})
+	}
+	sort.SliceStable(s.NetworkZones, func(i, j int) bool {
+		return less(s.NetworkZones[i].Name, s.NetworkZones[j].Name, s.NetworkZones[i].ID, s.NetworkZones[j].ID)
+	})
+	sort.SliceStable(s.IdentityProviders, func(i, j int) bool {
+		return less(s.IdentityProviders[i].Name, s.IdentityProviders[j].Name, s.IdentityProviders[i].ID, s.IdentityProviders[j].ID)
+	})
+}
+
+// less orders by name, and then by ID.
+func less(nameA, nameB, idA, idB string) bool {
+	if nameA != nameB {
+		return nameA < nameB
+	}
+	return idA < idB
+}
@@ -0,0 +1,110 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// plainKey matches the mapping keys that don't need to be quoted in YAML.
+var plainKey = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+
+// writeYAML writes v, a generic JSON value, as a YAML block at the given indentation. Strings are
+// written as double quoted scalars, whose escapes are a superset of JSON's.
+func writeYAML(buf *bytes.Buffer, v interface{}, indent int) {
+	pad := strings.Repeat(" ", indent)
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			buf.WriteString(pad + "{}\n")
+			return
+		}
+
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			buf.WriteString(pad + yamlKey(k) + ":")
+			if isBlock(v[k]) {
+				buf.WriteString("\n")
+				writeYAML(buf, v[k], indent+2)
+			} else {
+				buf.WriteString(" " + yamlScalar(v[k]) + "\n")
+			}
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			buf.WriteString(pad + "[]\n")
+			return
+		}
+
+		for _, item := range v {
+			if !isBlock(item) {
+				buf.WriteString(pad + "- " + yamlScalar(item) + "\n")
+				continue
+			}
+
+			// Render the item one level deeper, and turn the indentation of its first line into the "- ".
+			child := new(bytes.Buffer)
+			writeYAML(child, item, indent+2)
+			buf.WriteString(pad + "- ")
+			buf.Write(child.Bytes()[indent+2:])
+		}
+	default:
+		buf.WriteString(pad + yamlScalar(v) + "\n")
+	}
+}
+
+// isBlock reports whether v is written as a YAML block rather than a scalar.
+func isBlock(v interface{}) bool {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return len(v) > 0
+	case []interface{}:
+		return len(v) > 0
+	}
+	return false
+}
+
+// yamlKey returns k as a YAML mapping key.
+func yamlKey(k string) string {
+	if plainKey.MatchString(k) && !isReserved(k) {
+		return k
+	}
+	return yamlScalar(k)
+}
+
+// isReserved reports whether the plain scalar s would be read as something other than a string.
+func isReserved(s string) bool {
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "y", "n", "null":
+		return true
+	}
+	return false
+}
+
+// yamlScalar returns v, a JSON scalar or an empty collection, as a YAML flow scalar.
+func yamlScalar(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "{}"
+	case []interface{}:
+		return "[]"
+	case json.Number:
+		return v.String()
+	}
+
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.Encode(v)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
@@ -0,0 +1,397 @@
+// Package fake generates random, valid Okta models, e.g. to load-test systems consuming Okta data
+// or to populate a mock server.
+//
+// Generated models have well-formed IDs, respect the enum values and required attributes of the
+// API, and carry consistent timestamps. A Generator is deterministic for a given seed.
+package fake
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/austinylin/go-okta/okta"
+)
+
+// Generator generates random models. It is not safe for concurrent use.
+type Generator struct {
+	// Domain is the email domain of generated users, example.com by default.
+	Domain string
+	// Now is the upper bound of generated timestamps, the time New() was called by default.
+	Now time.Time
+
+	rand *rand.Rand
+	seq  int
+}
+
+// New returns a Generator seeded with seed.
+func New(seed int64) *Generator {
+	return &Generator{
+		Domain: "example.com",
+		Now:    time.Now().UTC().Truncate(time.Second),
+		rand:   rand.New(rand.NewSource(seed)),
+	}
+}
+
+// ID prefixes of Okta objects.
+const (
+	userIDPrefix  = "00u"
+	groupIDPrefix = "00g"
+	appIDPrefix   = "0oa"
+	keyIDPrefix   = "akm"
+)
+
+const idAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+// ID returns a random 20 character Okta ID with prefix, e.g. "00u" for users.
+func (g *Generator) ID(prefix string) string {
+	b := []byte(prefix)
+	for len(b) < 20 {
+		b = append(b, idAlphabet[g.rand.Intn(len(idAlphabet))])
+	}
+	return string(b)
+}
+
+// UserStatuses are the statuses of generated users, see User.
+var UserStatuses = []string{"STAGED", "PROVISIONED", "ACTIVE", "RECOVERY", "PASSWORD_EXPIRED", "LOCKED_OUT", "SUSPENDED", "DEPROVISIONED"}
+
+var (
+	firstNames  = []string{"Ada", "Alan", "Barbara", "Claude", "Dennis", "Edsger", "Frances", "Grace", "Hedy", "Ken", "Katherine", "Linus", "Margaret", "Radia", "Tim", "Whitfield"}
+	lastNames   = []string{"Allen", "Berners-Lee", "Diffie", "Dijkstra", "Hamilton", "Hopper", "Johnson", "Kernighan", "Lamarr", "Liskov", "Lovelace", "Perlman", "Ritchie", "Shannon", "Thompson", "Turing"}
+	departments = []string{"Engineering", "Finance", "Legal", "Marketing", "Operations", "Sales", "Support"}
+	titles      = []string{"Analyst", "Director", "Engineer", "Manager", "Specialist"}
+	cities      = []struct{ city, state, zip string }{
+		{"San Francisco", "CA", "94107"},
+		{"Austin", "TX", "78701"},
+		{"New York", "NY", "10001"},
+		{"Seattle", "WA", "98101"},
+		{"Chicago", "IL", "60601"},
+	}
+)
+
+// User returns a random user. Users are mostly ACTIVE, and only ACTIVE users have a last login.
+// Logins are unique within the Generator.
+func (g *Generator) User() *okta.User {
+	first, last := g.pick(firstNames), g.pick(lastNames)
+	login := fmt.Sprintf("%s.%s.%d@%s", strings.ToLower(first), strings.ToLower(strings.Replace(last, "-", "", -1)), g.next(), g.Domain)
+	city := cities[g.rand.Intn(len(cities))]
+
+	u := new(okta.User)
+	u.ID = g.ID(userIDPrefix)
+	u.Status = "ACTIVE"
+	if g.rand.Intn(4) == 0 {
+		u.Status = g.pick(UserStatuses)
+	}
+
+	u.Created = g.timeBefore(g.Now, 3*365*24*time.Hour)
+	if u.Status != "STAGED" {
+		u.Activated = g.timeBetween(u.Created, g.Now)
+		u.StatusChanged = u.Activated
+		u.PasswordChanged = u.Activated
+	}
+	if u.Status != "STAGED" && u.Status != "ACTIVE" {
+		u.StatusChanged = g.timeBetween(u.Activated, g.Now)
+	}
+	if u.Status == "ACTIVE" {
+		u.LastLogin = g.timeBetween(u.Activated, g.Now)
+	}
+	u.LastUpdated = latest(u.Created, u.StatusChanged, u.PasswordChanged)
+
+	u.Profile = okta.UserProfile{
+		Login:          login,
+		Email:          login,
+		FirstName:      first,
+		LastName:       last,
+		DisplayName:    first + " " + last,
+		Title:          g.pick(titles),
+		Department:     g.pick(departments),
+		EmployeeNumber: fmt.Sprintf("%d", 1000+g.rand.Intn(9000)),
+		MobilePhone:    fmt.Sprintf("+1-555-%03d-%04d", g.rand.Intn(1000), g.rand.Intn(10000)),
+		City:           city.city,
+		State:          city.state,
+		ZipCode:        city.zip,
+		CountryCode:    "US",
+	}
+	u.Credentials.Provider.Type = "OKTA"
+	u.Credentials.Provider.Name = "OKTA"
+	return u
+}
+
+// Users returns n random users.
+func (g *Generator) Users(n int) []*okta.User {
+	users := make([]*okta.User, n)
+	for i := range users {
+		users[i] = g.User()
+	}
+	return users
+}
+
+// Group returns a random OKTA_GROUP or, one time in five, an APP_GROUP imported from Active
+// Directory. BUILT_IN groups aren't generated, an org has exactly one. Names are unique within
+// the Generator.
+func (g *Generator) Group() *okta.Group {
+	name := fmt.Sprintf("%s %s %d", g.pick(departments), g.pick([]string{"Admins", "Contractors", "Team", "Users"}), g.next())
+
+	grp := new(okta.Group)
+	grp.ID = g.ID(groupIDPrefix)
+	grp.Created = okta.Timestamp{Time: g.timeBefore(g.Now, 3*365*24*time.Hour)}
+	grp.LastUpdated = okta.Timestamp{Time: g.timeBetween(grp.Created.Time, g.Now)}
+	grp.LastMembershipUpdated = okta.Timestamp{Time: g.timeBetween(grp.Created.Time, g.Now)}
+	grp.Type = okta.GroupTypeOkta
+	grp.ObjectClass = []string{"okta:user_group"}
+	grp.Profile.Name = name
+	grp.Profile.Description = "Members of " + name
+
+	if g.rand.Intn(5) == 0 {
+		grp.Type = okta.GroupTypeApp
+		grp.ObjectClass = []string{"okta:windows_security_principal"}
+		grp.Profile.SamAccountName = name
+		grp.Profile.DN = fmt.Sprintf("CN=%s,OU=Groups,DC=corp,DC=example,DC=com", name)
+		grp.Profile.WindowsDomainQualifiedName = `CORP\` + name
+		grp.Profile.ExternalID = g.ID("")
+	}
+	return grp
+}
+
+// Groups returns n random groups.
+func (g *Generator) Groups(n int) []*okta.Group {
+	groups := make([]*okta.Group, n)
+	for i := range groups {
+		groups[i] = g.Group()
+	}
+	return groups
+}
+
+// App returns a random bookmark, custom SAML 2.0 or OpenID Connect application, mostly ACTIVE.
+// Labels are unique within the Generator.
+func (g *Generator) App() *okta.App {
+	slug := fmt.Sprintf("%s%d", strings.ToLower(g.pick(departments)), g.next())
+
+	app := new(okta.App)
+	app.ID = g.ID(appIDPrefix)
+	app.Created = okta.Timestamp{Time: g.timeBefore(g.Now, 3*365*24*time.Hour)}
+	app.LastUpdated = okta.Timestamp{Time: g.timeBetween(app.Created.Time, g.Now)}
+	app.Status = okta.AppStatusActive
+	if g.rand.Intn(5) == 0 {
+		app.Status = okta.AppStatusInactive
+	}
+	app.Features = []okta.AppFeatureName{}
+	app.Visibility = okta.NewAppVisability()
+	app.Credentials.UserNameTemplate.Template = "${source.login}"
+	app.Credentials.UserNameTemplate.Type = "BUILT_IN"
+
+	url := fmt.Sprintf("https://%s.%s", slug, g.Domain)
+	switch g.rand.Intn(3) {
+	case 0:
+		app.Name = okta.AppNameBookmark
+		app.Label = "Bookmark " + slug
+		app.SignOnMode = okta.AppSignOnModeBookmark
+		app.Settings = map[string]map[string]interface{}{
+			"app": {
+				"requestIntegration": false,
+				"url":                url,
+			},
+		}
+	case 1:
+		app.Name = okta.AppName(slug + "_saml_1")
+		app.Label = "SAML " + slug
+		app.SignOnMode = okta.AppSignOnModeSAML2
+		app.Credentials.Signing.KID = g.ID(keyIDPrefix)
+		app.Settings = map[string]map[string]interface{}{
+			"signOn": {
+				"ssoAcsUrl":             url + "/saml/acs",
+				"recipient":             url + "/saml/acs",
+				"destination":           url + "/saml/acs",
+				"audience":              url,
+				"idpIssuer":             "http://www.okta.com/${org.externalKey}",
+				"subjectNameIdTemplate": "${user.userName}",
+				"subjectNameIdFormat":   "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+				"responseSigned":        true,
+				"assertionSigned":       true,
+				"signatureAlgorithm":    "RSA_SHA256",
+				"digestAlgorithm":       "SHA256",
+				"authnContextClassRef":  "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport",
+				"attributeStatements":   []okta.AppSAMLAttributeStatement{},
+			},
+		}
+	default:
+		app.Name = "oidc_client"
+		app.Label = "OIDC " + slug
+		app.SignOnMode = okta.AppSignOnModeOpenIDConnect
+		app.Credentials.OAuthClient.ClientID = app.ID
+		app.Credentials.OAuthClient.TokenEndpointAuthMethod = "client_secret_basic"
+		app.Settings = map[string]map[string]interface{}{
+			"oauthClient": {
+				"redirect_uris":    []string{url + "/oauth2/callback"},
+				"response_types":   []string{"code"},
+				"grant_types":      []string{"authorization_code", "refresh_token"},
+				"application_type": "web",
+			},
+		}
+	}
+	return app
+}
+
+// Apps returns n random applications.
+func (g *Generator) Apps(n int) []*okta.App {
+	apps := make([]*okta.App, n)
+	for i := range apps {
+		apps[i] = g.App()
+	}
+	return apps
+}
+
+// LogEventTypes are the event types of generated log events, see LogEvent.
+var LogEventTypes = []string{
+	"user.session.start",
+	"user.session.end",
+	"user.authentication.sso",
+	"user.authentication.auth_via_mfa",
+	"user.account.lock",
+	"user.lifecycle.create",
+	"user.lifecycle.deactivate",
+	"group.user_membership.add",
+	"group.user_membership.remove",
+	"application.user_membership.add",
+}
+
+// LogEvent returns a random log event of a random user, published in the day before Now.
+func (g *Generator) LogEvent() *okta.LogEvent {
+	return g.UserLogEvent(g.User(), g.pick(LogEventTypes))
+}
+
+// LogEvents returns n random log events of users, sorted by publication time.
+func (g *Generator) LogEvents(n int, users []*okta.User) []*okta.LogEvent {
+	events := make([]*okta.LogEvent, n)
+	published := g.Now.Add(-24 * time.Hour)
+	for i := range events {
+		user := g.User()
+		if len(users) > 0 {
+			user = users[g.rand.Intn(len(users))]
+		}
+		events[i] = g.UserLogEvent(user, g.pick(LogEventTypes))
+
+		published = published.Add(time.Duration(g.rand.Int63n(int64(24*time.Hour) / int64(n+1)))).Truncate(time.Millisecond)
+		events[i].Published = okta.Timestamp{Time: published}
+	}
+	return events
+}
+
+// UserLogEvent returns a log event of eventType performed by, or for, user, published in the day
+// before Now. Membership events target a random group or application.
+func (g *Generator) UserLogEvent(user *okta.User, eventType string) *okta.LogEvent {
+	ip := fmt.Sprintf("198.51.100.%d", 1+g.rand.Intn(254))
+	city := cities[g.rand.Intn(len(cities))]
+	geo := &okta.LogGeographicalContext{City: city.city, State: city.state, Country: "United States", PostalCode: city.zip}
+	requestID := g.ID("")
+
+	e := &okta.LogEvent{
+		UUID:           g.uuid(),
+		Published:      okta.Timestamp{Time: g.timeBefore(g.Now, 24*time.Hour)},
+		EventType:      eventType,
+		Version:        "0",
+		Severity:       "INFO",
+		DisplayMessage: displayMessages[eventType],
+		Actor: okta.LogActor{
+			ID:          user.ID,
+			Type:        "User",
+			AlternateID: user.Profile.Login,
+			DisplayName: user.Profile.DisplayName,
+		},
+		Client: &okta.LogClient{
+			Zone:                "null",
+			Device:              "Computer",
+			IPAddress:           ip,
+			GeographicalContext: geo,
+		},
+		Outcome:     &okta.LogOutcome{Result: "SUCCESS"},
+		Transaction: &okta.LogTransaction{Type: "WEB", ID: requestID},
+		DebugContext: &okta.LogDebugContext{
+			DebugData: map[string]interface{}{"requestId": requestID},
+		},
+	}
+	if e.DisplayMessage == "" {
+		e.DisplayMessage = eventType
+	}
+
+	switch {
+	case eventType == "user.account.lock":
+		e.Severity = "WARN"
+	case eventType == "user.session.start" && g.rand.Intn(10) == 0:
+		e.Severity = "WARN"
+		e.Outcome = &okta.LogOutcome{Result: "FAILURE", Reason: "INVALID_CREDENTIALS"}
+	case strings.HasPrefix(eventType, "user.lifecycle."):
+		e.Actor = okta.LogActor{ID: g.ID(userIDPrefix), Type: "User", AlternateID: "admin@" + g.Domain, DisplayName: "Okta Admin"}
+		e.Target = []*okta.LogTarget{userTarget(user)}
+	case strings.HasPrefix(eventType, "group."):
+		grp := g.Group()
+		e.Target = []*okta.LogTarget{userTarget(user), {ID: grp.ID, Type: "UserGroup", AlternateID: grp.Profile.Name, DisplayName: grp.Profile.Name}}
+	case strings.HasPrefix(eventType, "application.") || eventType == "user.authentication.sso":
+		app := g.App()
+		e.Target = []*okta.LogTarget{{ID: app.ID, Type: "AppInstance", AlternateID: app.Label, DisplayName: app.Label}}
+		if eventType != "user.authentication.sso" {
+			e.Target = append(e.Target, userTarget(user))
+		}
+	}
+	return e
+}
+
+var displayMessages = map[string]string{
+	"user.session.start":               "User login to Okta",
+	"user.session.end":                 "User logout from Okta",
+	"user.authentication.sso":          "User single sign on to app",
+	"user.authentication.auth_via_mfa": "Authentication of user via MFA",
+	"user.account.lock":                "Max sign in attempts exceeded",
+	"user.lifecycle.create":            "Create okta user",
+	"user.lifecycle.deactivate":        "Deactivate Okta user",
+	"group.user_membership.add":        "Add user to group membership",
+	"group.user_membership.remove":     "Remove user from group membership",
+	"application.user_membership.add":  "Add user to application membership",
+}
+
+// userTarget returns the log event target of user.
+func userTarget(user *okta.User) *okta.LogTarget {
+	return &okta.LogTarget{ID: user.ID, Type: "User", AlternateID: user.Profile.Login, DisplayName: user.Profile.DisplayName}
+}
+
+// next returns the next value of the sequence used to make names unique.
+func (g *Generator) next() int {
+	g.seq++
+	return g.seq
+}
+
+func (g *Generator) pick(values []string) string {
+	return values[g.rand.Intn(len(values))]
+}
+
+// timeBefore returns a random time in the window d before t.
+func (g *Generator) timeBefore(t time.Time, d time.Duration) time.Time {
+	return t.Add(-time.Duration(g.rand.Int63n(int64(d)))).Truncate(time.Second)
+}
+
+// timeBetween returns a random time between from and to.
+func (g *Generator) timeBetween(from, to time.Time) time.Time {
+	if !to.After(from) {
+		return from
+	}
+	return from.Add(time.Duration(g.rand.Int63n(int64(to.Sub(from))))).Truncate(time.Second)
+}
+
+func (g *Generator) uuid() string {
+	b := make([]byte, 16)
+	g.rand.Read(b)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func latest(times ...time.Time) time.Time {
+	var l time.Time
+	for _, t := range times {
+		if t.After(l) {
+			l = t
+		}
+	}
+	return l
+}
@@ -0,0 +1,14 @@
+package fixtures
+
+import "testing"
+
+func TestRoundTrip(t *testing.T) {
+	for _, f := range All {
+		f := f
+		t.Run(f.Name, func(t *testing.T) {
+			if err := f.RoundTrip(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package fixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// RoundTripError reports the attributes of a payload that didn't survive a round trip through a
+// model.
+type RoundTripError struct {
+	// Dropped are the paths of the attributes missing from the encoded model.
+	Dropped []string
+	// Changed are the paths of the attributes whose value differs in the encoded model.
+	Changed []string
+}
+
+func (e *RoundTripError) Error() string {
+	var parts []string
+	if len(e.Dropped) > 0 {
+		parts = append(parts, "dropped "+strings.Join(e.Dropped, ", "))
+	}
+	if len(e.Changed) > 0 {
+		parts = append(parts, "changed "+strings.Join(e.Changed, ", "))
+	}
+	return "round trip " + strings.Join(parts, "; ")
+}
+
+// RoundTrip decodes payload into v, a pointer to a model, encodes v and compares the result with
+// payload, returning a *RoundTripError listing the attributes that were dropped or changed.
+//
+// Attributes the model adds are ignored, as are dropped attributes with an empty value, e.g. a
+// false omitted by omitempty. Timestamps are compared as times. The attributes at known paths,
+// and below, are skipped.
+func RoundTrip(payload []byte, v interface{}, known ...string) error {
+	if err := json.Unmarshal(payload, v); err != nil {
+		return err
+	}
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	var in, out interface{}
+	if err := json.Unmarshal(payload, &in); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(encoded, &out); err != nil {
+		return err
+	}
+
+	knownPaths := make(map[string]bool)
+	for _, path := range known {
+		knownPaths[path] = true
+	}
+
+	e := new(RoundTripError)
+	compare(e, knownPaths, "", in, out)
+	if len(e.Dropped) == 0 && len(e.Changed) == 0 {
+		return nil
+	}
+	return e
+}
+
+// compare records in e the differences between the decoded payload in and the decoded model out.
+func compare(e *RoundTripError, known map[string]bool, path string, in, out interface{}) {
+	if known[path] || isEmpty(in) {
+		return
+	}
+
+	switch in := in.(type) {
+	case map[string]interface{}:
+		outMap, ok := out.(map[string]interface{})
+		if !ok {
+			e.Changed = append(e.Changed, path)
+			return
+		}
+		keys := make([]string, 0, len(in))
+		for k := range in {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			p := k
+			if path != "" {
+				p = path + "." + k
+			}
+			outValue, ok := outMap[k]
+			if !ok {
+				if !known[p] && !isEmpty(in[k]) {
+					e.Dropped = append(e.Dropped, p)
+				}
+				continue
+			}
+			compare(e, known, p, in[k], outValue)
+		}
+	case []interface{}:
+		outSlice, ok := out.([]interface{})
+		if !ok || len(outSlice) != len(in) {
+			e.Changed = append(e.Changed, path)
+			return
+		}
+		for i := range in {
+			compare(e, known, fmt.Sprintf("%s[%d]", path, i), in[i], outSlice[i])
+		}
+	case string:
+		if outString, ok := out.(string); ok && (outString == in || sameTime(in, outString)) {
+			return
+		}
+		e.Changed = append(e.Changed, path)
+	default:
+		if !reflect.DeepEqual(in, out) {
+			e.Changed = append(e.Changed, path)
+		}
+	}
+}
+
+// isEmpty reports whether v is the JSON encoding of an empty value.
+func isEmpty(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// sameTime reports whether a and b are RFC 3339 timestamps of the same instant.
+func sameTime(a, b string) bool {
+	ta, err := time.Parse(time.RFC3339, a)
+	if err != nil {
+		return false
+	}
+	tb, err := time.Parse(time.RFC3339, b)
+	return err == nil && ta.Equal(tb)
+}
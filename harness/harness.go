@@ -0,0 +1,226 @@
+// Package harness helps testing against a real Okta org, typically a developer org.
+//
+// A Harness creates a client from the OKTA_BASE_URL and OKTA_API_TOKEN environment variables,
+// skipping the test when they aren't set. It provisions temporary resources with unique names,
+// deletes them when the test ends, and paces the requests of the client so that a test run stays
+// within the rate limits of the org.
+//
+//	func TestGroupMembership(t *testing.T) {
+//		h := harness.New(t)
+//		group := h.Group()
+//		user := h.User()
+//		if _, err := h.Client.Groups.AddUser(h.Context, group.ID, user.ID); err != nil {
+//			t.Fatal(err)
+//		}
+//	}
+//
+// The integration suite of this repository, in the integration directory, runs with:
+//
+//	OKTA_BASE_URL=https://dev-123456.okta.com/api/v1/ OKTA_API_TOKEN=... go test -tags integration ./integration
+package harness
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/austinylin/go-okta/okta"
+)
+
+// Prefix starts the names of the resources created by a Harness, so that resources leaked by an
+// interrupted run can be found and removed, see Sweep().
+const Prefix = "go-okta-test"
+
+// Harness is a test harness against a real org.
+type Harness struct {
+	Client *okta.Client
+	// Context is canceled when the test ends.
+	Context context.Context
+
+	tb  testing.TB
+	run string
+	seq int
+}
+
+// New creates a Harness for the test tb, skipping the test if OKTA_BASE_URL or OKTA_API_TOKEN
+// isn't set.
+func New(tb testing.TB) *Harness {
+	tb.Helper()
+
+	baseURL, token := os.Getenv("OKTA_BASE_URL"), os.Getenv("OKTA_API_TOKEN")
+	if baseURL == "" || token == "" {
+		tb.Skip("OKTA_BASE_URL and OKTA_API_TOKEN must be set to run against an org")
+	}
+	if !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+	if !strings.HasSuffix(baseURL, "/api/v1/") {
+		baseURL += "api/v1/"
+	}
+
+	client, err := okta.NewClient(token, baseURL, &http.Client{Transport: pacer})
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	tb.Cleanup(cancel)
+
+	return &Harness{
+		Client:  client,
+		Context: ctx,
+		tb:      tb,
+		run:     randomHex(4),
+	}
+}
+
+// Name returns a unique name for a resource of kind, e.g. "go-okta-test-group-1a2b3c4d-1".
+func (h *Harness) Name(kind string) string {
+	h.seq++
+	return fmt.Sprintf("%s-%s-%s-%d", Prefix, kind, h.run, h.seq)
+}
+
+// Cleanup registers fn to run when the test ends, failing the test if it returns an error.
+// Cleanups run in the reverse order of registration, after the Context is canceled, so they use
+// a context of their own.
+func (h *Harness) Cleanup(name string, fn func(ctx context.Context) error) {
+	h.tb.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+		if err := fn(ctx); err != nil {
+			h.tb.Errorf("cleanup of %s: %v", name, err)
+		}
+	})
+}
+
+// Group creates a group, removed when the test ends.
+func (h *Harness) Group() *okta.Group {
+	h.tb.Helper()
+
+	name := h.Name("group")
+	group, _, err := h.Client.Groups.Add(h.Context, &okta.GroupProfile{Name: name, Description: "Created by the go-okta test harness"})
+	if err != nil {
+		h.tb.Fatalf("creating group %s: %v", name, err)
+	}
+
+	h.Cleanup("group "+name, func(ctx context.Context) error {
+		_, err := h.Client.Groups.Remove(ctx, group.ID)
+		return err
+	})
+	return group
+}
+
+// User creates a staged user, without credentials, deleted when the test ends. The login is at
+// example.com, so no activation email is ever delivered.
+func (h *Harness) User() *okta.User {
+	h.tb.Helper()
+
+	name := h.Name("user")
+	profile := &okta.UserProfile{
+		Login:     name + "@example.com",
+		Email:     name + "@example.com",
+		FirstName: "Test",
+		LastName:  name,
+	}
+	user, _, err := h.Client.Users.Add(h.Context, profile, false)
+	if err != nil {
+		h.tb.Fatalf("creating user %s: %v", name, err)
+	}
+
+	h.Cleanup("user "+name, func(ctx context.Context) error {
+		if _, err := h.Client.Users.Deactivate(ctx, user.ID); err != nil {
+			return err
+		}
+		_, err := h.Client.Users.Remove(ctx, user.ID)
+		return err
+	})
+	return user
+}
+
+// BookmarkApp creates an inactive bookmark application, deleted when the test ends.
+func (h *Harness) BookmarkApp() *okta.App {
+	h.tb.Helper()
+
+	name := h.Name("app")
+	link, _ := url.Parse("https://example.com/" + name)
+	app, _, err := h.Client.Apps.AddBookmarkApp(h.Context, name, false, link)
+	if err != nil {
+		h.tb.Fatalf("creating app %s: %v", name, err)
+	}
+
+	h.Cleanup("app "+name, func(ctx context.Context) error {
+		if _, err := h.Client.Apps.Deactivate(ctx, app.ID); err != nil {
+			return err
+		}
+		_, err := h.Client.Apps.Remove(ctx, app.ID)
+		return err
+	})
+	return app
+}
+
+// Sweep deletes the groups, users and applications left over by interrupted runs: those whose
+// name starts with Prefix.
+func (h *Harness) Sweep() {
+	h.tb.Helper()
+	ctx := h.Context
+
+	groups, _, err := h.Client.Groups.List(ctx, &okta.GroupListParams{Q: Prefix})
+	if err == nil {
+		var all []*okta.Group
+		if all, _, err = groups.All(ctx); err == nil {
+			for _, g := range all {
+				if _, err := h.Client.Groups.Remove(ctx, g.ID); err != nil {
+					h.tb.Logf("sweeping group %s: %v", g.Profile.Name, err)
+				}
+			}
+		}
+	}
+	if err != nil {
+		h.tb.Errorf("listing groups to sweep: %v", err)
+	}
+
+	users, _, err := h.Client.Users.List(ctx, &okta.UserListParams{Q: Prefix})
+	if err == nil {
+		var all []*okta.User
+		if all, _, err = users.All(ctx); err == nil {
+			for _, u := range all {
+				h.Client.Users.Deactivate(ctx, u.ID)
+				if _, err := h.Client.Users.Remove(ctx, u.ID); err != nil {
+					h.tb.Logf("sweeping user %s: %v", u.Profile.Login, err)
+				}
+			}
+		}
+	}
+	if err != nil {
+		h.tb.Errorf("listing users to sweep: %v", err)
+	}
+
+	apps, _, err := h.Client.Apps.List(ctx, &okta.AppListParams{Q: Prefix})
+	if err == nil {
+		var all []*okta.App
+		if all, _, err = apps.All(ctx); err == nil {
+			for _, a := range all {
+				h.Client.Apps.Deactivate(ctx, a.ID)
+				if _, err := h.Client.Apps.Remove(ctx, a.ID); err != nil {
+					h.tb.Logf("sweeping app %s: %v", a.Label, err)
+				}
+			}
+		}
+	}
+	if err != nil {
+		h.tb.Errorf("listing apps to sweep: %v", err)
+	}
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
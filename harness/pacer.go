@@ -0,0 +1,78 @@
+package harness
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/austinylin/go-okta/okta"
+)
+
+// MinRemaining is the number of requests left in a rate limit window below which the requests of
+// a Harness wait for the window to reset. It keeps headroom for other clients of the org.
+var MinRemaining = 5
+
+// pacer is the transport of the clients of all harnesses, shared so that parallel tests are paced
+// together.
+var pacer = &pacingTransport{base: http.DefaultTransport, resets: make(map[string]time.Time)}
+
+// pacingTransport delays requests to an endpoint whose rate limit is nearly exhausted until the
+// limit resets. Rate limits are per endpoint family, approximated by the first segments of the
+// path.
+type pacingTransport struct {
+	base http.RoundTripper
+
+	mu     sync.Mutex
+	resets map[string]time.Time
+}
+
+func (t *pacingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := endpointKey(req)
+
+	t.mu.Lock()
+	reset := t.resets[key]
+	t.mu.Unlock()
+	if d := time.Until(reset); d > 0 {
+		timer := time.NewTimer(d)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	remaining, errRemaining := strconv.Atoi(resp.Header.Get("X-Rate-Limit-Remaining"))
+	resetUnix, errReset := strconv.ParseInt(resp.Header.Get("X-Rate-Limit-Reset"), 10, 64)
+	if errRemaining == nil && errReset == nil && (remaining <= MinRemaining || resp.StatusCode == http.StatusTooManyRequests) {
+		t.mu.Lock()
+		// The reset on the local clock, with a second of margin for the resolution of the Date
+		// header the skew is measured with.
+		t.resets[key] = time.Unix(resetUnix, 0).Add(-okta.ClockSkew(resp)).Add(time.Second)
+		t.mu.Unlock()
+	}
+	return resp, nil
+}
+
+// endpointKey returns the method and the first two segments of the path of req, e.g.
+// "GET /api/v1/groups".
+func endpointKey(req *http.Request) string {
+	path := req.URL.Path
+	segments := 0
+	for i := 0; i < len(path); i++ {
+		if path[i] == '/' {
+			segments++
+			if segments == 4 {
+				path = path[:i]
+				break
+			}
+		}
+	}
+	return req.Method + " " + path
+}
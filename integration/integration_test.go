@@ -0,0 +1,175 @@
+//go:build integration
+
+// Package integration tests the client against a real org, see the harness package.
+package integration
+
+import (
+	"testing"
+
+	"github.com/austinylin/go-okta/harness"
+	"github.com/austinylin/go-okta/okta"
+)
+
+func TestGroupLifecycle(t *testing.T) {
+	h := harness.New(t)
+	group := h.Group()
+
+	got, _, err := h.Client.Groups.GetByID(h.Context, group.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Profile.Name != group.Profile.Name {
+		t.Errorf("got name %q, want %q", got.Profile.Name, group.Profile.Name)
+	}
+
+	profile := group.Profile
+	profile.Description = "Updated by the go-okta integration suite"
+	updated, _, err := h.Client.Groups.Update(h.Context, group.ID, &profile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Profile.Description != profile.Description {
+		t.Errorf("got description %q, want %q", updated.Profile.Description, profile.Description)
+	}
+}
+
+func TestGroupMembership(t *testing.T) {
+	h := harness.New(t)
+	group := h.Group()
+	users := []*okta.User{h.User(), h.User(), h.User()}
+
+	ids := []string{users[0].ID, users[1].ID}
+	added, err := h.Client.Groups.AddUsers(h.Context, group.ID, ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := added.Err(); err != nil {
+		t.Fatal(err)
+	}
+	assertMembers(t, h, group.ID, ids)
+
+	ids = []string{users[1].ID, users[2].ID}
+	result, err := h.Client.Groups.SyncUsers(h.Context, group.ID, ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := result.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(result.Items); n != 2 {
+		t.Errorf("sync changed %d memberships, want 2", n)
+	}
+	assertMembers(t, h, group.ID, ids)
+}
+
+func assertMembers(t *testing.T, h *harness.Harness, groupID string, want []string) {
+	t.Helper()
+
+	page, _, err := h.Client.Groups.ListUsers(h.Context, groupID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	members, _, err := page.All(h.Context)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := make(map[string]bool, len(members))
+	for _, u := range members {
+		got[u.ID] = true
+	}
+	if len(got) != len(want) {
+		t.Errorf("got %d members, want %d", len(got), len(want))
+	}
+	for _, id := range want {
+		if !got[id] {
+			t.Errorf("user %s is not a member", id)
+		}
+	}
+}
+
+func TestAppGroupAssignment(t *testing.T) {
+	h := harness.New(t)
+	app := h.BookmarkApp()
+	groups := []*okta.Group{h.Group(), h.Group()}
+
+	result, err := h.Client.Apps.AssignGroups(h.Context, app.ID, []string{groups[0].ID, groups[1].ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := result.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(result.Succeeded()); n != 2 {
+		t.Errorf("got %d assignments, want 2", n)
+	}
+}
+
+func TestPagination(t *testing.T) {
+	h := harness.New(t)
+	const n = 3
+	for i := 0; i < n; i++ {
+		h.Group()
+	}
+
+	// Only the groups of this test match the query, so the page limit is the only reason to
+	// page, and the default limit doesn't page at all. All must agree with manual paging.
+	page, _, err := h.Client.Groups.List(h.Context, &okta.GroupListParams{Q: harness.Prefix})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paged int
+	for {
+		paged += len(page.Items)
+		if !page.HasNextPage() {
+			break
+		}
+		if page, err = page.NextPage(h.Context); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	page, _, err = h.Client.Groups.List(h.Context, &okta.GroupListParams{Q: harness.Prefix})
+	if err != nil {
+		t.Fatal(err)
+	}
+	all, _, err := page.All(h.Context)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != paged {
+		t.Errorf("All returned %d groups, paging returned %d", len(all), paged)
+	}
+	if len(all) < n {
+		t.Errorf("got %d groups, want at least %d", len(all), n)
+	}
+}
+
+func TestCache(t *testing.T) {
+	h := harness.New(t)
+	h.Client.Cache = okta.NewCache(okta.DefaultCacheTTLs)
+	group := h.Group()
+
+	if _, resp, err := h.Client.Groups.GetByID(h.Context, group.ID); err != nil || resp == nil {
+		t.Fatalf("first get: response %v, error %v", resp, err)
+	}
+	if _, resp, err := h.Client.Groups.GetByID(h.Context, group.ID); err != nil || resp != nil {
+		t.Fatalf("second get: response %v, error %v, want a cache hit", resp, err)
+	}
+
+	profile := group.Profile
+	profile.Description = "Updated by the go-okta integration suite"
+	if _, _, err := h.Client.Groups.Update(h.Context, group.ID, &profile); err != nil {
+		t.Fatal(err)
+	}
+	got, resp, err := h.Client.Groups.GetByID(h.Context, group.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp == nil {
+		t.Error("got a cache hit after an update")
+	}
+	if got.Profile.Description != profile.Description {
+		t.Errorf("got description %q, want %q", got.Profile.Description, profile.Description)
+	}
+}
@@ -0,0 +1,54 @@
+package logexport
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileCheckpointer persists the position of an Exporter in a file. The file is replaced
+// atomically, so a crash never leaves a partial cursor behind.
+type FileCheckpointer struct {
+	Path string
+}
+
+// NewFileCheckpointer is a helper method to create a new FileCheckpointer.
+func NewFileCheckpointer(path string) *FileCheckpointer {
+	return &FileCheckpointer{Path: path}
+}
+
+// Load implements the Checkpointer interface.
+func (c *FileCheckpointer) Load(ctx context.Context) (string, error) {
+	data, err := ioutil.ReadFile(c.Path)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// Save implements the Checkpointer interface.
+func (c *FileCheckpointer) Save(ctx context.Context, cursor string) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(c.Path), filepath.Base(c.Path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.WriteString(cursor + "\n"); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), c.Path)
+}
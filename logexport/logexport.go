@@ -0,0 +1,160 @@
+// Package logexport delivers System Log events to a SIEM, or any other destination.
+//
+// An Exporter follows the System Log with an okta.LogPoller, batches the events, and writes the
+// batches to a Sink. Its position is saved to a Checkpointer only once a batch has been written,
+// so delivery is at least once: after a crash the events of the last, unacknowledged, batch are
+// delivered again. Sinks should deduplicate on LogEvent.UUID if that matters.
+package logexport
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/austinylin/go-okta/okta"
+)
+
+// Sink is the destination of the exported events.
+type Sink interface {
+	// Write delivers a batch of events. The batch is considered delivered once Write returns nil.
+	Write(ctx context.Context, events []*okta.LogEvent) error
+}
+
+// Checkpointer persists the position of an Exporter.
+type Checkpointer interface {
+	// Load returns the saved cursor, or an empty string if there is none.
+	Load(ctx context.Context) (string, error)
+	// Save persists cursor.
+	Save(ctx context.Context, cursor string) error
+}
+
+// Exporter delivers System Log events to a Sink.
+type Exporter struct {
+	Poller       *okta.LogPoller
+	Sink         Sink
+	Checkpointer Checkpointer
+	// BatchSize is the number of events above which a batch is written without waiting for more.
+	BatchSize int
+	// FlushInterval is the longest events are held back before being written.
+	FlushInterval time.Duration
+	// RetryInterval is how long to wait before retrying a batch the Sink failed to write.
+	RetryInterval time.Duration
+	// MaxRetries is the number of times a batch is retried before Run gives up.
+	MaxRetries int
+	// Clock tells the time, and waits, for the Exporter. Defaults to the system clock; NewExporter
+	// sets the Clock of the client.
+	Clock okta.Clock
+}
+
+// NewExporter is a helper method to create a new Exporter of the events matching params. If the
+// checkpointer has a saved position, it takes precedence over params.Since.
+func NewExporter(client *okta.Client, params *okta.LogListParams, sink Sink, checkpointer Checkpointer) *Exporter {
+	return &Exporter{
+		Poller:        okta.NewLogPoller(client, params),
+		Sink:          sink,
+		Checkpointer:  checkpointer,
+		BatchSize:     1000,
+		FlushInterval: 30 * time.Second,
+		RetryInterval: 10 * time.Second,
+		MaxRetries:    5,
+		Clock:         client.Clock,
+	}
+}
+
+// Run exports events until ctx is done, or until an error the Exporter can't recover from.
+func (e *Exporter) Run(ctx context.Context) error {
+	if e.Checkpointer != nil {
+		cursor, err := e.Checkpointer.Load(ctx)
+		if err != nil {
+			return err
+		}
+		if cursor != "" {
+			e.Poller.Resume(cursor)
+		}
+	}
+
+	var batch []*okta.LogEvent
+	var batchStart time.Time
+	for {
+		events, err := e.Poller.Next(ctx)
+		var rateErr *okta.RateLimitError
+		if errors.As(err, &rateErr) {
+			if err := e.wait(ctx, rateErr.Rate.Reset.Sub(e.now())); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(batch) == 0 {
+			batchStart = e.now()
+		}
+		batch = append(batch, events...)
+
+		// The batch always ends on a page boundary, so the cursor of the poller is the position
+		// right after it.
+		caughtUp := len(events) == 0
+		if len(batch) > 0 && (len(batch) >= e.BatchSize || caughtUp || e.now().Sub(batchStart) >= e.FlushInterval) {
+			if err := e.flush(ctx, batch); err != nil {
+				return err
+			}
+			batch = nil
+		}
+
+		if caughtUp {
+			if err := e.wait(ctx, e.Poller.Interval); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// flush writes a batch to the sink, retrying on failure, and then saves the position.
+func (e *Exporter) flush(ctx context.Context, batch []*okta.LogEvent) error {
+	var err error
+	for attempt := 0; attempt <= e.MaxRetries; attempt++ {
+		if attempt > 0 {
+			if err := e.wait(ctx, e.RetryInterval); err != nil {
+				return err
+			}
+		}
+		if err = e.Sink.Write(ctx, batch); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if e.Checkpointer != nil {
+		return e.Checkpointer.Save(ctx, e.Poller.Cursor())
+	}
+	return nil
+}
+
+// now returns the current time of the Clock of the Exporter.
+func (e *Exporter) now() time.Time {
+	if e.Clock != nil {
+		return e.Clock.Now()
+	}
+	return time.Now()
+}
+
+// wait blocks for d, by the Clock of the Exporter, or until ctx is done.
+func (e *Exporter) wait(ctx context.Context, d time.Duration) error {
+	if e.Clock != nil {
+		return e.Clock.Sleep(ctx, d)
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
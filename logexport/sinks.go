@@ -0,0 +1,118 @@
+package logexport
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/austinylin/go-okta/okta"
+)
+
+// FileSink appends events to a file as JSON lines.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileSink is a helper method to create a new FileSink appending to the file at path.
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{file: f}, nil
+}
+
+// Write implements the Sink interface. The file is synced before Write returns.
+func (s *FileSink) Write(ctx context.Context, events []*okta.LogEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w := bufio.NewWriter(s.file)
+	enc := json.NewEncoder(w)
+	for _, e := range events {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+// Close closes the file.
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
+
+// HTTPSink posts batches of events as a JSON array to an HTTP endpoint, such as the HTTP event
+// collector of a SIEM.
+type HTTPSink struct {
+	URL string
+	// Header is added to every request, e.g. for an Authorization header.
+	Header http.Header
+	// HTTPClient defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// NewHTTPSink is a helper method to create a new HTTPSink posting to url.
+func NewHTTPSink(url string) *HTTPSink {
+	return &HTTPSink{URL: url, Header: make(http.Header)}
+}
+
+// Write implements the Sink interface. Any status code outside the 200 range is an error.
+func (s *HTTPSink) Write(ctx context.Context, events []*okta.LogEvent) error {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", s.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range s.Header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	httpClient := s.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("POST %s: %s", s.URL, resp.Status)
+	}
+	return nil
+}
+
+// ChannelSink sends events to a channel, for processing in the same program. Write blocks until
+// every event of the batch has been received.
+type ChannelSink chan<- *okta.LogEvent
+
+// Write implements the Sink interface.
+func (s ChannelSink) Write(ctx context.Context, events []*okta.LogEvent) error {
+	for _, e := range events {
+		select {
+		case s <- e:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
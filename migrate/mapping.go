@@ -0,0 +1,28 @@
+package migrate
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// csvHeader are the columns of WriteCSV(), named after the JSON attributes of a UserMapping.
+var csvHeader = []string{"sourceId", "targetId", "login", "existing", "groups", "error"}
+
+// WriteCSV writes the mappings of the users to w as CSV, with a header row, e.g. to update the
+// references to the users in other systems. The groups of a user are separated by spaces.
+func (r *Result) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, m := range r.Users {
+		row := []string{m.SourceID, m.TargetID, m.Login, strconv.FormatBool(m.Existing), strings.Join(m.Groups, " "), m.Error}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
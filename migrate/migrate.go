@@ -0,0 +1,369 @@
+// Package migrate copies the users of an Okta org into another, e.g. to consolidate orgs after an
+// acquisition.
+//
+// A Migrator streams the users of the source org page by page and recreates each of them in the
+// target org, with its custom attributes, its password when its hash is known, and its
+// memberships of the groups mastered in Okta. The requests are paced by the rate limits of both
+// orgs, and retried when they fail with a transient error. The result maps the IDs of the users
+// of the source org to those of the target org:
+//
+//	m := migrate.NewMigrator(source, target)
+//	m.PasswordHook = true
+//	result, err := m.Migrate(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	err = result.WriteCSV(mappingFile)
+//
+// Okta doesn't export the hashes of passwords: they come from the system the users were imported
+// from, see Migrator.PasswordHash, or the passwords are imported at the first sign-in of the users
+// by the password import inline hook of the target org, see Migrator.PasswordHook.
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/austinylin/go-okta/okta"
+)
+
+// Migrator copies the users of a source org into a target org.
+type Migrator struct {
+	Source *okta.Client
+	Target *okta.Client
+	// Search, if set, selects the users of the source org to migrate, e.g.
+	// `profile.department eq "Sales"`. Defaults to the users which aren't deprovisioned.
+	Search string
+	// PasswordHash, if set, returns the hash of the password of a user of the source org, e.g.
+	// from the database of the application the user was imported from. A nil hash imports the
+	// user without a password.
+	PasswordHash func(ctx context.Context, user *okta.User) (*okta.PasswordHash, error)
+	// PasswordHook imports the passwords of the users without a hash at their first sign-in, with
+	// the password import inline hook of the target org, e.g. one verifying them against the
+	// source org.
+	PasswordHook bool
+	// Activate activates the users created in the target org.
+	Activate bool
+	// Groups copies the memberships of the users of the groups mastered in Okta to the groups of
+	// the same name of the target org, which are created if missing.
+	Groups bool
+	// MinRemaining is the number of requests left in the current rate limit window of an org
+	// below which the migrator waits for the window to reset before making the next request,
+	// leaving room for the other clients of the org.
+	MinRemaining int
+	// MaxRetries is the number of times a request failing with a transient error, or rejected by
+	// the rate limit, is retried, by the RetryClassifier of the Retry policy of its client. The
+	// requests of a client with a Retry policy of its own are retried by the client instead.
+	MaxRetries int
+	// OnUser, if set, is called with the mapping of every user once it's migrated, or failed, e.g.
+	// to report the progress of a long migration.
+	OnUser func(m *UserMapping)
+}
+
+// NewMigrator is a helper method to create a new Migrator of the users of source, and their
+// group memberships, to target.
+func NewMigrator(source, target *okta.Client) *Migrator {
+	return &Migrator{
+		Source:       source,
+		Target:       target,
+		Groups:       true,
+		MinRemaining: 5,
+		MaxRetries:   3,
+	}
+}
+
+// UserMapping represents the migration of a user.
+type UserMapping struct {
+	SourceID string `json:"sourceId"`
+	// TargetID is the ID of the user in the target org, empty if the migration failed.
+	TargetID string `json:"targetId,omitempty"`
+	Login    string `json:"login"`
+	// Existing reports whether the user already existed in the target org, matched by login,
+	// and wasn't created.
+	Existing bool `json:"existing,omitempty"`
+	// Groups are the IDs of the groups of the target org the user was added to.
+	Groups []string `json:"groups,omitempty"`
+	// Err is the error of the migration of the user, nil on success. A user can be migrated, with
+	// a TargetID, but miss some of its groups.
+	Err   error  `json:"-"`
+	Error string `json:"error,omitempty"`
+}
+
+// Result represents the outcome of a migration.
+type Result struct {
+	// Users are the mappings of the users, in the order of the source org.
+	Users []*UserMapping
+	// Groups maps the IDs of the groups of the source org to the IDs of the groups of the target
+	// org.
+	Groups map[string]string
+}
+
+// Failed returns the mappings of the users whose migration failed.
+func (r *Result) Failed() []*UserMapping {
+	var failed []*UserMapping
+	for _, m := range r.Users {
+		if m.Err != nil {
+			failed = append(failed, m)
+		}
+	}
+	return failed
+}
+
+// Migrate migrates the users of the source org. The failure of a user is recorded in its mapping
+// and doesn't stop the others; the error reports that the users of the source org couldn't be
+// listed, or ctx being done, along with the result so far.
+func (m *Migrator) Migrate(ctx context.Context) (*Result, error) {
+	result := &Result{Groups: make(map[string]string)}
+
+	var page *okta.Collection[*okta.User]
+	err := m.call(ctx, m.Source, true, func() (resp *okta.Response, err error) {
+		page, resp, err = m.Source.Users.List(ctx, &okta.UserListParams{Search: m.Search})
+		return resp, err
+	})
+	for err == nil {
+		for _, user := range page.Items {
+			if err := ctx.Err(); err != nil {
+				return result, err
+			}
+			mapping := m.migrateUser(ctx, result, user)
+			if mapping.Err != nil {
+				mapping.Error = mapping.Err.Error()
+			}
+			result.Users = append(result.Users, mapping)
+			if m.OnUser != nil {
+				m.OnUser(mapping)
+			}
+		}
+		if !page.HasNextPage() {
+			return result, nil
+		}
+		err = m.call(ctx, m.Source, true, func() (*okta.Response, error) {
+			next, err := page.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+			page = next
+			return page.Response, nil
+		})
+	}
+	return result, err
+}
+
+// migrateUser creates user in the target org, or finds it there, and adds it to its groups.
+func (m *Migrator) migrateUser(ctx context.Context, result *Result, user *okta.User) *UserMapping {
+	mapping := &UserMapping{SourceID: user.ID, Login: user.Profile.Login}
+
+	params := &okta.UserImportParams{Profile: &user.Profile, PasswordHook: m.PasswordHook, Activate: m.Activate}
+	if m.PasswordHash != nil {
+		hash, err := m.PasswordHash(ctx, user)
+		if err != nil {
+			mapping.Err = err
+			return mapping
+		}
+		if hash != nil {
+			params.PasswordHash, params.PasswordHook = hash, false
+		}
+	}
+
+	var created *okta.User
+	err := m.call(ctx, m.Target, false, func() (resp *okta.Response, err error) {
+		created, resp, err = m.Target.Users.Import(ctx, params)
+		return resp, err
+	})
+	switch {
+	case err == nil:
+		mapping.TargetID = created.ID
+	case loginExists(err):
+		existing, err := m.findUser(ctx, user.Profile.Login)
+		if err != nil {
+			mapping.Err = err
+			return mapping
+		}
+		mapping.TargetID, mapping.Existing = existing.ID, true
+	default:
+		mapping.Err = err
+		return mapping
+	}
+
+	if m.Groups {
+		mapping.Groups, mapping.Err = m.copyGroups(ctx, result, user.ID, mapping.TargetID)
+	}
+	return mapping
+}
+
+// copyGroups adds the user targetID to the groups of the target org matching the groups of the
+// source org the user sourceID is a member of, and returns their IDs.
+func (m *Migrator) copyGroups(ctx context.Context, result *Result, sourceID, targetID string) ([]string, error) {
+	var groups []*okta.Group
+	err := m.call(ctx, m.Source, true, func() (resp *okta.Response, err error) {
+		page, resp, err := m.Source.Users.ListGroups(ctx, sourceID)
+		if err != nil {
+			return resp, err
+		}
+		groups, resp, err = page.All(ctx)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var added []string
+	for _, group := range groups {
+		if group.Type != okta.GroupTypeOkta {
+			continue
+		}
+		groupID, err := m.targetGroup(ctx, result, group)
+		if err != nil {
+			return added, err
+		}
+		err = m.call(ctx, m.Target, true, func() (*okta.Response, error) {
+			return m.Target.Groups.AddUser(ctx, groupID, targetID)
+		})
+		if err != nil {
+			return added, fmt.Errorf("adding to group %s: %w", group.Profile.Name, err)
+		}
+		added = append(added, groupID)
+	}
+	return added, nil
+}
+
+// targetGroup returns the ID of the group of the target org with the name of group, created if
+// missing.
+func (m *Migrator) targetGroup(ctx context.Context, result *Result, group *okta.Group) (string, error) {
+	if id, ok := result.Groups[group.ID]; ok {
+		return id, nil
+	}
+
+	var candidates []*okta.Group
+	err := m.call(ctx, m.Target, true, func() (resp *okta.Response, err error) {
+		page, resp, err := m.Target.Groups.List(ctx, &okta.GroupListParams{Q: group.Profile.Name, Type: okta.GroupTypeOkta})
+		if err != nil {
+			return resp, err
+		}
+		candidates, resp, err = page.All(ctx)
+		return resp, err
+	})
+	if err != nil {
+		return "", err
+	}
+	for _, candidate := range candidates {
+		if candidate.Profile.Name == group.Profile.Name {
+			result.Groups[group.ID] = candidate.ID
+			return candidate.ID, nil
+		}
+	}
+
+	var created *okta.Group
+	err = m.call(ctx, m.Target, false, func() (resp *okta.Response, err error) {
+		created, resp, err = m.Target.Groups.Add(ctx, &okta.GroupProfile{
+			Name:        group.Profile.Name,
+			Description: group.Profile.Description,
+		})
+		return resp, err
+	})
+	if err != nil {
+		return "", fmt.Errorf("creating group %s: %w", group.Profile.Name, err)
+	}
+	result.Groups[group.ID] = created.ID
+	return created.ID, nil
+}
+
+// findUser returns the user of the target org whose login is login.
+func (m *Migrator) findUser(ctx context.Context, login string) (*okta.User, error) {
+	var users []*okta.User
+	err := m.call(ctx, m.Target, true, func() (resp *okta.Response, err error) {
+		page, resp, err := m.Target.Users.List(ctx, &okta.UserListParams{Filter: fmt.Sprintf("profile.login eq %q", login)})
+		if err != nil {
+			return resp, err
+		}
+		users = page.Items
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("user %s exists in the target org but can't be found", login)
+	}
+	return users[0], nil
+}
+
+// loginExists reports whether err rejects the creation of a user whose login is taken.
+func loginExists(err error) bool {
+	var errResp *okta.ErrorResponse
+	return errors.As(err, &errResp) && len(errResp.FieldErrors()["login"]) > 0
+}
+
+// call makes the request of fn with client, retrying it when it fails with a transient error,
+// and pacing the requests by the rate limit of the org: once few requests are left in the
+// window, it waits for the window to reset. The requests which aren't idempotent, e.g. creating
+// a user, are only retried when rejected by the rate limit, as they may have been processed
+// otherwise. Nothing is retried if client retries the requests itself, see okta.Client.Retry.
+func (m *Migrator) call(ctx context.Context, client *okta.Client, idempotent bool, fn func() (*okta.Response, error)) error {
+	classifier := okta.DefaultRetryClassifier()
+	maxRetries := m.MaxRetries
+	if client.Retry != nil {
+		if client.Retry.Classifier != nil {
+			classifier = client.Retry.Classifier
+		}
+		if client.Retry.MaxRetries > 0 {
+			maxRetries = 0
+		}
+	}
+
+	for retries := 0; ; retries++ {
+		resp, err := fn()
+		class := classifier.Classify(err)
+		if err != nil && retries < maxRetries &&
+			(class == okta.RetryClassRateLimited || class == okta.RetryClassRetryable && idempotent) {
+			until := now(client).Add(time.Second << uint(retries))
+			var rateErr *okta.RateLimitError
+			if errors.As(err, &rateErr) {
+				until = rateErr.Rate.Reset.Time
+			}
+			if err := sleepUntil(ctx, client, until); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if resp != nil && resp.Rate.Limit > 0 && resp.Rate.Remaining <= m.MinRemaining {
+			return sleepUntil(ctx, client, resp.Rate.Reset.Time)
+		}
+		return nil
+	}
+}
+
+// now returns the current time of the Clock of client, or of the system if it has none.
+func now(client *okta.Client) time.Time {
+	if client.Clock != nil {
+		return client.Clock.Now()
+	}
+	return time.Now()
+}
+
+// sleepUntil waits until t by the Clock of client, or the system one if it has none, or until
+// ctx is done.
+func sleepUntil(ctx context.Context, client *okta.Client, t time.Time) error {
+	d := t.Sub(now(client))
+	if d <= 0 {
+		return nil
+	}
+	if client.Clock != nil {
+		return client.Clock.Sleep(ctx, d)
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,57 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// accessTokenClaimsCtxKey is the context key of the claims injected by AccessTokenMiddleware.
+const accessTokenClaimsCtxKey contextKey = "accessTokenClaims"
+
+// AccessTokenClaimsFromContext returns the claims of the access token of the request, as injected
+// by AccessTokenMiddleware.
+func AccessTokenClaimsFromContext(ctx context.Context) (*AccessTokenClaims, bool) {
+	claims, ok := ctx.Value(accessTokenClaimsCtxKey).(*AccessTokenClaims)
+	return claims, ok
+}
+
+// AccessTokenMiddleware returns net/http middleware that authenticates requests with the Bearer
+// access token of their Authorization header. Requests with a missing or invalid token are
+// rejected with 401 Unauthorized, and those with a token missing a required scope with 403
+// Forbidden. When the signing keys can't be fetched, requests are rejected with 503 Service
+// Unavailable. The claims of valid tokens are available to next through
+// AccessTokenClaimsFromContext().
+func AccessTokenMiddleware(v *AccessTokenVerifier) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			auth := r.Header.Get("Authorization")
+			if len(auth) < 7 || !strings.EqualFold(auth[:7], "Bearer ") {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+
+			claims, err := v.Verify(r.Context(), strings.TrimSpace(auth[7:]))
+			switch {
+			case errors.Is(err, ErrInsufficientScope):
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer error="insufficient_scope", scope=%q`, strings.Join(v.RequiredScopes, " ")))
+				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+				return
+			case errors.Is(err, ErrKeysUnavailable):
+				// The signing keys couldn't be fetched: an outage, not an invalid token.
+				http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+				return
+			case err != nil:
+				w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), accessTokenClaimsCtxKey, claims)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
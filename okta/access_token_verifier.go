@@ -0,0 +1,371 @@
+package okta
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Access token verification errors
+var (
+	ErrInvalidToken      = errors.New("invalid access token")
+	ErrInsufficientScope = errors.New("access token is missing a required scope")
+	// ErrKeysUnavailable is returned when the signing keys couldn't be fetched, an outage of
+	// the authorization server rather than an invalid token.
+	ErrKeysUnavailable = errors.New("signing keys of the authorization server unavailable")
+)
+
+// AccessTokenClaims represents the claims of an Okta access token.
+//
+// https://developer.okta.com/docs/reference/api/oidc/#access-token-payload
+type AccessTokenClaims struct {
+	Issuer    string        `json:"iss"`
+	Subject   string        `json:"sub"`
+	Audience  claimAudience `json:"aud"`
+	ExpiresAt int64         `json:"exp"`
+	IssuedAt  int64         `json:"iat"`
+	NotBefore int64         `json:"nbf,omitempty"`
+	JTI       string        `json:"jti"`
+	ClientID  string        `json:"cid"`
+	UID       string        `json:"uid,omitempty"`
+	Scopes    []string      `json:"scp"`
+	// Raw holds all the claims, including the custom ones.
+	Raw map[string]interface{} `json:"-"`
+}
+
+// HasScope reports whether the token was granted scope.
+func (c *AccessTokenClaims) HasScope(scope string) bool {
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// claimAudience is the aud claim, which is either a string or a list of strings.
+type claimAudience []string
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (a *claimAudience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = claimAudience{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*a = multiple
+	return nil
+}
+
+// AccessTokenVerifier validates access tokens issued by an Okta authorization server. Signing keys
+// are fetched from the JWKS endpoint of the authorization server, cached, and refreshed in the
+// background, as well as whenever a token is signed by an unknown key.
+type AccessTokenVerifier struct {
+	// Issuer is the issuer of the authorization server, e.g. https://example.okta.com/oauth2/default.
+	Issuer string
+	// Audience is the expected audience of tokens.
+	Audience string
+	// ClientID, if set, is the only client tokens may have been issued to.
+	ClientID string
+	// RequiredScopes are the scopes tokens must have all been granted.
+	RequiredScopes []string
+	// ClockSkew is the tolerance applied to the time based claims.
+	ClockSkew time.Duration
+	// RefreshInterval is how often the signing keys are refreshed in the background.
+	RefreshInterval time.Duration
+	// HTTPClient is used to fetch the signing keys. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	keysMu      sync.RWMutex
+	keys        map[string]*rsa.PublicKey
+	lastRefresh time.Time
+	refreshErr  error
+	refreshMu   sync.Mutex
+	startOnce   sync.Once
+	stopMu      sync.Mutex
+	stop        chan struct{}
+	stopped     bool
+}
+
+// NewAccessTokenVerifier is a helper method to create a new AccessTokenVerifier for tokens issued by
+// issuer to audience.
+func NewAccessTokenVerifier(issuer, audience string, requiredScopes ...string) *AccessTokenVerifier {
+	return &AccessTokenVerifier{
+		Issuer:          strings.TrimSuffix(issuer, "/"),
+		Audience:        audience,
+		RequiredScopes:  requiredScopes,
+		ClockSkew:       time.Minute,
+		RefreshInterval: time.Hour,
+	}
+}
+
+// Close stops the background refresh of the signing keys, or prevents it from starting.
+func (v *AccessTokenVerifier) Close() {
+	v.stopMu.Lock()
+	defer v.stopMu.Unlock()
+	if v.stopped {
+		return
+	}
+	v.stopped = true
+	if v.stop != nil {
+		close(v.stop)
+	}
+}
+
+// start starts the background refresh of the signing keys, unless the verifier is closed.
+func (v *AccessTokenVerifier) start() {
+	v.stopMu.Lock()
+	defer v.stopMu.Unlock()
+	if v.stopped {
+		return
+	}
+	v.stop = make(chan struct{})
+	go v.refreshLoop(v.stop)
+}
+
+// Verify validates the signature and the claims of an access token, and returns its claims. The
+// returned error wraps ErrInvalidToken, ErrInsufficientScope, or ErrKeysUnavailable.
+func (v *AccessTokenVerifier) Verify(ctx context.Context, token string) (*AccessTokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidToken)
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		KID string `json:"kid"`
+	}
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if header.Alg != "RS256" {
+		return nil, fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidToken, header.Alg)
+	}
+
+	key, err := v.key(ctx, header.KID)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, fmt.Errorf("%w: invalid signature", ErrInvalidToken)
+	}
+
+	claims := new(AccessTokenClaims)
+	if err := decodeJWTPart(parts[1], claims); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if err := decodeJWTPart(parts[1], &claims.Raw); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	if err := v.validate(claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// validate checks the claims of a token with a valid signature.
+func (v *AccessTokenVerifier) validate(claims *AccessTokenClaims) error {
+	now := time.Now()
+	skew := int64(v.ClockSkew / time.Second)
+
+	if claims.Issuer != v.Issuer {
+		return fmt.Errorf("%w: issuer is %q", ErrInvalidToken, claims.Issuer)
+	}
+
+	audienceOK := false
+	for _, aud := range claims.Audience {
+		audienceOK = audienceOK || aud == v.Audience
+	}
+	if !audienceOK {
+		return fmt.Errorf("%w: audience is %q", ErrInvalidToken, claims.Audience)
+	}
+
+	if claims.ExpiresAt+skew < now.Unix() {
+		return fmt.Errorf("%w: token expired", ErrInvalidToken)
+	}
+	if claims.NotBefore-skew > now.Unix() || claims.IssuedAt-skew > now.Unix() {
+		return fmt.Errorf("%w: token used before issued", ErrInvalidToken)
+	}
+
+	if v.ClientID != "" && claims.ClientID != v.ClientID {
+		return fmt.Errorf("%w: client is %q", ErrInvalidToken, claims.ClientID)
+	}
+
+	for _, scope := range v.RequiredScopes {
+		if !claims.HasScope(scope) {
+			return fmt.Errorf("%w: %q", ErrInsufficientScope, scope)
+		}
+	}
+	return nil
+}
+
+// key returns the signing key kid, refreshing the keys if it is unknown.
+func (v *AccessTokenVerifier) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	v.startOnce.Do(v.start)
+
+	v.keysMu.RLock()
+	key, ok := v.keys[kid]
+	v.keysMu.RUnlock()
+	if ok {
+		return key, nil
+	}
+
+	// Unknown keys trigger at most one refresh every 10 seconds, so that tokens with made up key
+	// IDs can't be used to flood the JWKS endpoint.
+	// While the last refresh failed, the key may well be known to the authorization server.
+	v.refreshMu.Lock()
+	if time.Since(v.lastRefresh) > 10*time.Second {
+		v.refresh(ctx)
+	}
+	err := v.refreshErr
+	v.refreshMu.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrKeysUnavailable, err)
+	}
+
+	v.keysMu.RLock()
+	key, ok = v.keys[kid]
+	v.keysMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown signing key %q", ErrInvalidToken, kid)
+	}
+	return key, nil
+}
+
+// refreshLoop refreshes the signing keys every RefreshInterval until stop is closed.
+func (v *AccessTokenVerifier) refreshLoop(stop chan struct{}) {
+	if v.RefreshInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(v.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			v.refreshMu.Lock()
+			v.refresh(context.Background())
+			v.refreshMu.Unlock()
+		}
+	}
+}
+
+// refresh fetches the signing keys, and records the error of the fetch. refreshMu must be held.
+func (v *AccessTokenVerifier) refresh(ctx context.Context) error {
+	v.lastRefresh = time.Now()
+	v.refreshErr = v.fetchKeys(ctx)
+	return v.refreshErr
+}
+
+// fetchKeys fetches the signing keys from the JWKS endpoint, and replaces the cached ones.
+func (v *AccessTokenVerifier) fetchKeys(ctx context.Context) error {
+
+	req, err := http.NewRequest("GET", v.jwksURL(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	httpClient := v.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetching signing keys: %s", resp.Status)
+	}
+
+	var jwks struct {
+		Keys []*JSONWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&jwks); err != nil {
+		return err
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(jwks.Keys))
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		key, err := k.RSAPublicKey()
+		if err != nil {
+			return err
+		}
+		keys[k.KID] = key
+	}
+
+	v.keysMu.Lock()
+	v.keys = keys
+	v.keysMu.Unlock()
+	return nil
+}
+
+// jwksURL returns the JWKS endpoint of the authorization server.
+func (v *AccessTokenVerifier) jwksURL() string {
+	return oauthEndpoint(v.Issuer, "keys")
+}
+
+// oauthEndpoint returns the URL of the endpoint of the authorization server whose issuer is
+// issuer, e.g. "keys". The org authorization server, whose issuer is the org URL, serves its
+// endpoints under /oauth2.
+func oauthEndpoint(issuer string, endpoint string) string {
+	issuer = strings.TrimSuffix(issuer, "/")
+	if strings.Contains(issuer, "/oauth2/") {
+		return issuer + "/v1/" + endpoint
+	}
+	return issuer + "/oauth2/v1/" + endpoint
+}
+
+// RSAPublicKey returns the RSA public key of an RSA JSONWebKey.
+func (k *JSONWebKey) RSAPublicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("key %s: invalid modulus: %v", k.KID, err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("key %s: invalid exponent: %v", k.KID, err)
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
+
+// decodeJWTPart decodes a base64url encoded JSON part of a JWT into v.
+func decodeJWTPart(part string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,77 @@
+package okta
+
+// AgentPool represents a pool of on-premises agents of the same type, e.g. AD or LDAP agents.
+//
+// https://developer.okta.com/docs/reference/api/agent-pools/#agent-pool-object
+type AgentPool struct {
+	RawJSON
+	ID                string  `json:"id"`
+	Name              string  `json:"name"`
+	Type              string  `json:"type"`
+	OperationalStatus string  `json:"operationalStatus"`
+	DisruptedAgents   int     `json:"disruptedAgents"`
+	InactiveAgents    int     `json:"inactiveAgents"`
+	Agents            []Agent `json:"agents"`
+}
+
+// Agent represents a single on-premises agent.
+//
+// https://developer.okta.com/docs/reference/api/agent-pools/#agent-object
+type Agent struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// OperationalStatus has possible values of: "OPERATIONAL", "DISRUPTED", "INACTIVE"
+	OperationalStatus   string      `json:"operationalStatus"`
+	Version             string      `json:"version"`
+	IsHidden            bool        `json:"isHidden"`
+	IsLatestGAedVersion bool        `json:"isLatestGAedVersion"`
+	LastConnection      int64       `json:"lastConnection"`
+	UpdateStatus        string      `json:"updateStatus"`
+	UpdateMessage       string      `json:"updateMessage"`
+	PoolID              string      `json:"poolId"`
+	Links               interface{} `json:"_links,omitempty"`
+}
+
+// AgentPoolUpdate represents an auto-update of the agents of a pool.
+//
+// https://developer.okta.com/docs/reference/api/agent-pools/#agent-pool-update-object
+type AgentPoolUpdate struct {
+	ID          string                   `json:"id,omitempty"`
+	Name        string                   `json:"name"`
+	AgentType   string                   `json:"agentType"`
+	Agents      []Agent                  `json:"agents,omitempty"`
+	Enabled     bool                     `json:"enabled"`
+	NotifyAdmin bool                     `json:"notifyAdmin"`
+	Reason      string                   `json:"reason,omitempty"`
+	Schedule    *AgentPoolUpdateSchedule `json:"schedule,omitempty"`
+	SortOrder   int                      `json:"sortOrder,omitempty"`
+	// Status has possible values of: "Scheduled", "Queued", "Paused", "InProgress", "Completed", "Cancelled", "Failed"
+	Status string      `json:"status,omitempty"`
+	Links  interface{} `json:"_links,omitempty"`
+}
+
+// AgentPoolUpdateSchedule represents the maintenance window of an auto-update.
+//
+// https://developer.okta.com/docs/reference/api/agent-pools/#agent-pool-update-schedule-object
+type AgentPoolUpdateSchedule struct {
+	Cron        string    `json:"cron"`
+	Delay       int       `json:"delay"`
+	Duration    int       `json:"duration"`
+	Timezone    string    `json:"timezone"`
+	LastUpdated Timestamp `json:"lastUpdated,omitempty"`
+}
+
+// AgentPoolUpdateSetting represents the auto-update settings of a pool.
+//
+// https://developer.okta.com/docs/reference/api/agent-pools/#agent-pool-update-setting-object
+type AgentPoolUpdateSetting struct {
+	AgentType               string `json:"agentType,omitempty"`
+	ContinueOnError         bool   `json:"continueOnError"`
+	LatestVersion           string `json:"latestVersion,omitempty"`
+	MinimalSupportedVersion string `json:"minimalSupportedVersion,omitempty"`
+	PoolID                  string `json:"poolId,omitempty"`
+	PoolName                string `json:"poolName,omitempty"`
+	// ReleaseChannel has possible values of: "GA", "EA", "BETA", "TEST"
+	ReleaseChannel string `json:"releaseChannel,omitempty"`
+}
@@ -0,0 +1,150 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// AgentPoolsService is the service providing access to the Agent Pools Resource in the Okta API
+type AgentPoolsService service
+
+// List fetches the first page of the agent pools of the org and the status of their agents.
+// poolType optionally filters by agent type, e.g. "AD" or "LDAP".
+//
+// https://developer.okta.com/docs/reference/api/agent-pools/#list-agent-pools
+func (s *AgentPoolsService) List(ctx context.Context, poolType string) (*Collection[*AgentPool], *Response, error) {
+	query := url.Values{}
+	if poolType != "" {
+		query.Set("poolType", poolType)
+	}
+	path := fmt.Sprintf("agentPools?%s", query.Encode())
+	return listPage[*AgentPool](ctx, s.client, RateLimitCategoryCore, path)
+}
+
+// ListUpdates fetches the auto-updates of an agent pool, optionally only the scheduled ones.
+//
+// https://developer.okta.com/docs/reference/api/agent-pools/#list-all-agent-pool-updates
+func (s *AgentPoolsService) ListUpdates(ctx context.Context, poolID string, scheduled bool) ([]*AgentPoolUpdate, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("agentPools/%s/updates?scheduled=%s", poolID, strconv.FormatBool(scheduled))
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updates []*AgentPoolUpdate
+	resp, err := s.client.Do(ctx, req, &updates)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updates, resp, nil
+}
+
+// GetUpdate fetches an auto-update of an agent pool.
+//
+// https://developer.okta.com/docs/reference/api/agent-pools/#get-agent-pool-update-by-id
+func (s *AgentPoolsService) GetUpdate(ctx context.Context, poolID string, updateID string) (*AgentPoolUpdate, *Response, error) {
+	return s.doUpdate(ctx, "GET", fmt.Sprintf("agentPools/%s/updates/%s", poolID, updateID), nil)
+}
+
+// AddUpdate creates an auto-update for the agents of a pool.
+//
+// https://developer.okta.com/docs/reference/api/agent-pools/#create-agent-pool-update
+func (s *AgentPoolsService) AddUpdate(ctx context.Context, poolID string, updateIn *AgentPoolUpdate) (*AgentPoolUpdate, *Response, error) {
+	return s.doUpdate(ctx, "POST", fmt.Sprintf("agentPools/%s/updates", poolID), updateIn)
+}
+
+// UpdateUpdate modifies an auto-update of an agent pool, e.g. to move its maintenance window.
+//
+// https://developer.okta.com/docs/reference/api/agent-pools/#update-agent-pool-update-by-id
+func (s *AgentPoolsService) UpdateUpdate(ctx context.Context, poolID string, updateID string, updateIn *AgentPoolUpdate) (*AgentPoolUpdate, *Response, error) {
+	return s.doUpdate(ctx, "POST", fmt.Sprintf("agentPools/%s/updates/%s", poolID, updateID), updateIn)
+}
+
+// RemoveUpdate deletes an auto-update of an agent pool.
+//
+// https://developer.okta.com/docs/reference/api/agent-pools/#delete-agent-pool-update-by-id
+func (s *AgentPoolsService) RemoveUpdate(ctx context.Context, poolID string, updateID string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("agentPools/%s/updates/%s", poolID, updateID)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// UpdateLifecycle runs a lifecycle operation on an auto-update of an agent pool. operation has possible
+// values of: "activate", "deactivate", "pause", "resume", "retry", "stop"
+//
+// https://developer.okta.com/docs/reference/api/agent-pools/#activate-agent-pool-update
+func (s *AgentPoolsService) UpdateLifecycle(ctx context.Context, poolID string, updateID string, operation string) (*AgentPoolUpdate, *Response, error) {
+	return s.doUpdate(ctx, "POST", fmt.Sprintf("agentPools/%s/updates/%s/%s", poolID, updateID, operation), nil)
+}
+
+// doUpdate is a helper function for the single auto-update operations.
+func (s *AgentPoolsService) doUpdate(ctx context.Context, method string, path string, updateIn *AgentPoolUpdate) (*AgentPoolUpdate, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if updateIn != nil {
+		body = updateIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updateOut := new(AgentPoolUpdate)
+	resp, err := s.client.Do(ctx, req, updateOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updateOut, resp, nil
+}
+
+// GetUpdateSettings fetches the auto-update settings of an agent pool.
+//
+// https://developer.okta.com/docs/reference/api/agent-pools/#get-agent-pool-update-settings
+func (s *AgentPoolsService) GetUpdateSettings(ctx context.Context, poolID string) (*AgentPoolUpdateSetting, *Response, error) {
+	return s.doUpdateSettings(ctx, "GET", poolID, nil)
+}
+
+// UpdateUpdateSettings modifies the auto-update settings of an agent pool.
+//
+// https://developer.okta.com/docs/reference/api/agent-pools/#update-agent-pool-update-settings
+func (s *AgentPoolsService) UpdateUpdateSettings(ctx context.Context, poolID string, settings *AgentPoolUpdateSetting) (*AgentPoolUpdateSetting, *Response, error) {
+	return s.doUpdateSettings(ctx, "POST", poolID, settings)
+}
+
+// doUpdateSettings is a helper function for the auto-update settings operations.
+func (s *AgentPoolsService) doUpdateSettings(ctx context.Context, method string, poolID string, settings *AgentPoolUpdateSetting) (*AgentPoolUpdateSetting, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("agentPools/%s/updates/settings", poolID)
+
+	var body interface{}
+	if settings != nil {
+		body = settings
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settingsOut := new(AgentPoolUpdateSetting)
+	resp, err := s.client.Do(ctx, req, settingsOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settingsOut, resp, nil
+}
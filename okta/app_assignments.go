@@ -0,0 +1,140 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// assignmentsConcurrency is the number of applications whose assignments
+// ListAssignedUsersForApps() lists at once.
+const assignmentsConcurrency = 4
+
+// maxRateLimitWaits is the number of times a request rejected by the rate limit is retried, once
+// the rate limit resets, by the helpers that wait for it.
+const maxRateLimitWaits = 3
+
+// GetAssignedUser fetches the assignment of a user to an application. Its Scope tells whether the
+// user is assigned directly, "USER", or through a group, "GROUP".
+//
+// https://developer.okta.com/docs/reference/api/apps/#get-assigned-user-for-application
+func (s *AppsService) GetAssignedUser(ctx context.Context, id string, userID string) (*AppUser, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("apps/%s/users/%s", id, userID)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	appUser := new(AppUser)
+	resp, err := s.client.Do(ctx, req, appUser)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return appUser, resp, nil
+}
+
+// UnassignUser removes the direct assignment of a user to an application, which deprovisions the
+// user from it. The users assigned through a group remain assigned until they leave the group.
+//
+// https://developer.okta.com/docs/reference/api/apps/#remove-user-from-application
+func (s *AppsService) UnassignUser(ctx context.Context, id string, userID string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("apps/%s/users/%s", id, userID)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListAssignedUsersForApps fetches all the users assigned to each of the applications appIDs, e.g.
+// for an access review, and returns them by application ID. A few applications are listed at once.
+//
+// The listings share the rate limit state of the client: once a request exhausts the rate limit,
+// the others wait for it to reset instead of being rejected, and a page rejected by the rate limit
+// is retried after the reset. Any other error, or ctx being done, stops the listing; the map then
+// holds the applications listed until then, alongside a *PartialResultError[*AppUser] with their
+// users.
+//
+// https://developer.okta.com/docs/api/resources/apps#list-users-assigned-to-application
+func (s *AppsService) ListAssignedUsersForApps(ctx context.Context, appIDs []string) (map[string][]*AppUser, error) {
+	assignments := make(map[string][]*AppUser, len(appIDs))
+	var mu sync.Mutex
+
+	tasks := make([]func(ctx context.Context) error, 0, len(appIDs))
+	for _, id := range appIDs {
+		id := id
+		tasks = append(tasks, func(ctx context.Context) error {
+			appUsers, err := s.listAllAssignedUsers(ctx, id)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			assignments[id] = appUsers
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	if err := runConcurrently(ctx, assignmentsConcurrency, tasks); err != nil {
+		var appUsers []*AppUser
+		for _, id := range appIDs {
+			appUsers = append(appUsers, assignments[id]...)
+		}
+		return assignments, partialResult(appUsers, err)
+	}
+	return assignments, nil
+}
+
+// listAllAssignedUsers fetches all the users assigned to an application, waiting for the rate
+// limit to reset when it's exceeded.
+func (s *AppsService) listAllAssignedUsers(ctx context.Context, id string) ([]*AppUser, error) {
+	var page *Collection[*AppUser]
+	err := s.client.waitRateLimit(ctx, func() (err error) {
+		page, _, err = s.ListAssignedUsers(ctx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	appUsers := page.Items
+	for page.HasNextPage() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		err := s.client.waitRateLimit(ctx, func() error {
+			next, err := page.NextPage(ctx)
+			if err == nil {
+				page = next
+			}
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		appUsers = append(appUsers, page.Items...)
+	}
+	return appUsers, nil
+}
+
+// waitRateLimit calls fn, and calls it again once the rate limit resets, up to maxRateLimitWaits
+// times, as long as it returns a *RateLimitError.
+func (c *Client) waitRateLimit(ctx context.Context, fn func() error) error {
+	for waits := 0; ; waits++ {
+		err := fn()
+		var rateErr *RateLimitError
+		if !errors.As(err, &rateErr) || waits == maxRateLimitWaits {
+			return err
+		}
+		if err := c.sleepUntil(ctx, rateErr.Rate.Reset.Time); err != nil {
+			return err
+		}
+	}
+}
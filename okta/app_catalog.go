@@ -0,0 +1,107 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+// CatalogAppSettings represents the settings of an instance of an application of the Okta
+// Integration Network (OIN) catalog, e.g. ZoomSettings. It's marshaled as the "app" object of the
+// settings of the instance; CatalogApp covers the applications without a settings struct.
+//
+// https://developer.okta.com/docs/reference/api/apps/#add-application
+type CatalogAppSettings interface {
+	// CatalogName returns the name of the application in the catalog, e.g. "zoomus".
+	CatalogName() AppName
+	// CatalogSignOnMode returns the sign on mode of the instance, one the application supports.
+	CatalogSignOnMode() AppSignOnMode
+}
+
+// CatalogApp represents the settings of an instance of any application of the catalog, by name,
+// e.g. those of an application without a settings struct in this package. The names and the
+// settings of an application show in the JSON of an instance of it, see AppsService.GetByID().
+type CatalogApp struct {
+	Name       AppName
+	SignOnMode AppSignOnMode
+	// Settings is the "app" object of the settings of the instance, e.g.
+	// {"domain": "example"}.
+	Settings map[string]interface{}
+}
+
+// CatalogName returns the name of the application.
+func (a *CatalogApp) CatalogName() AppName {
+	return a.Name
+}
+
+// CatalogSignOnMode returns the sign on mode of the instance.
+func (a *CatalogApp) CatalogSignOnMode() AppSignOnMode {
+	return a.SignOnMode
+}
+
+// MarshalJSON implements the json.Marshaler interface, marshaling the settings.
+func (a *CatalogApp) MarshalJSON() ([]byte, error) {
+	if a.Settings == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(a.Settings)
+}
+
+// ZoomSettings represents the settings of an instance of the Zoom application of the catalog,
+// signing in with SAML.
+type ZoomSettings struct {
+	// SubDomain is the subdomain of the Zoom account, e.g. "example" for example.zoom.us.
+	SubDomain string `json:"subDomain"`
+}
+
+// CatalogName returns "zoomus".
+func (*ZoomSettings) CatalogName() AppName {
+	return AppNameZoom
+}
+
+// CatalogSignOnMode returns AppSignOnModeSAML2.
+func (*ZoomSettings) CatalogSignOnMode() AppSignOnMode {
+	return AppSignOnModeSAML2
+}
+
+// SlackSettings represents the settings of an instance of the Slack application of the catalog,
+// signing in with SAML.
+type SlackSettings struct {
+	// Domain is the subdomain of the Slack workspace, e.g. "example" for example.slack.com.
+	Domain string `json:"domain"`
+}
+
+// CatalogName returns "slack".
+func (*SlackSettings) CatalogName() AppName {
+	return AppNameSlack
+}
+
+// CatalogSignOnMode returns AppSignOnModeSAML2.
+func (*SlackSettings) CatalogSignOnMode() AppSignOnMode {
+	return AppSignOnModeSAML2
+}
+
+// AddCatalogApp creates a new instance of an application of the Okta Integration Network catalog,
+// e.g. Zoom or Slack, it wraps Add(). Okta fills in the settings of the application the instance
+// doesn't set, and the label of the application if label is empty.
+//
+//	app, _, err := client.Apps.AddCatalogApp(ctx, "Zoom", true, &okta.ZoomSettings{SubDomain: "example"})
+//
+// https://developer.okta.com/docs/reference/api/apps/#add-application
+func (s *AppsService) AddCatalogApp(ctx context.Context, label string, activate bool, settings CatalogAppSettings) (*App, *Response, error) {
+	if settings.CatalogName() == "" || settings.CatalogSignOnMode() == "" {
+		return nil, nil, errors.New("Invalid parameters, the catalog name and the sign on mode of the application must be set")
+	}
+
+	appIn := new(App)
+	appIn.Name = settings.CatalogName()
+	appIn.SignOnMode = settings.CatalogSignOnMode()
+	appIn.Label = label
+	appIn.Visibility = NewAppVisability()
+	appIn.Settings = map[string]interface{}{
+		"app": settings,
+	}
+
+	appOut, resp, err := s.Add(ctx, appIn, activate)
+	return appOut, resp, err
+}
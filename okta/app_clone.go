@@ -0,0 +1,142 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// CloneAppParams is a helper struct for calling CloneApp().
+type CloneAppParams struct {
+	// Label of the application created in the target org. Defaults to the label of the source
+	// application.
+	Label string
+	// Activate activates the application created in the target org.
+	Activate bool
+	// SkipGroups leaves out the group assignments.
+	SkipGroups bool
+}
+
+// AppClone represents the application CloneApp() created in the target org.
+type AppClone struct {
+	// App is the application in the target org.
+	App *App
+	// Groups records the assignment of the groups of the source application; items are the names
+	// of the groups. The groups without a namesake in the target org fail.
+	Groups *BulkResult
+}
+
+// CloneApp recreates a SAML, OpenID Connect or bookmark application of the source org in the
+// target org, e.g. to promote an application configured in a sandbox to production. It copies:
+//
+//   - the settings, including the attribute statements of SAML applications,
+//   - the accessibility and visibility,
+//   - the username template and authentication scheme,
+//   - the group assignments, with their priority and profile, to the groups of the target org with the same name.
+//
+// The signing key and the OAuth client credentials belong to the source org and aren't copied:
+// Okta generates new ones in the target org, to be shared with the service provider.
+//
+// The steps aren't transactional. If a step fails the application created so far is returned
+// alongside the error, so that callers can remove it.
+func CloneApp(ctx context.Context, source *Client, target *Client, id string, params *CloneAppParams) (*AppClone, error) {
+	if params == nil {
+		params = new(CloneAppParams)
+	}
+
+	app, _, err := source.Apps.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	switch app.SignOnMode {
+	case AppSignOnModeSAML2, AppSignOnModeOpenIDConnect, AppSignOnModeBookmark:
+	default:
+		return nil, fmt.Errorf("Invalid application, %s has sign on mode %s; only SAML, OpenID Connect and bookmark applications can be cloned", id, app.SignOnMode)
+	}
+
+	appIn := &App{
+		Name:          app.Name,
+		Label:         app.Label,
+		SignOnMode:    app.SignOnMode,
+		Accessibility: app.Accessibility,
+		Visibility:    app.Visibility,
+		Credentials: AppCredential{
+			Scheme:           app.Credentials.Scheme,
+			UserNameTemplate: app.Credentials.UserNameTemplate,
+			OAuthClient: AppCredentialOAuthCredential{
+				TokenEndpointAuthMethod: app.Credentials.OAuthClient.TokenEndpointAuthMethod,
+				AutoKeyRotation:         app.Credentials.OAuthClient.AutoKeyRotation,
+			},
+		},
+		Settings: app.Settings,
+		Profile:  app.Profile,
+	}
+	if params.Label != "" {
+		appIn.Label = params.Label
+	}
+
+	clone := new(AppClone)
+	clone.App, _, err = target.Apps.Add(ctx, appIn, params.Activate)
+	if err != nil {
+		return nil, err
+	}
+	if params.SkipGroups {
+		return clone, nil
+	}
+
+	clone.Groups, err = cloneGroupAssignments(ctx, source, target, id, clone.App.ID)
+	return clone, err
+}
+
+// cloneGroupAssignments assigns the groups of the target org with the same name as the groups
+// assigned to the application id of the source org to the application targetID.
+func cloneGroupAssignments(ctx context.Context, source *Client, target *Client, id string, targetID string) (*BulkResult, error) {
+	page, _, err := source.Apps.ListAssignedGroups(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	assignments, _, err := page.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(BulkResult)
+	for _, assignment := range assignments {
+		group, _, err := source.Groups.GetByID(ctx, assignment.ID)
+		if err != nil {
+			return result, err
+		}
+		name := group.Profile.Name
+
+		targetGroupID, resp, err := groupIDByName(ctx, target, name)
+		if err == nil && targetGroupID == "" {
+			err = fmt.Errorf("no group named %q in the target org", name)
+		}
+		if err == nil {
+			_, resp, err = target.Apps.AssignGroup(ctx, targetID, targetGroupID, &AppGroupAssignment{
+				Priority: assignment.Priority,
+				Profile:  assignment.Profile,
+			})
+		}
+		result.record(name, resp, err)
+	}
+	return result, nil
+}
+
+// groupIDByName returns the ID of the group of client named name, or an empty string if there is
+// none.
+func groupIDByName(ctx context.Context, client *Client, name string) (string, *Response, error) {
+	page, resp, err := client.Groups.List(ctx, &GroupListParams{Q: name})
+	if err != nil {
+		return "", resp, err
+	}
+	groups, resp, err := page.All(ctx)
+	if err != nil {
+		return "", resp, err
+	}
+	for _, g := range groups {
+		if g.Profile.Name == name {
+			return g.ID, resp, nil
+		}
+	}
+	return "", resp, nil
+}
@@ -0,0 +1,97 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// ListGroupPushMappings fetches the first page of group push mappings of an application, optionally filtered by params.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/GroupPushMapping/#tag/GroupPushMapping/operation/listGroupPushMappings
+func (s *AppsService) ListGroupPushMappings(ctx context.Context, id string, params *GroupPushMappingListParams) (*Collection[*GroupPushMapping], *Response, error) {
+	query := url.Values{}
+	query.Set("limit", "100")
+	if params != nil {
+		if params.SourceGroupID != "" {
+			query.Set("sourceGroupId", params.SourceGroupID)
+		}
+		if params.Status != "" {
+			query.Set("status", params.Status)
+		}
+	}
+	path := fmt.Sprintf("apps/%s/group-push/mappings?%s", id, query.Encode())
+
+	return listPage[*GroupPushMapping](ctx, s.client, RateLimitCategoryCore, path)
+}
+
+// GetGroupPushMapping fetches a group push mapping of an application.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/GroupPushMapping/#tag/GroupPushMapping/operation/getGroupPushMapping
+func (s *AppsService) GetGroupPushMapping(ctx context.Context, id, mappingID string) (*GroupPushMapping, *Response, error) {
+	return s.doGroupPushMapping(ctx, "GET", fmt.Sprintf("apps/%s/group-push/mappings/%s", id, mappingID), nil)
+}
+
+// AddGroupPushMapping creates a group push mapping for an application.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/GroupPushMapping/#tag/GroupPushMapping/operation/createGroupPushMapping
+func (s *AppsService) AddGroupPushMapping(ctx context.Context, id string, params *GroupPushMappingAddParams) (*GroupPushMapping, *Response, error) {
+	if params == nil || params.SourceGroupID == "" {
+		return nil, nil, errors.New("Invalid parameters, `SourceGroupID` must be set")
+	}
+	if (params.TargetGroupID == "") == (params.TargetGroupName == "") {
+		return nil, nil, errors.New("Invalid parameters, exactly one of `TargetGroupID` and `TargetGroupName` must be set")
+	}
+
+	return s.doGroupPushMapping(ctx, "POST", fmt.Sprintf("apps/%s/group-push/mappings", id), params)
+}
+
+// ActivateGroupPushMapping activates an inactive group push mapping.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/GroupPushMapping/#tag/GroupPushMapping/operation/updateGroupPushMapping
+func (s *AppsService) ActivateGroupPushMapping(ctx context.Context, id, mappingID string) (*GroupPushMapping, *Response, error) {
+	return s.doGroupPushMapping(ctx, "PATCH", fmt.Sprintf("apps/%s/group-push/mappings/%s", id, mappingID), map[string]string{"status": "ACTIVE"})
+}
+
+// DeactivateGroupPushMapping deactivates an active group push mapping. Mappings must be
+// deactivated before they can be deleted.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/GroupPushMapping/#tag/GroupPushMapping/operation/updateGroupPushMapping
+func (s *AppsService) DeactivateGroupPushMapping(ctx context.Context, id, mappingID string) (*GroupPushMapping, *Response, error) {
+	return s.doGroupPushMapping(ctx, "PATCH", fmt.Sprintf("apps/%s/group-push/mappings/%s", id, mappingID), map[string]string{"status": "INACTIVE"})
+}
+
+// RemoveGroupPushMapping deletes an inactive group push mapping. If deleteTargetGroup is true the
+// group in the downstream application is deleted as well.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/GroupPushMapping/#tag/GroupPushMapping/operation/deleteGroupPushMapping
+func (s *AppsService) RemoveGroupPushMapping(ctx context.Context, id, mappingID string, deleteTargetGroup bool) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("apps/%s/group-push/mappings/%s?deleteTargetGroup=%t", id, mappingID, deleteTargetGroup)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// doGroupPushMapping is a helper function for the requests returning a GroupPushMapping.
+func (s *AppsService) doGroupPushMapping(ctx context.Context, method, path string, body interface{}) (*GroupPushMapping, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mappingOut := new(GroupPushMapping)
+	resp, err := s.client.Do(ctx, req, mappingOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mappingOut, resp, nil
+}
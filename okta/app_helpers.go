@@ -1,5 +1,11 @@
 package okta
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
 // NewAppVisability is a helper method to create a new AppVisability object with default settings.
 func NewAppVisability() AppVisability {
 	return AppVisability{
@@ -10,3 +16,81 @@ func NewAppVisability() AppVisability {
 		},
 	}
 }
+
+// Username template type Constants
+//
+// https://developer.okta.com/docs/reference/api/apps/#username-template-object
+const (
+	UserNameTemplateTypeNone    = "NONE"
+	UserNameTemplateTypeBuiltIn = "BUILT_IN"
+	UserNameTemplateTypeCustom  = "CUSTOM"
+)
+
+// Built in username template Constants, the templates Okta offers for the BUILT_IN type.
+const (
+	// UserNameTemplateOktaUsername is the Okta username of the user.
+	UserNameTemplateOktaUsername = "${source.login}"
+	// UserNameTemplateOktaUsernamePrefix is the Okta username of the user up to the "@".
+	UserNameTemplateOktaUsernamePrefix = `${fn:substringBefore(source.login, "@")}`
+	// UserNameTemplateEmail is the email of the user.
+	UserNameTemplateEmail = "${source.email}"
+	// UserNameTemplateEmailPrefix is the email of the user up to the "@".
+	UserNameTemplateEmailPrefix = `${fn:substringBefore(source.email, "@")}`
+	// UserNameTemplateSAMAccountName is the AD SAM account name of the user.
+	UserNameTemplateSAMAccountName = "${source.samAccountName}"
+	// UserNameTemplateUserPrincipalName is the AD user principal name of the user.
+	UserNameTemplateUserPrincipalName = "${source.userPrincipalName}"
+)
+
+// NewBuiltInUserNameTemplate is a helper method to create a new AppCredentialsUserNameTemplate of
+// one of the built in templates, e.g. UserNameTemplateEmail. suffix, e.g. "@example.com", is
+// appended to the username if it isn't empty.
+func NewBuiltInUserNameTemplate(template string, suffix string) AppCredentialsUserNameTemplate {
+	return AppCredentialsUserNameTemplate{
+		Type:       UserNameTemplateTypeBuiltIn,
+		Template:   template,
+		UserSuffix: suffix,
+	}
+}
+
+// NewCustomUserNameTemplate is a helper method to create a new AppCredentialsUserNameTemplate of
+// an Expression Language expression, e.g. `String.substringBefore(user.email, "@")`. suffix, e.g.
+// "@example.com", is appended to the expression as a string literal if it isn't empty, since
+// custom templates can't have a UserSuffix.
+func NewCustomUserNameTemplate(expr string, suffix string) AppCredentialsUserNameTemplate {
+	if suffix != "" {
+		expr = fmt.Sprintf(`%s + "%s"`, expr, strings.Replace(suffix, `"`, `""`, -1))
+	}
+	return AppCredentialsUserNameTemplate{
+		Type:     UserNameTemplateTypeCustom,
+		Template: expr,
+	}
+}
+
+// Validate returns an error if the template is a combination of type, template and suffix Okta
+// rejects. The expression of a custom template isn't checked, see el.ValidateApp() for that.
+func (t AppCredentialsUserNameTemplate) Validate() error {
+	switch t.Type {
+	case UserNameTemplateTypeNone:
+		if t.Template != "" || t.UserSuffix != "" {
+			return errors.New("Invalid username template, a NONE template can't have a template or a suffix")
+		}
+	case "", UserNameTemplateTypeBuiltIn:
+		if t.Template == "" && t.UserSuffix != "" {
+			return errors.New("Invalid username template, a suffix needs a template to be appended to")
+		}
+		if t.Template != "" && !strings.HasPrefix(t.Template, "${") {
+			return fmt.Errorf("Invalid username template, %q isn't a built in template, use a CUSTOM one", t.Template)
+		}
+	case UserNameTemplateTypeCustom:
+		if t.Template == "" {
+			return errors.New("Invalid username template, a CUSTOM template must have a template")
+		}
+		if t.UserSuffix != "" {
+			return errors.New("Invalid username template, a CUSTOM template can't have a suffix, append it to the expression")
+		}
+	default:
+		return fmt.Errorf("Invalid username template, unknown type %q", t.Type)
+	}
+	return nil
+}
@@ -1,42 +1,126 @@
 package okta
 
 import (
+	"fmt"
 	"net/url"
 	"time"
 )
 
 // App represents an application in Okta
 type App struct {
+	RawJSON
 	ID            string           `json:"id,omitempty"`
 	Name          AppName          `json:"name,omitempty"`
 	Label         string           `json:"label,omitempty"`
 	Created       Timestamp        `json:"created,omitempty"`
 	LastUpdated   Timestamp        `json:"lastUpdated,omitempty"`
-	Status        string           `json:"status,omitempty"`
-	Features      []string         `json:"features,omitempty"`
+	Status        AppStatus        `json:"status,omitempty"`
+	Features      []AppFeatureName `json:"features,omitempty"`
 	SignOnMode    AppSignOnMode    `json:"signOnMode"`
 	Accessibility AppAccessibility `json:"accessibility"`
 	Visibility    AppVisability    `json:"visibility"`
 	Credentials   AppCredential    `json:"credentials"`
 	Settings      interface{}      `json:"settings,omitempty"`
 	Profile       interface{}      `json:"profile,omitempty"`
+	Links         interface{}      `json:"_links,omitempty"`
+}
+
+// AppStatus is a type for the status enum of applications. Okta may return values added since,
+// see Valid().
+//
+// https://developer.okta.com/docs/reference/api/apps/#application-object
+type AppStatus string
+
+// AppStatus Constants
+const (
+	AppStatusActive   AppStatus = "ACTIVE"
+	AppStatusInactive           = "INACTIVE"
+	AppStatusDeleted            = "DELETED"
+)
+
+// AppStatuses is the list of the valid AppStatus values.
+var AppStatuses = []AppStatus{AppStatusActive, AppStatusInactive, AppStatusDeleted}
+
+// Valid reports whether s is a known status.
+func (s AppStatus) Valid() bool {
+	for _, v := range AppStatuses {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// AppFeatureName is a type for the features enum of applications, the provisioning features
+// enabled on an application. Okta may return values added since, see Valid().
+//
+// https://developer.okta.com/docs/reference/api/apps/#features
+type AppFeatureName string
+
+// AppFeatureName Constants
+const (
+	AppFeatureGroupPush             AppFeatureName = "GROUP_PUSH"
+	AppFeatureImportNewUsers                       = "IMPORT_NEW_USERS"
+	AppFeatureImportProfileUpdates                 = "IMPORT_PROFILE_UPDATES"
+	AppFeatureImportUserSchema                     = "IMPORT_USER_SCHEMA"
+	AppFeatureProfileMastering                     = "PROFILE_MASTERING"
+	AppFeaturePushNewUsers                         = "PUSH_NEW_USERS"
+	AppFeaturePushPasswordUpdates                  = "PUSH_PASSWORD_UPDATES"
+	AppFeaturePushPendingUsers                     = "PUSH_PENDING_USERS"
+	AppFeaturePushProfileUpdates                   = "PUSH_PROFILE_UPDATES"
+	AppFeaturePushUserDeactivation                 = "PUSH_USER_DEACTIVATION"
+	AppFeatureReactivateUsers                      = "REACTIVATE_USERS"
+	AppFeatureOutboundDelegatedAuth                = "OUTBOUND_DEL_AUTH"
+	AppFeatureVPNConfig                            = "VPN_CONFIG"
+)
+
+// AppFeatureNames is the list of the valid AppFeatureName values.
+var AppFeatureNames = []AppFeatureName{
+	AppFeatureGroupPush,
+	AppFeatureImportNewUsers,
+	AppFeatureImportProfileUpdates,
+	AppFeatureImportUserSchema,
+	AppFeatureProfileMastering,
+	AppFeaturePushNewUsers,
+	AppFeaturePushPasswordUpdates,
+	AppFeaturePushPendingUsers,
+	AppFeaturePushProfileUpdates,
+	AppFeaturePushUserDeactivation,
+	AppFeatureReactivateUsers,
+	AppFeatureOutboundDelegatedAuth,
+	AppFeatureVPNConfig,
+}
+
+// Valid reports whether f is a known feature.
+func (f AppFeatureName) Valid() bool {
+	for _, v := range AppFeatureNames {
+		if f == v {
+			return true
+		}
+	}
+	return false
 }
 
 // AppName is a type for the AppName enum.
 // Note that name in the okta context is used to delinate the type of app.
-// Shared apps, which can be used by multiple Okta Customers, aren't implemented.
+// Shared apps, the applications of the Okta Integration Network catalog, are instantiated by name
+// with AddCatalogApp().
 //
 // https://developer.okta.com/docs/api/resources/apps#app-names--settings
 type AppName string
 
 // AppName Constants
 // Note that name in the okta context is used to delinate the type of app.
-// Shared apps, which can be used by multiple Okta Customers, aren't implemented.
+// The names of the catalog applications are those of the catalog, e.g. "zoomus"; only a few of
+// them have a constant.
 //
 // https://developer.okta.com/docs/api/resources/apps#app-names--settings
 const (
 	AppNameBookmark AppName = "bookmark"
 	AppNameSAML2            = "Custom SAML 2.0"
+	AppNameOrg2Org          = "okta_org2org"
+	AppNameZoom             = "zoomus"
+	AppNameSlack            = "slack"
 	// AppNameOAuth2           = "oidc_client"
 	// AppNameSWA              = "Custom SWA"
 )
@@ -70,6 +154,29 @@ const (
 	AppSignOnModeCustom                            = "Custom"
 )
 
+// AppSignOnModes is the list of the valid AppSignOnMode values.
+var AppSignOnModes = []AppSignOnMode{
+	AppSignOnModeBookmark,
+	AppSignOnModeBasicAuth,
+	AppSignOnModeBrowserPlugin,
+	AppSignOnModeSecurePasswordStore,
+	AppSignOnModeSAML2,
+	AppSignOnModeWSFederation,
+	AppSignOnModeAutoLogin,
+	AppSignOnModeOpenIDConnect,
+	AppSignOnModeCustom,
+}
+
+// Valid reports whether m is a known sign on mode.
+func (m AppSignOnMode) Valid() bool {
+	for _, v := range AppSignOnModes {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
 // AppAuthenticationScheme is the type for the AppAuthenticationScheme enum
 //
 // https://developer.okta.com/docs/api/resources/apps#authentication-schemes
@@ -163,6 +270,42 @@ type AppAddSAMLAppParams struct {
 	HonorForceAuthn       bool
 	AuthnContextClassRef  string
 	AttributeStatements   []AppSAMLAttributeStatement
+	// SingleLogout enables the logouts initiated by the application, which requires SPCertificate.
+	SingleLogout *AppSAMLSingleLogout
+	// ParticipateSLO has the application notified of the logouts initiated by Okta or another
+	// application.
+	ParticipateSLO *AppSAMLParticipateSLO
+	SPCertificate  *AppSAMLSPCertificate
+}
+
+// AppSAMLSingleLogout represents the single logout settings of a SAML app, for the logouts it
+// initiates.
+//
+// https://developer.okta.com/docs/reference/api/apps/#single-logout
+type AppSAMLSingleLogout struct {
+	Enabled   bool   `json:"enabled"`
+	SPIssuer  string `json:"spIssuer,omitempty"`
+	LogoutURL string `json:"logoutUrl,omitempty"`
+}
+
+// AppSAMLParticipateSLO represents the settings of a SAML app for the logouts initiated by Okta
+// or another application.
+//
+// https://developer.okta.com/docs/reference/api/apps/#service-provider-initiated-logout
+type AppSAMLParticipateSLO struct {
+	Enabled              bool   `json:"enabled"`
+	LogoutRequestURL     string `json:"logoutRequestUrl,omitempty"`
+	SessionIndexRequired bool   `json:"sessionIndexRequired"`
+	// BindingType has possible values of: "POST", "REDIRECT"
+	BindingType string `json:"bindingType,omitempty"`
+}
+
+// AppSAMLSPCertificate represents the certificate of a SAML app, which signs its logout requests.
+//
+// https://developer.okta.com/docs/reference/api/apps/#service-provider-certificate
+type AppSAMLSPCertificate struct {
+	// X5C is the certificate chain, base64 encoded DER certificates.
+	X5C []string `json:"x5c"`
 }
 
 // AppVisability represents where an app is shown.
@@ -171,7 +314,7 @@ type AppAddSAMLAppParams struct {
 type AppVisability struct {
 	AutoSubmitToolbar bool              `json:"autoSubmitToolbar"`
 	Hide              AppVisabilityHide `json:"hide"`
-	// AppLinks
+	AppLinks          map[string]bool   `json:"appLinks,omitempty"`
 }
 
 // AppVisabilityHide is a helper struct.
@@ -186,23 +329,25 @@ type AppVisabilityHide struct {
 //
 // https://developer.okta.com/docs/api/resources/apps#application-user-model
 type AppUser struct {
-	ID              string    `json:"id"`
-	ExternalID      string    `json:"externalId"`
-	Created         time.Time `json:"created"`
-	LastUpdated     time.Time `json:"lastUpdated"`
-	Scope           string    `json:"scope"`
-	Status          string    `json:"status"`
-	StatusChanged   time.Time `json:"statusChanged"`
-	PasswordChanged time.Time `json:"passwordChanged"`
-	SyncState       string    `json:"syncState"`
-	LastSync        time.Time `json:"lastSync"`
+	RawJSON
+	ID              string           `json:"id"`
+	ExternalID      string           `json:"externalId"`
+	Created         time.Time        `json:"created"`
+	LastUpdated     time.Time        `json:"lastUpdated"`
+	Scope           string           `json:"scope"`
+	Status          string           `json:"status"`
+	StatusChanged   time.Time        `json:"statusChanged"`
+	PasswordChanged time.Time        `json:"passwordChanged"`
+	SyncState       AppUserSyncState `json:"syncState"`
+	LastSync        time.Time        `json:"lastSync"`
 	Credentials     struct {
 		UserName string `json:"userName"`
 		Password struct {
 		} `json:"password"`
 	} `json:"credentials"`
-	Profile struct{} `json:"profile"`
-	Links   struct {
+	Profile  map[string]interface{} `json:"profile"`
+	Embedded *AppUserEmbedded       `json:"_embedded,omitempty"`
+	Links    struct {
 		App struct {
 			Link string `json:"href"`
 		} `json:"app"`
@@ -211,3 +356,148 @@ type AppUser struct {
 		} `json:"user"`
 	} `json:"_links"`
 }
+
+// AppUserSyncState is a type for the AppUserSyncState enum, the state of the provisioning of an
+// AppUser.
+//
+// https://developer.okta.com/docs/reference/api/apps/#application-user-object
+type AppUserSyncState string
+
+// AppUserSyncState Constants
+const (
+	AppUserSyncStateDisabled     AppUserSyncState = "DISABLED"
+	AppUserSyncStateOutOfSync                     = "OUT_OF_SYNC"
+	AppUserSyncStateSyncing                       = "SYNCING"
+	AppUserSyncStateSynchronized                  = "SYNCHRONIZED"
+	AppUserSyncStateError                         = "ERROR"
+)
+
+// AppUserEmbedded represents the embedded resources of an AppUser.
+type AppUserEmbedded struct {
+	// Errors are the errors of the last provisioning of the user to the application.
+	Errors []*ProvisioningError `json:"errors,omitempty"`
+}
+
+// ProvisioningError represents an error of the provisioning of a user to an application, e.g.
+// a push rejected by the application.
+type ProvisioningError struct {
+	Code    string       `json:"errorCode"`
+	Summary string       `json:"errorSummary"`
+	ID      string       `json:"errorId,omitempty"`
+	Causes  []ErrorCause `json:"errorCauses,omitempty"`
+}
+
+func (e *ProvisioningError) Error() string {
+	return fmt.Sprintf("%s - %s %+v", e.Code, e.Summary, e.Causes)
+}
+
+// ProvisioningErrors returns the errors of the last provisioning of the user to the application.
+func (u *AppUser) ProvisioningErrors() []*ProvisioningError {
+	if u.Embedded == nil {
+		return nil
+	}
+	return u.Embedded.Errors
+}
+
+// Failed reports whether the last provisioning of the user to the application failed.
+func (u *AppUser) Failed() bool {
+	return u.SyncState == AppUserSyncStateError || len(u.ProvisioningErrors()) > 0
+}
+
+// AppGroupAssignment represents the assignment of a group to an App.
+//
+// https://developer.okta.com/docs/reference/api/apps/#application-group-model
+type AppGroupAssignment struct {
+	RawJSON
+	ID          string      `json:"id,omitempty"`
+	Priority    int         `json:"priority,omitempty"`
+	LastUpdated Timestamp   `json:"lastUpdated,omitempty"`
+	Profile     interface{} `json:"profile,omitempty"`
+}
+
+// AppFeature represents a provisioning feature of an App, e.g. USER_PROVISIONING.
+//
+// https://developer.okta.com/docs/reference/api/apps/#application-feature-object
+type AppFeature struct {
+	RawJSON
+	Name         string                 `json:"name,omitempty"`
+	Status       string                 `json:"status,omitempty"`
+	Description  string                 `json:"description,omitempty"`
+	Capabilities AppFeatureCapabilities `json:"capabilities"`
+}
+
+// AppFeatureCapabilities represents the provisioning capabilities of an AppFeature.
+//
+// https://developer.okta.com/docs/reference/api/apps/#capabilities-object
+type AppFeatureCapabilities struct {
+	Create struct {
+		LifecycleCreate AppFeatureStatus `json:"lifecycleCreate"`
+	} `json:"create"`
+	Update struct {
+		Profile             AppFeatureStatus `json:"profile"`
+		LifecycleDeactivate AppFeatureStatus `json:"lifecycleDeactivate"`
+		Password            struct {
+			Status string `json:"status"`
+			// Seed has possible values of: "OKTA", "RANDOM"
+			Seed string `json:"seed"`
+			// Change has possible values of: "KEEP_EXISTING", "CHANGE"
+			Change string `json:"change"`
+		} `json:"password"`
+	} `json:"update"`
+}
+
+// AppFeatureStatus is a helper struct.
+type AppFeatureStatus struct {
+	// Status has possible values of: "ENABLED", "DISABLED"
+	Status string `json:"status"`
+}
+
+// GroupPushMapping represents a mapping that pushes the members of an Okta group to a group in a
+// downstream application.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/GroupPushMapping/
+type GroupPushMapping struct {
+	RawJSON
+	ID            string `json:"id,omitempty"`
+	SourceGroupID string `json:"sourceGroupId"`
+	TargetGroupID string `json:"targetGroupId,omitempty"`
+	// Status has possible values of: "ACTIVE", "INACTIVE", "ERROR"
+	Status       string      `json:"status,omitempty"`
+	ErrorSummary string      `json:"errorSummary,omitempty"`
+	Created      Timestamp   `json:"created,omitempty"`
+	LastUpdated  Timestamp   `json:"lastUpdated,omitempty"`
+	LastPush     Timestamp   `json:"lastPush,omitempty"`
+	Links        interface{} `json:"_links,omitempty"`
+}
+
+// GroupPushMappingAddParams is a helper struct for calling AddGroupPushMapping().
+//
+// Set TargetGroupID to link the source group to an existing app group, resolving a conflict
+// with a group of the same name, or TargetGroupName to create a new app group.
+type GroupPushMappingAddParams struct {
+	SourceGroupID   string `json:"sourceGroupId"`
+	TargetGroupID   string `json:"targetGroupId,omitempty"`
+	TargetGroupName string `json:"targetGroupName,omitempty"`
+	// Status has possible values of: "ACTIVE", "INACTIVE"
+	Status string `json:"status,omitempty"`
+}
+
+// GroupPushMappingListParams is a helper struct for calling ListGroupPushMappings().
+type GroupPushMappingListParams struct {
+	SourceGroupID string
+	// Status has possible values of: "ACTIVE", "INACTIVE", "ERROR"
+	Status string
+}
+
+// andFilter is a helper function for the typed filters of the list params: it returns filter
+// combined with the clause attribute eq value, if value isn't empty.
+func andFilter(filter string, attribute string, value string) string {
+	if value == "" {
+		return filter
+	}
+	clause := fmt.Sprintf("%s eq %q", attribute, value)
+	if filter == "" {
+		return clause
+	}
+	return fmt.Sprintf("(%s) and %s", filter, clause)
+}
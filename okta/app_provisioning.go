@@ -0,0 +1,175 @@
+package okta
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+)
+
+// GetSAMLMetadata fetches the SAML IdP metadata XML of a SAML application.
+//
+// https://developer.okta.com/docs/reference/api/apps/#preview-saml-metadata-for-application
+func (s *AppsService) GetSAMLMetadata(ctx context.Context, id string) ([]byte, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryAppsGetUpdateDelete)
+	path := fmt.Sprintf("apps/%s/sso/saml/metadata", id)
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "application/xml")
+
+	metadata := new(bytes.Buffer)
+	resp, err := s.client.Do(ctx, req, metadata)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return metadata.Bytes(), resp, nil
+}
+
+// SetProvisioningConnection configures the default provisioning connection of an application to
+// authenticate with an API token, and optionally activates it.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/ApplicationConnections/
+func (s *AppsService) SetProvisioningConnection(ctx context.Context, id string, token string, activate bool) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryAppsGetUpdateDelete)
+	path := fmt.Sprintf("apps/%s/connections/default?activate=%t", id, activate)
+
+	body := map[string]interface{}{
+		"profile": map[string]interface{}{
+			"authScheme": "TOKEN",
+			"token":      token,
+		},
+	}
+
+	req, err := s.client.NewRequest("POST", path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// appProvisioningFeatures are the names of the provisioning features of the applications.
+var appProvisioningFeatures = map[string]bool{
+	"USER_PROVISIONING":    true,
+	"INBOUND_PROVISIONING": true,
+}
+
+// UpdateFeature modifies a provisioning feature of an application, e.g. USER_PROVISIONING. An
+// error is returned, without a request, if name isn't a provisioning feature.
+//
+// https://developer.okta.com/docs/reference/api/apps/#update-feature-for-application
+func (s *AppsService) UpdateFeature(ctx context.Context, id string, name string, capabilities *AppFeatureCapabilities) (*AppFeature, *Response, error) {
+	if !appProvisioningFeatures[name] {
+		return nil, nil, fmt.Errorf("okta: invalid provisioning feature %q", name)
+	}
+	defer s.client.invalidate(CacheResourceApps, id)
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryAppsGetUpdateDelete)
+	path := fmt.Sprintf("apps/%s/features/%s", id, name)
+
+	req, err := s.client.NewRequest("PUT", path, capabilities)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	featureOut := new(AppFeature)
+	resp, err := s.client.Do(ctx, req, featureOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return featureOut, resp, nil
+}
+
+// AssignGroup assigns a group to an application.
+//
+// https://developer.okta.com/docs/reference/api/apps/#assign-group-to-application
+func (s *AppsService) AssignGroup(ctx context.Context, id string, groupID string, assignment *AppGroupAssignment) (*AppGroupAssignment, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryAppsGetUpdateDelete)
+	path := fmt.Sprintf("apps/%s/groups/%s", id, groupID)
+
+	if assignment == nil {
+		assignment = new(AppGroupAssignment)
+	}
+
+	req, err := s.client.NewRequest("PUT", path, assignment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	assignmentOut := new(AppGroupAssignment)
+	resp, err := s.client.Do(ctx, req, assignmentOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return assignmentOut, resp, nil
+}
+
+// ListAssignedGroups fetches the first page of the groups assigned to an application.
+//
+// https://developer.okta.com/docs/reference/api/apps/#list-groups-assigned-to-application
+func (s *AppsService) ListAssignedGroups(ctx context.Context, id string) (*Collection[*AppGroupAssignment], *Response, error) {
+	path := fmt.Sprintf("apps/%s/groups?limit=%d", id, 200)
+	return listPage[*AppGroupAssignment](ctx, s.client, RateLimitCategoryCore, path)
+}
+
+// AssignUser assigns a user to an application. credentials and profile are optional, and are
+// only required by applications that don't derive them from the Okta user.
+//
+// https://developer.okta.com/docs/reference/api/apps/#assign-user-to-application-for-sso
+func (s *AppsService) AssignUser(ctx context.Context, id string, userID string, credentials map[string]interface{}, profile map[string]interface{}) (*AppUser, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryAppsGetUpdateDelete)
+	path := fmt.Sprintf("apps/%s/users", id)
+
+	body := map[string]interface{}{
+		"id":    userID,
+		"scope": "USER",
+	}
+	if credentials != nil {
+		body["credentials"] = credentials
+	}
+	if profile != nil {
+		body["profile"] = profile
+	}
+
+	req, err := s.client.NewRequest("POST", path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	appUserOut := new(AppUser)
+	resp, err := s.client.Do(ctx, req, appUserOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return appUserOut, resp, nil
+}
+
+// ListAssignmentsWithErrors fetches the users assigned to an application whose last provisioning
+// failed, see AppUser.Failed(), along with the Response of the last page. If the listing stops
+// early, the error is a *PartialResultError with the failed assignments found until then.
+func (s *AppsService) ListAssignmentsWithErrors(ctx context.Context, id string) ([]*AppUser, *Response, error) {
+	page, resp, err := s.ListAssignedUsers(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+	appUsers, resp, err := page.All(ctx)
+	if err != nil {
+		return nil, resp, partialResult(failedAssignments(partialItems[*AppUser](err)), err)
+	}
+	return failedAssignments(appUsers), resp, nil
+}
+
+// failedAssignments returns the assignments of appUsers whose last provisioning failed.
+func failedAssignments(appUsers []*AppUser) []*AppUser {
+	var failed []*AppUser
+	for _, appUser := range appUsers {
+		if appUser.Failed() {
+			failed = append(failed, appUser)
+		}
+	}
+	return failed
+}
@@ -0,0 +1,139 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// AppSession represents the sign-ins of a user to an application within an Okta session, see
+// SessionsService.ListAppSessions().
+type AppSession struct {
+	AppID string
+	// AppName is the display name of the application in the System Log.
+	AppName string
+	// SessionID is the ID of the Okta session, empty if the System Log didn't record it.
+	SessionID string
+	// LastSignIn is the time of the last sign-in to the application in the session.
+	LastSignIn time.Time
+	// Session is the Okta session, nil if it was closed, or it expired.
+	Session *Session
+}
+
+// Active reports whether the Okta session of the sign-ins is still open.
+func (s *AppSession) Active() bool {
+	return s.Session != nil
+}
+
+// ListAppSessions returns the applications a user signed in to since since, by Okta session,
+// most recent first, e.g. for a logout coordinated across the applications. The sign-ins are the
+// user.authentication.sso events of the System Log, the sessions their Okta session, fetched from
+// the Sessions API.
+//
+// Closing the Okta session doesn't close the sessions of the applications; those that don't
+// participate in single logout, see SAMLLogoutSettings(), must be logged out on their side.
+//
+// https://developer.okta.com/docs/reference/api/event-types/#catalog
+func (s *SessionsService) ListAppSessions(ctx context.Context, userID string, since time.Time) ([]*AppSession, error) {
+	params := &LogListParams{
+		Since:     since,
+		Until:     s.client.now(),
+		Filter:    fmt.Sprintf(`eventType eq "user.authentication.sso" and actor.id eq %q`, userID),
+		SortOrder: "ASCENDING",
+		Limit:     1000,
+	}
+
+	type key struct{ sessionID, appID string }
+	appSessions := make(map[key]*AppSession)
+	events, resp, err := s.client.Logs.List(ctx, params)
+	for {
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range events {
+			if event.Outcome != nil && event.Outcome.Result != "SUCCESS" {
+				continue
+			}
+			appSession := &AppSession{LastSignIn: event.Published.Time}
+			if event.AuthenticationContext != nil {
+				appSession.SessionID = event.AuthenticationContext.ExternalSessionID
+			}
+			for _, target := range event.Target {
+				if target.Type == "AppInstance" {
+					appSession.AppID = target.ID
+					appSession.AppName = target.DisplayName
+				}
+			}
+			if appSession.AppID == "" {
+				continue
+			}
+			appSessions[key{appSession.SessionID, appSession.AppID}] = appSession
+		}
+		if len(events) == 0 || resp.Pagination.Next == "" {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		events, resp, err = s.client.Logs.ListNext(ctx, resp.Pagination.Next)
+	}
+
+	sessions := make(map[string]*Session)
+	result := make([]*AppSession, 0, len(appSessions))
+	for _, appSession := range appSessions {
+		id := appSession.SessionID
+		if session, ok := sessions[id]; ok || id == "" {
+			appSession.Session = session
+			result = append(result, appSession)
+			continue
+		}
+		session, _, err := s.Get(ctx, id)
+		var errResp *ErrorResponse
+		if errors.As(err, &errResp) && errResp.Response.StatusCode == http.StatusNotFound {
+			err = nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if session != nil && session.UserID != userID {
+			session = nil
+		}
+		sessions[id] = session
+		appSession.Session = session
+		result = append(result, appSession)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].LastSignIn.After(result[j].LastSignIn)
+	})
+	return result, nil
+}
+
+// SAMLLogoutSettings returns the single logout settings of app, a SAML application: the logouts it
+// initiates, and its participation in the logouts initiated by Okta. Settings the application
+// doesn't have are nil.
+//
+// https://developer.okta.com/docs/reference/api/apps/#single-logout
+func SAMLLogoutSettings(app *App) (*AppSAMLSingleLogout, *AppSAMLParticipateSLO, error) {
+	if app.SignOnMode != AppSignOnModeSAML2 {
+		return nil, nil, fmt.Errorf("Invalid application, %s is a %s application, not a SAML one", app.ID, app.SignOnMode)
+	}
+	data, err := json.Marshal(app.Settings)
+	if err != nil {
+		return nil, nil, err
+	}
+	var settings struct {
+		SignOn struct {
+			SLO            *AppSAMLSingleLogout   `json:"slo"`
+			ParticipateSLO *AppSAMLParticipateSLO `json:"participateSlo"`
+		} `json:"signOn"`
+	}
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, nil, err
+	}
+	return settings.SignOn.SLO, settings.SignOn.ParticipateSLO, nil
+}
@@ -0,0 +1,109 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// logRetention is how long the System Log retains events.
+const logRetention = 90 * 24 * time.Hour
+
+// StaleAssignmentReport represents the assignments of an application unused for some time, see
+// AppsService.StaleAssignments().
+type StaleAssignmentReport struct {
+	AppID string
+	// Since is the start of the window searched for sign-ins.
+	Since time.Time
+	// Checked is the number of assignments checked.
+	Checked int
+	// Stale are the assignments without a sign-in since Since, in the order they were listed.
+	Stale []*StaleAssignment
+}
+
+// StaleAssignment represents an assignment of an application unused for some time.
+type StaleAssignment struct {
+	AppUser *AppUser
+	// LastSignIn is the time of the last sign-in of the user to the application before the
+	// window, if it was found in the System Log, otherwise zero.
+	LastSignIn time.Time
+}
+
+// StaleAssignments cross-references the users assigned to an application with the sign-ins to
+// the application in the System Log, and returns the assignments unused for unusedFor, e.g. to
+// reclaim licenses. Assignments more recent than unusedFor aren't reported.
+//
+// The System Log retains 90 days of events, so unusedFor can't be longer. The sign-ins searched
+// are the user.authentication.sso events, which Okta records for the SSO applications; the usage
+// of other applications, e.g. SWA ones, isn't seen and their assignments are all reported.
+//
+// https://developer.okta.com/docs/reference/api/event-types/#catalog
+func (s *AppsService) StaleAssignments(ctx context.Context, id string, unusedFor time.Duration) (*StaleAssignmentReport, error) {
+	if unusedFor <= 0 || unusedFor > logRetention {
+		return nil, fmt.Errorf("unusedFor must be between 0 and the 90 days retention of the System Log, not %v", unusedFor)
+	}
+	now := s.client.now()
+	since := now.Add(-unusedFor)
+
+	lastSignIns, err := s.lastSignIns(ctx, id, now.Add(-logRetention), now)
+	if err != nil {
+		return nil, err
+	}
+
+	page, _, err := s.ListAssignedUsers(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	appUsers, _, err := page.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &StaleAssignmentReport{AppID: id, Since: since, Checked: len(appUsers)}
+	for _, appUser := range appUsers {
+		if appUser.Created.After(since) {
+			continue
+		}
+		last := lastSignIns[appUser.ID]
+		if last.After(since) {
+			continue
+		}
+		report.Stale = append(report.Stale, &StaleAssignment{AppUser: appUser, LastSignIn: last})
+	}
+	return report, nil
+}
+
+// lastSignIns returns the time of the last sign-in to an application between since and until, by
+// user ID.
+func (s *AppsService) lastSignIns(ctx context.Context, id string, since time.Time, until time.Time) (map[string]time.Time, error) {
+	params := &LogListParams{
+		Since:     since,
+		Until:     until,
+		Filter:    fmt.Sprintf(`eventType eq "user.authentication.sso" and target.id eq %q`, id),
+		SortOrder: "ASCENDING",
+		Limit:     1000,
+	}
+
+	last := make(map[string]time.Time)
+	events, resp, err := s.client.Logs.List(ctx, params)
+	for {
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range events {
+			if event.Outcome != nil && event.Outcome.Result != "SUCCESS" {
+				continue
+			}
+			if event.Published.After(last[event.Actor.ID]) {
+				last[event.Actor.ID] = event.Published.Time
+			}
+		}
+		if len(events) == 0 || resp.Pagination.Next == "" {
+			return last, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		events, resp, err = s.client.Logs.ListNext(ctx, resp.Pagination.Next)
+	}
+}
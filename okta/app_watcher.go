@@ -0,0 +1,120 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AppEventType is a type for the event types of the System Log watched by an AppWatcher.
+//
+// https://developer.okta.com/docs/reference/api/event-types/
+type AppEventType string
+
+// AppEventType Constants
+const (
+	AppEventTypeCreated             AppEventType = "application.lifecycle.create"
+	AppEventTypeUpdated                          = "application.lifecycle.update"
+	AppEventTypeActivated                        = "application.lifecycle.activate"
+	AppEventTypeDeactivated                      = "application.lifecycle.deactivate"
+	AppEventTypeDeleted                          = "application.lifecycle.delete"
+	AppEventTypeUserAdded                        = "application.user_membership.add"
+	AppEventTypeUserRemoved                      = "application.user_membership.remove"
+	AppEventTypeUserUsernameChanged              = "application.user_membership.change_username"
+	AppEventTypeUserPasswordChanged              = "application.user_membership.change_password"
+	AppEventTypeUserProvisioned                  = "application.user_membership.provision"
+	AppEventTypeUserDeprovisioned                = "application.user_membership.deprovision"
+)
+
+// appEventPrefixes are the prefixes of the event types watched by an AppWatcher.
+var appEventPrefixes = []string{"application.lifecycle.", "application.user_membership."}
+
+// AppEvent represents a lifecycle or user membership event of an application, see AppWatcher.
+type AppEvent struct {
+	// Type is the type of the event, one of the AppEventType constants or another
+	// application.lifecycle.* or application.user_membership.* type.
+	Type  AppEventType
+	AppID string
+	// User is the user whose membership changed, for the user membership events, otherwise nil.
+	User *LogTarget
+	// Succeeded reports whether the outcome of the event is a success.
+	Succeeded bool
+	// LogEvent is the event of the System Log.
+	LogEvent *LogEvent
+}
+
+// IsMembership reports whether the event is about the membership of a user, e.g. an assignment.
+func (e *AppEvent) IsMembership() bool {
+	return strings.HasPrefix(string(e.Type), "application.user_membership.")
+}
+
+// AppWatcher follows the lifecycle and user membership events of an application in the System
+// Log, e.g. for a SCIM target to react to assignment changes promptly. It is a LogPoller filtered
+// to the events of the application.
+type AppWatcher struct {
+	AppID string
+	// Poller polls the System Log. Its cursor can be persisted, and restored, to resume watching
+	// where a process left off.
+	Poller *LogPoller
+}
+
+// NewAppWatcher is a helper method to create a new AppWatcher of the application appID, starting
+// at the events published since since.
+func NewAppWatcher(client *Client, appID string, since time.Time) *AppWatcher {
+	var types []string
+	for _, prefix := range appEventPrefixes {
+		types = append(types, fmt.Sprintf("eventType sw %q", prefix))
+	}
+	params := &LogListParams{
+		Since:  since,
+		Filter: fmt.Sprintf("(%s) and target.id eq %q", strings.Join(types, " or "), appID),
+	}
+	return &AppWatcher{AppID: appID, Poller: NewLogPoller(client, params)}
+}
+
+// Run calls fn with every event of the application, in the order they were published, until ctx
+// is done or fn returns an error, see LogPoller.Run().
+func (w *AppWatcher) Run(ctx context.Context, fn func(event *AppEvent) error) error {
+	return w.Poller.Run(ctx, func(events []*LogEvent) error {
+		for _, e := range events {
+			event := w.appEvent(e)
+			if event == nil {
+				continue
+			}
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// appEvent returns the AppEvent of e, or nil if e isn't an event of the application watched.
+func (w *AppWatcher) appEvent(e *LogEvent) *AppEvent {
+	watched := false
+	for _, prefix := range appEventPrefixes {
+		watched = watched || strings.HasPrefix(e.EventType, prefix)
+	}
+	if !watched {
+		return nil
+	}
+
+	event := &AppEvent{
+		Type:      AppEventType(e.EventType),
+		Succeeded: e.Outcome == nil || e.Outcome.Result == "SUCCESS",
+		LogEvent:  e,
+	}
+	for _, target := range e.Target {
+		switch {
+		case target.ID == w.AppID:
+			event.AppID = target.ID
+		case target.Type == "User":
+			event.User = target
+		}
+	}
+	if event.AppID == "" {
+		return nil
+	}
+	return event
+}
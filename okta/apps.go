@@ -11,21 +11,35 @@ type AppsService service
 
 // GetByID fetches a single application by its ID
 //
+// When Client.Cache is set, a cached application is returned with a Response of status 200 OK
+// and no headers, see Cache.
+//
 // https://developer.okta.com/docs/api/resources/apps#get-application
 func (s *AppsService) GetByID(ctx context.Context, id string) (*App, *Response, error) {
-	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, rateLimitAppsGetUpdateDeleteCategory)
+	app := new(App)
+	if resp := s.client.cached(CacheResourceApps, id, app); resp != nil {
+		return app, resp, nil
+	}
+	return s.get(ctx, id)
+}
+
+// get fetches an application by its ID from Okta, bypassing the cache, e.g. to modify it, and
+// caches it.
+func (s *AppsService) get(ctx context.Context, id string) (*App, *Response, error) {
+	app := new(App)
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryAppsGetUpdateDelete)
 	path := fmt.Sprintf("apps/%s", id)
 	req, err := s.client.NewRequest("GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	app := new(App)
 	resp, err := s.client.Do(ctx, req, app)
 	if err != nil {
 		return nil, resp, err
 	}
 
+	s.client.cache(CacheResourceApps, id, app)
 	return app, resp, nil
 }
 
@@ -49,7 +63,7 @@ func (s *AppsService) AddBookmarkApp(ctx context.Context, label string, activate
 }
 
 // AddSAMLApp creates a new SAML application, it wraps Add(). Caveats:
-// 	- Okta Docs: Fields that require certificate uploads can’t be enabled through the API, such as Single Log Out and Assertion Encryption. These must be updated through the UI.
+// 	- Okta Docs: Fields that require certificate uploads can’t be enabled through the API, such as Assertion Encryption. These must be updated through the UI. Single Log Out takes the certificate in SPCertificate.
 //  - Implementation Limitation: Override attributes aren't supported.
 //
 //	https://developer.okta.com/docs/api/resources/apps#add-custom-saml-application
@@ -123,16 +137,164 @@ func (s *AppsService) AddSAMLApp(
 			"attributeStatements":   params.AttributeStatements,
 		},
 	}
+	for key, value := range samlLogoutSettings(params) {
+		appIn.Settings.(map[string]map[string]interface{})["signOn"][key] = value
+	}
 
 	appOut, resp, err := s.Add(ctx, appIn, activate)
 	return appOut, resp, err
 }
 
+// UpdateBookmarkApp modifies a bookmark application, it wraps Update(). The application is
+// fetched, bypassing Client.Cache, and its label and URL replaced with label and url, unless they
+// are empty.
+//
+//	https://developer.okta.com/docs/reference/api/apps/#update-application
+func (s *AppsService) UpdateBookmarkApp(ctx context.Context, id string, label string, url *url.URL) (*App, *Response, error) {
+	appIn, resp, err := s.get(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+	if appIn.SignOnMode != AppSignOnModeBookmark {
+		return nil, resp, fmt.Errorf("Invalid application, %s is a %s application, not a bookmark one", id, appIn.SignOnMode)
+	}
+
+	if label != "" {
+		appIn.Label = label
+	}
+	if url != nil {
+		mergeAppSettings(appIn, "app", map[string]interface{}{"url": url.String()})
+	}
+
+	return s.Update(ctx, id, appIn)
+}
+
+// UpdateSAMLApp modifies a custom SAML application, it wraps Update(). The application is
+// fetched, bypassing Client.Cache, and the settings set in params merged into its sign on
+// settings: the empty strings and nil URLs and attribute statements of params keep the current
+// values, the booleans are always replaced. The label is replaced unless it's empty.
+//
+//	https://developer.okta.com/docs/reference/api/apps/#update-application
+func (s *AppsService) UpdateSAMLApp(ctx context.Context, id string, label string, params *AppAddSAMLAppParams) (*App, *Response, error) {
+	// Okta Docs: Either (or both) “responseSigned” or “assertionSigned” must be TRUE.
+	if !params.ResponseSigned && !params.AssertionSigned {
+		return nil, nil, fmt.Errorf("Invalid paramaters, either `ResponseSigned` or `AssertionSigned` must be true")
+	}
+
+	appIn, resp, err := s.get(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+	if appIn.SignOnMode != AppSignOnModeSAML2 {
+		return nil, resp, fmt.Errorf("Invalid application, %s is a %s application, not a SAML one", id, appIn.SignOnMode)
+	}
+
+	if label != "" {
+		appIn.Label = label
+	}
+
+	signOn := map[string]interface{}{
+		"responseSigned":  params.ResponseSigned,
+		"assertionSigned": params.AssertionSigned,
+		"honorForceAuthn": params.HonorForceAuthn,
+	}
+	for key, value := range map[string]string{
+		"defaultRelayState":     params.DefaultRelayState,
+		"audience":              params.Audience,
+		"idpIssuer":             params.IdpIssuer,
+		"subjectNameIdTemplate": params.SubjectNameIDTemplate,
+		"subjectNameIdFormat":   params.SubjectNameIDFormat,
+		"signatureAlgorithm":    params.SignatureAlgorithm,
+		"digestAlgorithm":       params.DigestAlgorithm,
+		"authnContextClassRef":  params.AuthnContextClassRef,
+	} {
+		if value != "" {
+			signOn[key] = value
+		}
+	}
+	for key, value := range map[string]*url.URL{
+		"ssoAcsUrl":   params.SsoAcsURL,
+		"recipient":   params.Recipient,
+		"destination": params.Destination,
+	} {
+		if value != nil {
+			signOn[key] = value.String()
+		}
+	}
+	if params.AttributeStatements != nil {
+		statements := make([]AppSAMLAttributeStatement, len(params.AttributeStatements))
+		copy(statements, params.AttributeStatements)
+		for i := range statements {
+			if statements[i].Namespace == "" {
+				statements[i].Namespace = "urn:oasis:names:tc:SAML:2.0:attrname-format:unspecified"
+			}
+		}
+		signOn["attributeStatements"] = statements
+	}
+	for key, value := range samlLogoutSettings(params) {
+		signOn[key] = value
+	}
+	mergeAppSettings(appIn, "signOn", signOn)
+
+	return s.Update(ctx, id, appIn)
+}
+
+// samlLogoutSettings returns the single logout attributes of the signOn settings set by params.
+func samlLogoutSettings(params *AppAddSAMLAppParams) map[string]interface{} {
+	settings := make(map[string]interface{})
+	if params.SingleLogout != nil {
+		settings["slo"] = params.SingleLogout
+	}
+	if params.ParticipateSLO != nil {
+		settings["participateSlo"] = params.ParticipateSLO
+	}
+	if params.SPCertificate != nil {
+		settings["spCertificate"] = params.SPCertificate
+	}
+	return settings
+}
+
+// mergeAppSettings sets the attributes of values in the settings object name of app, keeping
+// its other attributes.
+func mergeAppSettings(app *App, name string, values map[string]interface{}) {
+	settings, ok := app.Settings.(map[string]interface{})
+	if !ok {
+		settings = make(map[string]interface{})
+		app.Settings = settings
+	}
+	object, ok := settings[name].(map[string]interface{})
+	if !ok {
+		object = make(map[string]interface{})
+		settings[name] = object
+	}
+	for key, value := range values {
+		object[key] = value
+	}
+}
+
+// validateApp returns an error if the status or a feature of appIn isn't a known value, e.g. a
+// typo, before it's sent to Okta.
+func validateApp(appIn *App) error {
+	if appIn.Status != "" && !appIn.Status.Valid() {
+		return fmt.Errorf("okta: invalid AppStatus %q", appIn.Status)
+	}
+	for _, feature := range appIn.Features {
+		if !feature.Valid() {
+			return fmt.Errorf("okta: invalid AppFeatureName %q", feature)
+		}
+	}
+	return nil
+}
+
 // Add creates a new application. Most people will want to call one of the helper methods instead.
+// An error is returned, without a request, if the status or a feature of appIn isn't valid.
 //
 // https://developer.okta.com/docs/api/resources/apps#add-application
 func (s *AppsService) Add(ctx context.Context, appIn *App, activate bool) (*App, *Response, error) {
-	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, rateLimitAppsCreateListCategory)
+	if err := validateApp(appIn); err != nil {
+		return nil, nil, err
+	}
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryAppsCreateList)
 	path := fmt.Sprintf("apps?activate=%t", activate)
 	req, err := s.client.NewRequest("POST", path, appIn)
 	if err != nil {
@@ -148,48 +310,119 @@ func (s *AppsService) Add(ctx context.Context, appIn *App, activate bool) (*App,
 	return appOut, resp, nil
 }
 
-// ListAssignedUsers fetches the users assigned to the specified application id.
+// ListAssignedUsers fetches the first page of the users assigned to the specified application id.
 //
 // https://developer.okta.com/docs/api/resources/apps#list-users-assigned-to-application
-func (s *AppsService) ListAssignedUsers(ctx context.Context, id string) ([]*AppUser, *Response, error) {
+func (s *AppsService) ListAssignedUsers(ctx context.Context, id string) (*Collection[*AppUser], *Response, error) {
 	path := fmt.Sprintf("apps/%s/users?limit=%d", id, 100)
-	var appUsersAcc []*AppUser
-	return s.listAssignedUsersPaginated(ctx, path, appUsersAcc)
+	return listPage[*AppUser](ctx, s.client, RateLimitCategoryCore, path)
 }
 
-// listAssignedUsers is a helper function.
+// Update modifies an application.
 //
-// https://developer.okta.com/docs/api/resources/apps#list-users-assigned-to-application
-func (s *AppsService) listAssignedUsers(ctx context.Context, path string) ([]*AppUser, *Response, error) {
-	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, rateLimitCoreCategory)
-	req, err := s.client.NewRequest("GET", path, nil)
+// Note that delta updates are not supported. You must pass a full App object. An error is
+// returned, without a request, if the status or a feature of appIn isn't valid.
+//
+// https://developer.okta.com/docs/reference/api/apps/#update-application
+func (s *AppsService) Update(ctx context.Context, id string, appIn *App) (*App, *Response, error) {
+	if err := validateApp(appIn); err != nil {
+		return nil, nil, err
+	}
+	defer s.client.invalidate(CacheResourceApps, id)
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryAppsGetUpdateDelete)
+	path := fmt.Sprintf("apps/%s", id)
+	req, err := s.client.NewRequest("PUT", path, appIn)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	var appUsers []*AppUser
-	resp, err := s.client.Do(ctx, req, &appUsers)
+	appOut := new(App)
+	resp, err := s.client.Do(ctx, req, appOut)
 	if err != nil {
 		return nil, resp, err
 	}
 
-	return appUsers, resp, nil
+	return appOut, resp, nil
 }
 
-// listAssignedUsersPaginated is a helper function to ListAssignedUsers that handles pagination.
+// AppListParams is a helper struct for calling List().
 //
-// https://developer.okta.com/docs/api/resources/apps#list-users-assigned-to-application
-func (s *AppsService) listAssignedUsersPaginated(ctx context.Context, path string, appUserAcc []*AppUser) ([]*AppUser, *Response, error) {
-	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, rateLimitCoreCategory)
-	appUsers, resp, err := s.listAssignedUsers(ctx, path)
+// https://developer.okta.com/docs/reference/api/apps/#list-applications
+type AppListParams struct {
+	// Q matches the beginning of the name or label of applications.
+	Q string
+	// Filter is a filter expression, e.g. `name eq "bookmark"`.
+	Filter string
+	// Status restricts the applications to a status, and is combined with Filter.
+	Status AppStatus
+}
+
+// List fetches the first page of applications, optionally filtered by params.
+//
+// https://developer.okta.com/docs/reference/api/apps/#list-applications
+func (s *AppsService) List(ctx context.Context, params *AppListParams) (*Collection[*App], *Response, error) {
+	query := url.Values{}
+	query.Set("limit", "200")
+	if params != nil {
+		if params.Q != "" {
+			query.Set("q", params.Q)
+		}
+		if params.Status != "" && !params.Status.Valid() {
+			return nil, nil, fmt.Errorf("okta: invalid AppStatus %q", params.Status)
+		}
+		if filter := andFilter(params.Filter, "status", string(params.Status)); filter != "" {
+			query.Set("filter", filter)
+		}
+	}
+	path := fmt.Sprintf("apps?%s", query.Encode())
+
+	return listPage[*App](ctx, s.client, RateLimitCategoryAppsCreateList, path)
+}
+
+// Activate activates an inactive application.
+//
+// https://developer.okta.com/docs/reference/api/apps/#activate-application
+func (s *AppsService) Activate(ctx context.Context, id string) (*Response, error) {
+	return s.lifecycle(ctx, id, "activate")
+}
+
+// Deactivate deactivates an active application.
+//
+// https://developer.okta.com/docs/reference/api/apps/#deactivate-application
+func (s *AppsService) Deactivate(ctx context.Context, id string) (*Response, error) {
+	return s.lifecycle(ctx, id, "deactivate")
+}
+
+// lifecycle is a helper function for the lifecycle operations.
+func (s *AppsService) lifecycle(ctx context.Context, id string, operation string) (*Response, error) {
+	defer s.client.invalidate(CacheResourceApps, id)
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryAppsGetUpdateDelete)
+	path := fmt.Sprintf("apps/%s/lifecycle/%s", id, operation)
+
+	req, err := s.client.NewRequest("POST", path, nil)
 	if err != nil {
-		return nil, resp, err
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Remove deletes an inactive application. With Guard.ProtectAssignedApps set on the client, it
+// refuses to delete an application with assignments, see Guard.
+//
+// https://developer.okta.com/docs/reference/api/apps/#delete-application
+func (s *AppsService) Remove(ctx context.Context, id string) (*Response, error) {
+	if err := s.client.guardAppRemoval(ctx, id); err != nil {
+		return nil, err
 	}
+	defer s.client.invalidate(CacheResourceApps, id)
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryAppsGetUpdateDelete)
+	path := fmt.Sprintf("apps/%s", id)
 
-	appUserAcc = append(appUserAcc, appUsers...)
-	if len(resp.Pagination.Next) == 0 {
-		return appUserAcc, resp, nil
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return s.listAssignedUsersPaginated(ctx, resp.Pagination.Next, appUserAcc)
+	return s.client.Do(ctx, req, nil)
 }
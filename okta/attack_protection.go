@@ -0,0 +1,114 @@
+package okta
+
+import "context"
+
+// AttackProtectionService is the service providing access to the Attack Protection API of Okta.
+//
+// Note that this API isn't served below /api/v1/, the paths are therefore absolute.
+type AttackProtectionService service
+
+// UserLockoutSettings represents the org wide user lockout settings.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/AttackProtection/
+type UserLockoutSettings struct {
+	PreventBruteForceLockoutFromUnknownDevices bool `json:"preventBruteForceLockoutFromUnknownDevices"`
+}
+
+// AuthenticatorAttackProtectionSettings represents the authenticator specific attack protection settings.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/AttackProtection/
+type AuthenticatorAttackProtectionSettings struct {
+	VerifyKnowledgeSecondWhen2faRequired bool `json:"verifyKnowledgeSecondWhen2faRequired"`
+}
+
+// GetUserLockoutSettings fetches the user lockout settings.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/AttackProtection/#tag/AttackProtection/operation/getUserLockoutSettings
+func (s *AttackProtectionService) GetUserLockoutSettings(ctx context.Context) (*UserLockoutSettings, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "/attack-protection/api/v1/user-lockout-settings"
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Okta returns the settings as a single element array.
+	var settings []*UserLockoutSettings
+	resp, err := s.client.Do(ctx, req, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(settings) == 0 {
+		return new(UserLockoutSettings), resp, nil
+	}
+
+	return settings[0], resp, nil
+}
+
+// UpdateUserLockoutSettings replaces the user lockout settings.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/AttackProtection/#tag/AttackProtection/operation/replaceUserLockoutSettings
+func (s *AttackProtectionService) UpdateUserLockoutSettings(ctx context.Context, settings *UserLockoutSettings) (*UserLockoutSettings, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "/attack-protection/api/v1/user-lockout-settings"
+
+	req, err := s.client.NewRequest("PUT", path, settings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settingsOut := new(UserLockoutSettings)
+	resp, err := s.client.Do(ctx, req, settingsOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settingsOut, resp, nil
+}
+
+// GetAuthenticatorSettings fetches the authenticator attack protection settings.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/AttackProtection/#tag/AttackProtection/operation/getAuthenticatorSettings
+func (s *AttackProtectionService) GetAuthenticatorSettings(ctx context.Context) (*AuthenticatorAttackProtectionSettings, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "/attack-protection/api/v1/authenticator-settings"
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Okta returns the settings as a single element array.
+	var settings []*AuthenticatorAttackProtectionSettings
+	resp, err := s.client.Do(ctx, req, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(settings) == 0 {
+		return new(AuthenticatorAttackProtectionSettings), resp, nil
+	}
+
+	return settings[0], resp, nil
+}
+
+// UpdateAuthenticatorSettings replaces the authenticator attack protection settings.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/AttackProtection/#tag/AttackProtection/operation/replaceAuthenticatorSettings
+func (s *AttackProtectionService) UpdateAuthenticatorSettings(ctx context.Context, settings *AuthenticatorAttackProtectionSettings) (*AuthenticatorAttackProtectionSettings, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "/attack-protection/api/v1/authenticator-settings"
+
+	req, err := s.client.NewRequest("PUT", path, settings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settingsOut := new(AuthenticatorAttackProtectionSettings)
+	resp, err := s.client.Do(ctx, req, settingsOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settingsOut, resp, nil
+}
@@ -0,0 +1,154 @@
+package okta
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AuditOutcome is the outcome of an audited call.
+type AuditOutcome string
+
+// AuditOutcome Constants
+const (
+	AuditOutcomeSuccess AuditOutcome = "SUCCESS"
+	AuditOutcomeFailure              = "FAILURE"
+)
+
+// AuditRecord represents a mutating call made by a Client, see Client.Audit.
+type AuditRecord struct {
+	Time   time.Time `json:"time"`
+	Method string    `json:"method"`
+	// Path is the path of the request, relative to the base URL of the Client, e.g.
+	// "users/00u1ab2c3D4E5F6G7H8i/lifecycle/deactivate".
+	Path string `json:"path"`
+	// ResourceID is the ID of the resource the call changed: the last ID in Path, or the ID of
+	// the resource returned by a creation.
+	ResourceID string `json:"resourceId,omitempty"`
+	// BodySHA256 is the hex encoded SHA-256 of the request body, which the record leaves out as
+	// it may hold credentials.
+	BodySHA256    string        `json:"bodySha256,omitempty"`
+	OktaRequestID string        `json:"oktaRequestId,omitempty"`
+	StatusCode    int           `json:"statusCode,omitempty"`
+	Outcome       AuditOutcome  `json:"outcome"`
+	Error         string        `json:"error,omitempty"`
+	Duration      time.Duration `json:"duration"`
+}
+
+// AuditSink receives the records of the mutating calls of a Client. Record is called once a call
+// completes, from the goroutine that made it, so it must be safe for concurrent use. An error is
+// logged, and doesn't fail the call.
+type AuditSink interface {
+	Record(ctx context.Context, record *AuditRecord) error
+}
+
+// AuditSinkFunc is an adapter to use a function as an AuditSink.
+type AuditSinkFunc func(ctx context.Context, record *AuditRecord) error
+
+// Record implements the AuditSink interface.
+func (f AuditSinkFunc) Record(ctx context.Context, record *AuditRecord) error {
+	return f(ctx, record)
+}
+
+// JSONAuditSink writes audit records to W as JSON lines.
+type JSONAuditSink struct {
+	W io.Writer
+
+	mu sync.Mutex
+}
+
+// NewJSONAuditSink is a helper method to create a new JSONAuditSink.
+func NewJSONAuditSink(w io.Writer) *JSONAuditSink {
+	return &JSONAuditSink{W: w}
+}
+
+// Record implements the AuditSink interface.
+func (s *JSONAuditSink) Record(ctx context.Context, record *AuditRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.W.Write(append(data, '\n'))
+	return err
+}
+
+// oktaIDPattern matches the IDs of Okta resources, e.g. 00u1ab2c3D4E5F6G7H8i.
+var oktaIDPattern = regexp.MustCompile(`^[0-9a-zA-Z]{20}$`)
+
+// isMutation reports whether a request with method changes the org.
+func isMutation(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// newAuditRecord returns the record of req, before it is made.
+func (c *Client) newAuditRecord(req *http.Request) *AuditRecord {
+	record := &AuditRecord{
+		Time:   c.now(),
+		Method: req.Method,
+		Path:   strings.TrimPrefix(req.URL.Path, c.BaseURL.Path),
+	}
+
+	segments := strings.Split(record.Path, "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if oktaIDPattern.MatchString(segments[i]) {
+			record.ResourceID = segments[i]
+			break
+		}
+	}
+
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			h := sha256.New()
+			if _, err := io.Copy(h, body); err == nil {
+				record.BodySHA256 = hex.EncodeToString(h.Sum(nil))
+			}
+			body.Close()
+		}
+	}
+	return record
+}
+
+// audit completes record with the outcome of the call, and sends it to Client.Audit.
+func (c *Client) audit(ctx context.Context, record *AuditRecord, resp *Response, v interface{}, err error) {
+	record.Duration = c.now().Sub(record.Time)
+	if resp != nil && resp.Response != nil {
+		record.StatusCode = resp.StatusCode
+		record.OktaRequestID = resp.OktaRequestID
+	}
+	record.Outcome = AuditOutcomeSuccess
+	if err != nil {
+		record.Outcome = AuditOutcomeFailure
+		record.Error = err.Error()
+	}
+
+	// A creation returns the created resource, with its ID.
+	if record.ResourceID == "" && err == nil && v != nil {
+		if _, ok := v.(io.Writer); !ok {
+			var created struct {
+				ID string `json:"id"`
+			}
+			if data, err := json.Marshal(v); err == nil && json.Unmarshal(data, &created) == nil {
+				record.ResourceID = created.ID
+			}
+		}
+	}
+
+	if err := c.Audit.Record(ctx, record); err != nil {
+		log.Printf("okta: recording the audit record of %s %s: %v", record.Method, record.Path, err)
+	}
+}
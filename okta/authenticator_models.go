@@ -0,0 +1,79 @@
+package okta
+
+// Authenticator represents an authenticator of an Identity Engine org, e.g. Okta Verify or a FIDO2 security key.
+//
+// https://developer.okta.com/docs/reference/api/authenticators-admin/#authenticator-object
+type Authenticator struct {
+	RawJSON
+	ID   string           `json:"id,omitempty"`
+	Key  AuthenticatorKey `json:"key"`
+	Name string           `json:"name"`
+	// Type has possible values of: "app", "email", "password", "phone", "security_key", "security_question", "federated"
+	Type        string                 `json:"type,omitempty"`
+	Status      string                 `json:"status,omitempty"`
+	Created     Timestamp              `json:"created,omitempty"`
+	LastUpdated Timestamp              `json:"lastUpdated,omitempty"`
+	Settings    *AuthenticatorSettings `json:"settings,omitempty"`
+	Provider    *AuthenticatorProvider `json:"provider,omitempty"`
+	Links       interface{}            `json:"_links,omitempty"`
+}
+
+// AuthenticatorKey is a type for the AuthenticatorKey enum.
+//
+// https://developer.okta.com/docs/reference/api/authenticators-admin/#authenticator-properties
+type AuthenticatorKey string
+
+// AuthenticatorKey Constants
+//
+// https://developer.okta.com/docs/reference/api/authenticators-admin/#authenticator-properties
+const (
+	AuthenticatorKeyOktaVerify       AuthenticatorKey = "okta_verify"
+	AuthenticatorKeyWebAuthn                          = "webauthn"
+	AuthenticatorKeyPhone                             = "phone_number"
+	AuthenticatorKeyEmail                             = "okta_email"
+	AuthenticatorKeyPassword                          = "okta_password"
+	AuthenticatorKeySecurityQuestion                  = "security_question"
+	AuthenticatorKeyGoogleOTP                         = "google_otp"
+	AuthenticatorKeyDuo                               = "duo"
+	AuthenticatorKeyExternalIDP                       = "external_idp"
+	AuthenticatorKeyCustomApp                         = "custom_app"
+)
+
+// AuthenticatorSettings represents the settings of an authenticator. Which attributes apply depends on the authenticator.
+//
+// https://developer.okta.com/docs/reference/api/authenticators-admin/#authenticator-settings-object
+type AuthenticatorSettings struct {
+	// AllowedFor has possible values of: "recovery", "sso", "any", "none"
+	AllowedFor             string `json:"allowedFor,omitempty"`
+	TokenLifetimeInMinutes int    `json:"tokenLifetimeInMinutes,omitempty"`
+	// UserVerification has possible values of: "REQUIRED", "PREFERRED"
+	UserVerification string `json:"userVerification,omitempty"`
+	AppInstanceID    string `json:"appInstanceId,omitempty"`
+	Compliance       *struct {
+		// FIPS has possible values of: "REQUIRED", "OPTIONAL"
+		FIPS string `json:"fips,omitempty"`
+	} `json:"compliance,omitempty"`
+	ChannelBinding *struct {
+		Style    string `json:"style,omitempty"`
+		Required string `json:"required,omitempty"`
+	} `json:"channelBinding,omitempty"`
+}
+
+// AuthenticatorProvider represents the provider of an authenticator, such as Duo or the push provider of a custom authenticator.
+//
+// https://developer.okta.com/docs/reference/api/authenticators-admin/#authenticator-provider-object
+type AuthenticatorProvider struct {
+	Type          string                 `json:"type"`
+	Configuration map[string]interface{} `json:"configuration,omitempty"`
+}
+
+// AuthenticatorMethod represents one of the methods of an authenticator, e.g. push or totp for Okta Verify.
+//
+// https://developer.okta.com/docs/reference/api/authenticators-admin/#authenticator-method-object
+type AuthenticatorMethod struct {
+	// Type has possible values such as: "push", "signed_nonce", "totp", "otp", "sms", "voice", "email", "password", "webauthn", "security_question"
+	Type     string                 `json:"type"`
+	Status   string                 `json:"status,omitempty"`
+	Settings map[string]interface{} `json:"settings,omitempty"`
+	Links    interface{}            `json:"_links,omitempty"`
+}
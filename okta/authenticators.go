@@ -0,0 +1,162 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// AuthenticatorsService is the service providing access to the Authenticators Administration Resource in the Okta API
+type AuthenticatorsService service
+
+// List fetches all authenticators of the org.
+//
+// https://developer.okta.com/docs/reference/api/authenticators-admin/#list-authenticators
+func (s *AuthenticatorsService) List(ctx context.Context) ([]*Authenticator, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "authenticators"
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var authenticators []*Authenticator
+	resp, err := s.client.Do(ctx, req, &authenticators)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return authenticators, resp, nil
+}
+
+// GetByID fetches an authenticator by ID.
+//
+// https://developer.okta.com/docs/reference/api/authenticators-admin/#get-an-authenticator
+func (s *AuthenticatorsService) GetByID(ctx context.Context, id string) (*Authenticator, *Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("authenticators/%s", id), nil)
+}
+
+// Add creates a new authenticator, e.g. a custom app authenticator.
+//
+// https://developer.okta.com/docs/reference/api/authenticators-admin/#create-authenticator
+func (s *AuthenticatorsService) Add(ctx context.Context, authenticatorIn *Authenticator, activate bool) (*Authenticator, *Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("authenticators?activate=%t", activate), authenticatorIn)
+}
+
+// Update modifies an authenticator.
+//
+// Note that delta updates are not supported. You must pass a full Authenticator object.
+//
+// https://developer.okta.com/docs/reference/api/authenticators-admin/#update-authenticator
+func (s *AuthenticatorsService) Update(ctx context.Context, id string, authenticatorIn *Authenticator) (*Authenticator, *Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("authenticators/%s", id), authenticatorIn)
+}
+
+// Activate activates an authenticator.
+//
+// https://developer.okta.com/docs/reference/api/authenticators-admin/#activate-an-authenticator
+func (s *AuthenticatorsService) Activate(ctx context.Context, id string) (*Authenticator, *Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("authenticators/%s/lifecycle/activate", id), nil)
+}
+
+// Deactivate deactivates an authenticator. Authenticators used by an enrollment policy can't be deactivated.
+//
+// https://developer.okta.com/docs/reference/api/authenticators-admin/#deactivate-an-authenticator
+func (s *AuthenticatorsService) Deactivate(ctx context.Context, id string) (*Authenticator, *Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("authenticators/%s/lifecycle/deactivate", id), nil)
+}
+
+// do is a helper function for the single authenticator operations.
+func (s *AuthenticatorsService) do(ctx context.Context, method string, path string, authenticatorIn *Authenticator) (*Authenticator, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if authenticatorIn != nil {
+		body = authenticatorIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	authenticatorOut := new(Authenticator)
+	resp, err := s.client.Do(ctx, req, authenticatorOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return authenticatorOut, resp, nil
+}
+
+// ListMethods fetches the methods of an authenticator.
+//
+// https://developer.okta.com/docs/reference/api/authenticators-admin/#list-methods-of-an-authenticator
+func (s *AuthenticatorsService) ListMethods(ctx context.Context, id string) ([]*AuthenticatorMethod, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("authenticators/%s/methods", id)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var methods []*AuthenticatorMethod
+	resp, err := s.client.Do(ctx, req, &methods)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return methods, resp, nil
+}
+
+// GetMethod fetches a method of an authenticator.
+//
+// https://developer.okta.com/docs/reference/api/authenticators-admin/#retrieve-a-method
+func (s *AuthenticatorsService) GetMethod(ctx context.Context, id string, methodType string) (*AuthenticatorMethod, *Response, error) {
+	return s.doMethod(ctx, "GET", fmt.Sprintf("authenticators/%s/methods/%s", id, methodType), nil)
+}
+
+// UpdateMethod modifies the settings of a method of an authenticator.
+//
+// https://developer.okta.com/docs/reference/api/authenticators-admin/#update-a-method
+func (s *AuthenticatorsService) UpdateMethod(ctx context.Context, id string, methodType string, methodIn *AuthenticatorMethod) (*AuthenticatorMethod, *Response, error) {
+	return s.doMethod(ctx, "PUT", fmt.Sprintf("authenticators/%s/methods/%s", id, methodType), methodIn)
+}
+
+// ActivateMethod activates a method of an authenticator.
+//
+// https://developer.okta.com/docs/reference/api/authenticators-admin/#activate-a-method
+func (s *AuthenticatorsService) ActivateMethod(ctx context.Context, id string, methodType string) (*AuthenticatorMethod, *Response, error) {
+	return s.doMethod(ctx, "POST", fmt.Sprintf("authenticators/%s/methods/%s/lifecycle/activate", id, methodType), nil)
+}
+
+// DeactivateMethod deactivates a method of an authenticator.
+//
+// https://developer.okta.com/docs/reference/api/authenticators-admin/#deactivate-a-method
+func (s *AuthenticatorsService) DeactivateMethod(ctx context.Context, id string, methodType string) (*AuthenticatorMethod, *Response, error) {
+	return s.doMethod(ctx, "POST", fmt.Sprintf("authenticators/%s/methods/%s/lifecycle/deactivate", id, methodType), nil)
+}
+
+// doMethod is a helper function for the single method operations.
+func (s *AuthenticatorsService) doMethod(ctx context.Context, method string, path string, methodIn *AuthenticatorMethod) (*AuthenticatorMethod, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if methodIn != nil {
+		body = methodIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	methodOut := new(AuthenticatorMethod)
+	resp, err := s.client.Do(ctx, req, methodOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return methodOut, resp, nil
+}
@@ -0,0 +1,136 @@
+package okta
+
+// AuthorizationServer represents a custom Authorization Server in Okta.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#authorization-server-object
+type AuthorizationServer struct {
+	RawJSON
+	ID          string   `json:"id,omitempty"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Audiences   []string `json:"audiences"`
+	Issuer      string   `json:"issuer,omitempty"`
+	// IssuerMode has possible values of: "ORG_URL", "CUSTOM_URL", "DYNAMIC"
+	IssuerMode  string                         `json:"issuerMode,omitempty"`
+	Status      string                         `json:"status,omitempty"`
+	Created     Timestamp                      `json:"created,omitempty"`
+	LastUpdated Timestamp                      `json:"lastUpdated,omitempty"`
+	Credentials *AuthorizationServerCredential `json:"credentials,omitempty"`
+	Links       interface{}                    `json:"_links,omitempty"`
+}
+
+// AuthorizationServerCredential represents the signing key settings of an Authorization Server.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#credentials-object
+type AuthorizationServerCredential struct {
+	Signing struct {
+		// RotationMode has possible values of: "AUTO", "MANUAL"
+		RotationMode string    `json:"rotationMode,omitempty"`
+		LastRotated  Timestamp `json:"lastRotated,omitempty"`
+		NextRotation Timestamp `json:"nextRotation,omitempty"`
+		KID          string    `json:"kid,omitempty"`
+		Use          string    `json:"use,omitempty"`
+	} `json:"signing"`
+}
+
+// AuthorizationServerListParams is a helper struct for calling List().
+type AuthorizationServerListParams struct {
+	Q string
+}
+
+// AuthorizationServerScope represents a scope of a custom Authorization Server.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#scope-object
+type AuthorizationServerScope struct {
+	RawJSON
+	ID          string                          `json:"id,omitempty"`
+	Name        string                          `json:"name"`
+	DisplayName string                          `json:"displayName,omitempty"`
+	Description string                          `json:"description,omitempty"`
+	System      bool                            `json:"system,omitempty"`
+	Consent     AuthorizationServerScopeConsent `json:"consent,omitempty"`
+	Default     bool                            `json:"default"`
+	// MetadataPublish has possible values of: "ALL_CLIENTS", "NO_CLIENTS"
+	MetadataPublish string `json:"metadataPublish,omitempty"`
+	Optional        bool   `json:"optional,omitempty"`
+}
+
+// AuthorizationServerScopeConsent is a type for the AuthorizationServerScopeConsent enum.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#scope-properties
+type AuthorizationServerScopeConsent string
+
+// AuthorizationServerScopeConsent Constants
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#scope-properties
+const (
+	AuthorizationServerScopeConsentRequired AuthorizationServerScopeConsent = "REQUIRED"
+	AuthorizationServerScopeConsentImplicit                                 = "IMPLICIT"
+	AuthorizationServerScopeConsentFlexible                                 = "FLEXIBLE"
+)
+
+// AuthorizationServerClaim represents a claim of a custom Authorization Server.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#claim-object
+type AuthorizationServerClaim struct {
+	RawJSON
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name"`
+	Status string `json:"status,omitempty"`
+	// ClaimType has possible values of: "RESOURCE" (access token), "IDENTITY" (ID token)
+	ClaimType            string                              `json:"claimType"`
+	ValueType            AuthorizationServerClaimValueType   `json:"valueType"`
+	Value                string                              `json:"value"`
+	AlwaysIncludeInToken bool                                `json:"alwaysIncludeInToken"`
+	GroupFilterType      AuthorizationServerClaimGroupFilter `json:"group_filter_type,omitempty"`
+	Conditions           *struct {
+		Scopes []string `json:"scopes"`
+	} `json:"conditions,omitempty"`
+	System bool `json:"system,omitempty"`
+}
+
+// AuthorizationServerClaimValueType is a type for the AuthorizationServerClaimValueType enum.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#claim-properties
+type AuthorizationServerClaimValueType string
+
+// AuthorizationServerClaimValueType Constants
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#claim-properties
+const (
+	AuthorizationServerClaimValueTypeExpression AuthorizationServerClaimValueType = "EXPRESSION"
+	AuthorizationServerClaimValueTypeGroups                                       = "GROUPS"
+	AuthorizationServerClaimValueTypeSystem                                       = "SYSTEM"
+)
+
+// AuthorizationServerClaimGroupFilter is a type for the AuthorizationServerClaimGroupFilter enum. It's
+// only used when the ValueType is GROUPS.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#details-for-groups-value-type
+type AuthorizationServerClaimGroupFilter string
+
+// AuthorizationServerClaimGroupFilter Constants
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#details-for-groups-value-type
+const (
+	AuthorizationServerClaimGroupFilterStartsWith AuthorizationServerClaimGroupFilter = "STARTS_WITH"
+	AuthorizationServerClaimGroupFilterEquals                                         = "EQUALS"
+	AuthorizationServerClaimGroupFilterContains                                       = "CONTAINS"
+	AuthorizationServerClaimGroupFilterRegex                                          = "REGEX"
+)
+
+// OAuth2RefreshToken represents a refresh token issued by an Authorization Server.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#oauth-2-0-token-object
+type OAuth2RefreshToken struct {
+	ID          string      `json:"id"`
+	Status      string      `json:"status"`
+	Created     Timestamp   `json:"created"`
+	LastUpdated Timestamp   `json:"lastUpdated"`
+	ExpiresAt   Timestamp   `json:"expiresAt"`
+	Issuer      string      `json:"issuer"`
+	ClientID    string      `json:"clientId"`
+	UserID      string      `json:"userId"`
+	Scopes      []string    `json:"scopes"`
+	Links       interface{} `json:"_links,omitempty"`
+}
@@ -0,0 +1,157 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListScopes fetches the first page of the scopes of an Authorization Server.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#get-all-scopes
+func (s *AuthorizationServersService) ListScopes(ctx context.Context, authServerID string) (*Collection[*AuthorizationServerScope], *Response, error) {
+	path := fmt.Sprintf("authorizationServers/%s/scopes?limit=%d", authServerID, 200)
+	return listPage[*AuthorizationServerScope](ctx, s.client, RateLimitCategoryCore, path)
+}
+
+// GetScope fetches a scope of an Authorization Server.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#get-a-scope
+func (s *AuthorizationServersService) GetScope(ctx context.Context, authServerID string, scopeID string) (*AuthorizationServerScope, *Response, error) {
+	return s.doScope(ctx, "GET", fmt.Sprintf("authorizationServers/%s/scopes/%s", authServerID, scopeID), nil)
+}
+
+// AddScope creates a new scope on an Authorization Server.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#create-a-scope
+func (s *AuthorizationServersService) AddScope(ctx context.Context, authServerID string, scopeIn *AuthorizationServerScope) (*AuthorizationServerScope, *Response, error) {
+	return s.doScope(ctx, "POST", fmt.Sprintf("authorizationServers/%s/scopes", authServerID), scopeIn)
+}
+
+// UpdateScope modifies a scope of an Authorization Server.
+//
+// Note that delta updates are not supported. You must pass a full AuthorizationServerScope object.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#update-a-scope
+func (s *AuthorizationServersService) UpdateScope(ctx context.Context, authServerID string, scopeID string, scopeIn *AuthorizationServerScope) (*AuthorizationServerScope, *Response, error) {
+	return s.doScope(ctx, "PUT", fmt.Sprintf("authorizationServers/%s/scopes/%s", authServerID, scopeID), scopeIn)
+}
+
+// RemoveScope deletes a scope of an Authorization Server.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#delete-a-scope
+func (s *AuthorizationServersService) RemoveScope(ctx context.Context, authServerID string, scopeID string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("authorizationServers/%s/scopes/%s", authServerID, scopeID)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// doScope is a helper function for the single scope operations.
+func (s *AuthorizationServersService) doScope(ctx context.Context, method string, path string, scopeIn *AuthorizationServerScope) (*AuthorizationServerScope, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if scopeIn != nil {
+		body = scopeIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scopeOut := new(AuthorizationServerScope)
+	resp, err := s.client.Do(ctx, req, scopeOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return scopeOut, resp, nil
+}
+
+// ListClaims fetches the claims of an Authorization Server.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#get-all-claims
+func (s *AuthorizationServersService) ListClaims(ctx context.Context, authServerID string) ([]*AuthorizationServerClaim, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("authorizationServers/%s/claims", authServerID)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var claims []*AuthorizationServerClaim
+	resp, err := s.client.Do(ctx, req, &claims)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return claims, resp, nil
+}
+
+// GetClaim fetches a claim of an Authorization Server.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#get-a-claim
+func (s *AuthorizationServersService) GetClaim(ctx context.Context, authServerID string, claimID string) (*AuthorizationServerClaim, *Response, error) {
+	return s.doClaim(ctx, "GET", fmt.Sprintf("authorizationServers/%s/claims/%s", authServerID, claimID), nil)
+}
+
+// AddClaim creates a new claim on an Authorization Server.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#create-a-claim
+func (s *AuthorizationServersService) AddClaim(ctx context.Context, authServerID string, claimIn *AuthorizationServerClaim) (*AuthorizationServerClaim, *Response, error) {
+	return s.doClaim(ctx, "POST", fmt.Sprintf("authorizationServers/%s/claims", authServerID), claimIn)
+}
+
+// UpdateClaim modifies a claim of an Authorization Server.
+//
+// Note that delta updates are not supported. You must pass a full AuthorizationServerClaim object.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#update-a-claim
+func (s *AuthorizationServersService) UpdateClaim(ctx context.Context, authServerID string, claimID string, claimIn *AuthorizationServerClaim) (*AuthorizationServerClaim, *Response, error) {
+	return s.doClaim(ctx, "PUT", fmt.Sprintf("authorizationServers/%s/claims/%s", authServerID, claimID), claimIn)
+}
+
+// RemoveClaim deletes a claim of an Authorization Server.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#delete-a-claim
+func (s *AuthorizationServersService) RemoveClaim(ctx context.Context, authServerID string, claimID string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("authorizationServers/%s/claims/%s", authServerID, claimID)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// doClaim is a helper function for the single claim operations.
+func (s *AuthorizationServersService) doClaim(ctx context.Context, method string, path string, claimIn *AuthorizationServerClaim) (*AuthorizationServerClaim, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if claimIn != nil {
+		body = claimIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	claimOut := new(AuthorizationServerClaim)
+	resp, err := s.client.Do(ctx, req, claimOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return claimOut, resp, nil
+}
@@ -0,0 +1,65 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListRefreshTokens fetches the first page of the refresh tokens an Authorization Server issued to a client.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#list-refresh-tokens
+func (s *AuthorizationServersService) ListRefreshTokens(ctx context.Context, authServerID string, clientID string) (*Collection[*OAuth2RefreshToken], *Response, error) {
+	path := fmt.Sprintf("authorizationServers/%s/clients/%s/tokens?limit=%d", authServerID, clientID, 200)
+	return listPage[*OAuth2RefreshToken](ctx, s.client, RateLimitCategoryCore, path)
+}
+
+// GetRefreshToken fetches a refresh token an Authorization Server issued to a client.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#get-refresh-token
+func (s *AuthorizationServersService) GetRefreshToken(ctx context.Context, authServerID string, clientID string, tokenID string) (*OAuth2RefreshToken, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("authorizationServers/%s/clients/%s/tokens/%s", authServerID, clientID, tokenID)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokenOut := new(OAuth2RefreshToken)
+	resp, err := s.client.Do(ctx, req, tokenOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tokenOut, resp, nil
+}
+
+// RevokeRefreshToken revokes a single refresh token an Authorization Server issued to a client.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#revoke-refresh-token
+func (s *AuthorizationServersService) RevokeRefreshToken(ctx context.Context, authServerID string, clientID string, tokenID string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("authorizationServers/%s/clients/%s/tokens/%s", authServerID, clientID, tokenID)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RevokeRefreshTokens revokes all refresh tokens an Authorization Server issued to a client.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#revoke-all-refresh-tokens
+func (s *AuthorizationServersService) RevokeRefreshTokens(ctx context.Context, authServerID string, clientID string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("authorizationServers/%s/clients/%s/tokens", authServerID, clientID)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,131 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// AuthorizationServersService is the service providing access to the Authorization Servers Resource in the Okta API
+type AuthorizationServersService service
+
+// GetByID fetches an Authorization Server by ID.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#get-authorization-server
+func (s *AuthorizationServersService) GetByID(ctx context.Context, id string) (*AuthorizationServer, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("authorizationServers/%s", id)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	authServerOut := new(AuthorizationServer)
+	resp, err := s.client.Do(ctx, req, authServerOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return authServerOut, resp, nil
+}
+
+// List fetches the first page of Authorization Servers, optionally filtered by params.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#list-authorization-servers
+func (s *AuthorizationServersService) List(ctx context.Context, params *AuthorizationServerListParams) (*Collection[*AuthorizationServer], *Response, error) {
+	query := url.Values{}
+	query.Set("limit", "200")
+	if params != nil && params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	path := fmt.Sprintf("authorizationServers?%s", query.Encode())
+
+	return listPage[*AuthorizationServer](ctx, s.client, RateLimitCategoryCore, path)
+}
+
+// Add creates a new Authorization Server.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#create-authorization-server
+func (s *AuthorizationServersService) Add(ctx context.Context, authServerIn *AuthorizationServer) (*AuthorizationServer, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "authorizationServers"
+
+	req, err := s.client.NewRequest("POST", path, authServerIn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	authServerOut := new(AuthorizationServer)
+	resp, err := s.client.Do(ctx, req, authServerOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return authServerOut, resp, nil
+}
+
+// Update modifies an Authorization Server.
+//
+// Note that delta updates are not supported. You must pass a full AuthorizationServer object.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#update-authorization-server
+func (s *AuthorizationServersService) Update(ctx context.Context, id string, authServerIn *AuthorizationServer) (*AuthorizationServer, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("authorizationServers/%s", id)
+
+	req, err := s.client.NewRequest("PUT", path, authServerIn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	authServerOut := new(AuthorizationServer)
+	resp, err := s.client.Do(ctx, req, authServerOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return authServerOut, resp, nil
+}
+
+// Remove deletes an Authorization Server.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#delete-authorization-server
+func (s *AuthorizationServersService) Remove(ctx context.Context, id string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("authorizationServers/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Activate activates an inactive Authorization Server.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#activate-authorization-server
+func (s *AuthorizationServersService) Activate(ctx context.Context, id string) (*Response, error) {
+	return s.lifecycle(ctx, id, "activate")
+}
+
+// Deactivate deactivates an active Authorization Server.
+//
+// https://developer.okta.com/docs/reference/api/authorization-servers/#deactivate-authorization-server
+func (s *AuthorizationServersService) Deactivate(ctx context.Context, id string) (*Response, error) {
+	return s.lifecycle(ctx, id, "deactivate")
+}
+
+// lifecycle is a helper function for the lifecycle operations.
+func (s *AuthorizationServersService) lifecycle(ctx context.Context, id string, operation string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("authorizationServers/%s/lifecycle/%s", id, operation)
+
+	req, err := s.client.NewRequest("POST", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,227 @@
+package okta
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Authorization code flow errors
+var (
+	ErrStateMismatch = errors.New("okta: state of the authorization response doesn't match the request")
+	ErrNonceMismatch = errors.New("okta: nonce of the ID token doesn't match the request")
+)
+
+// AuthCodeFlow runs the authorization code flow with PKCE of an OIDC application against the org
+// or a custom authorization server: AuthorizeURL() builds the URL the user is redirected to,
+// AuthorizeRequest.Callback() checks the redirect back to RedirectURI, and Exchange() exchanges
+// the code for tokens.
+//
+//	req, err := flow.AuthorizeURL(nil)
+//	// Keep req in the session of the user, and redirect them to req.URL.
+//	code, err := req.Callback(r.URL.Query())
+//	token, err := flow.Exchange(ctx, req, code)
+//
+// https://developer.okta.com/docs/guides/implement-grant-type/authcodepkce/main/
+type AuthCodeFlow struct {
+	// Issuer is the issuer of the authorization server, e.g.
+	// https://example.okta.com/oauth2/default, or the org URL for the org authorization server.
+	Issuer   string
+	ClientID string
+	// ClientSecret authenticates confidential clients at the token endpoint. Public clients, e.g.
+	// single-page or native applications, leave it empty and rely on PKCE alone.
+	ClientSecret string
+	RedirectURI  string
+	// Scopes are the scopes requested, "openid" when empty.
+	Scopes []string
+	// HTTPClient makes the token requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// NewAuthCodeFlow is a helper method to create a new AuthCodeFlow of the public client clientID,
+// redirecting to redirectURI, requesting scopes.
+func NewAuthCodeFlow(issuer, clientID, redirectURI string, scopes ...string) *AuthCodeFlow {
+	return &AuthCodeFlow{
+		Issuer:      strings.TrimSuffix(issuer, "/"),
+		ClientID:    clientID,
+		RedirectURI: redirectURI,
+		Scopes:      scopes,
+	}
+}
+
+// AuthorizeParams are the optional parameters of an authorization request.
+//
+// https://developer.okta.com/docs/reference/api/oidc/#request-parameters
+type AuthorizeParams struct {
+	// Prompt is "none" to fail instead of prompting the user, "login" or "consent" to force the
+	// prompt.
+	Prompt    string
+	LoginHint string
+	// IDP is the ID of the identity provider to redirect the user to.
+	IDP string
+	// SessionToken is the session token of the Authentication API, for an embedded login whose
+	// credentials were checked by the application itself.
+	SessionToken string
+	// ResponseMode is how the authorization response is returned, e.g. "form_post"; a query
+	// string by default.
+	ResponseMode string
+}
+
+// AuthorizeRequest represents an authorization request: the URL the user is redirected to, and
+// the secrets the response is checked against, to keep, e.g. in the session of the user, until the
+// callback.
+type AuthorizeRequest struct {
+	URL   string
+	State string
+	Nonce string
+	// CodeVerifier is the PKCE code verifier, whose S256 challenge is in URL.
+	CodeVerifier string
+}
+
+// AuthorizeURL returns a new authorization request, with a random state, nonce and code verifier.
+//
+// https://developer.okta.com/docs/reference/api/oidc/#authorize
+func (f *AuthCodeFlow) AuthorizeURL(params *AuthorizeParams) (*AuthorizeRequest, error) {
+	req := new(AuthorizeRequest)
+	var err error
+	if req.State, err = randomString(16); err != nil {
+		return nil, err
+	}
+	if req.Nonce, err = randomString(16); err != nil {
+		return nil, err
+	}
+	if req.CodeVerifier, err = randomString(32); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{
+		"client_id":             {f.ClientID},
+		"response_type":         {"code"},
+		"scope":                 {strings.Join(f.scopes(), " ")},
+		"redirect_uri":          {f.RedirectURI},
+		"state":                 {req.State},
+		"nonce":                 {req.Nonce},
+		"code_challenge":        {codeChallenge(req.CodeVerifier)},
+		"code_challenge_method": {"S256"},
+	}
+	if params != nil {
+		for name, value := range map[string]string{
+			"prompt":        params.Prompt,
+			"login_hint":    params.LoginHint,
+			"idp":           params.IDP,
+			"sessionToken":  params.SessionToken,
+			"response_mode": params.ResponseMode,
+		} {
+			if value != "" {
+				query.Set(name, value)
+			}
+		}
+	}
+	req.URL = oauthEndpoint(f.Issuer, "authorize") + "?" + query.Encode()
+	return req, nil
+}
+
+// Callback checks the parameters of the authorization response, the query of the redirect to the
+// redirect URI, or its form for the form_post response mode, and returns the authorization code.
+// An error of the authorization server, e.g. the user denied the consent, is an *OAuthError.
+func (r *AuthorizeRequest) Callback(params url.Values) (string, error) {
+	if code := params.Get("error"); code != "" {
+		return "", &OAuthError{Code: code, Description: params.Get("error_description")}
+	}
+	if params.Get("state") != r.State {
+		return "", ErrStateMismatch
+	}
+	code := params.Get("code")
+	if code == "" {
+		return "", errors.New("okta: no code in the authorization response")
+	}
+	return code, nil
+}
+
+// Exchange exchanges code, the authorization code of the response to req, for tokens at the token
+// endpoint. The nonce of the ID token, if any, is checked against req; the ID token is received
+// directly from the authorization server, so its signature isn't validated.
+//
+// https://developer.okta.com/docs/reference/api/oidc/#token
+func (f *AuthCodeFlow) Exchange(ctx context.Context, req *AuthorizeRequest, code string) (*OAuthToken, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {f.RedirectURI},
+		"code_verifier": {req.CodeVerifier},
+	}
+	token, err := f.token(ctx, form)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.IDToken != "" && req.Nonce != "" {
+		parts := strings.Split(token.IDToken, ".")
+		var claims struct {
+			Nonce string `json:"nonce"`
+		}
+		if len(parts) != 3 || decodeJWTPart(parts[1], &claims) != nil {
+			return nil, errors.New("okta: invalid ID token in the token response")
+		}
+		if claims.Nonce != req.Nonce {
+			return nil, ErrNonceMismatch
+		}
+	}
+	return token, nil
+}
+
+// token posts form to the token endpoint, authenticated as the client, and returns the tokens.
+func (f *AuthCodeFlow) token(ctx context.Context, form url.Values) (*OAuthToken, error) {
+	obtained := time.Now()
+	data, err := postOAuthForm(ctx, f.HTTPClient, oauthEndpoint(f.Issuer, "token"), form, f.clientAuth(form))
+	if err != nil {
+		return nil, err
+	}
+	token := new(OAuthToken)
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, err
+	}
+	token.setExpiry(obtained)
+	return token, nil
+}
+
+// clientAuth returns the client authentication of a request posting form: the client secret with
+// basic authentication, or the client ID in form for a public client.
+func (f *AuthCodeFlow) clientAuth(form url.Values) func(req *http.Request) {
+	if f.ClientSecret == "" {
+		form.Set("client_id", f.ClientID)
+		return func(req *http.Request) {}
+	}
+	return func(req *http.Request) {
+		req.SetBasicAuth(url.QueryEscape(f.ClientID), url.QueryEscape(f.ClientSecret))
+	}
+}
+
+func (f *AuthCodeFlow) scopes() []string {
+	if len(f.Scopes) == 0 {
+		return []string{"openid"}
+	}
+	return f.Scopes
+}
+
+// codeChallenge returns the S256 PKCE code challenge of verifier.
+func codeChallenge(verifier string) string {
+	challenge := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(challenge[:])
+}
+
+// randomString returns n random bytes, base64url encoded.
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,31 @@
+package okta
+
+import (
+	"net/http"
+)
+
+// BeforeSendFunc is called with each request right before it is sent, after the Authorization
+// header is set, e.g. to add the signature or the headers required by a zero-trust egress proxy.
+// It can modify req in place. An error aborts the request and is returned by Client.Do().
+type BeforeSendFunc func(req *http.Request) error
+
+// RequestCategory returns the rate limit category of a request made by a Client, e.g.
+// RateLimitCategoryUsersGetByID, and false if req wasn't made by a Client. It lets a
+// BeforeSendFunc, or a RoundTripper of the http.Client, tell the endpoints apart.
+//
+// https://developer.okta.com/docs/reference/rl-global-mgmt/
+func RequestCategory(req *http.Request) (RateLimitCategory, bool) {
+	if req == nil {
+		return 0, false
+	}
+	category, ok := req.Context().Value(rateLimitCategoryCtxKey).(RateLimitCategory)
+	return category, ok
+}
+
+// beforeSend calls the BeforeSend hook of the client, if set, with req.
+func (c *Client) beforeSend(req *http.Request) error {
+	if c.BeforeSend == nil {
+		return nil
+	}
+	return c.BeforeSend(req)
+}
@@ -0,0 +1,44 @@
+package okta
+
+// BehaviorRule represents a behavior detection rule, which policies reference by ID.
+//
+// https://developer.okta.com/docs/reference/api/behavior-rules/#behavior-detection-rule-object
+type BehaviorRule struct {
+	RawJSON
+	ID          string               `json:"id,omitempty"`
+	Name        string               `json:"name"`
+	Type        BehaviorRuleType     `json:"type"`
+	Status      string               `json:"status,omitempty"`
+	Created     Timestamp            `json:"created,omitempty"`
+	LastUpdated Timestamp            `json:"lastUpdated,omitempty"`
+	Settings    BehaviorRuleSettings `json:"settings"`
+	Links       interface{}          `json:"_links,omitempty"`
+}
+
+// BehaviorRuleType is a type for the BehaviorRuleType enum.
+//
+// https://developer.okta.com/docs/reference/api/behavior-rules/#behavior-detection-rule-object
+type BehaviorRuleType string
+
+// BehaviorRuleType Constants
+//
+// https://developer.okta.com/docs/reference/api/behavior-rules/#behavior-detection-rule-object
+const (
+	BehaviorRuleTypeAnomalousLocation BehaviorRuleType = "ANOMALOUS_LOCATION"
+	BehaviorRuleTypeAnomalousDevice                    = "ANOMALOUS_DEVICE"
+	BehaviorRuleTypeAnomalousIP                        = "ANOMALOUS_IP"
+	BehaviorRuleTypeVelocity                           = "VELOCITY"
+)
+
+// BehaviorRuleSettings represents the settings of a behavior detection rule. Which attributes apply
+// depends on the rule type.
+//
+// https://developer.okta.com/docs/reference/api/behavior-rules/#behavior-detection-rule-settings-object
+type BehaviorRuleSettings struct {
+	MaxEventsUsedForEvaluation   int `json:"maxEventsUsedForEvaluation,omitempty"`
+	MinEventsNeededForEvaluation int `json:"minEventsNeededForEvaluation,omitempty"`
+	// Granularity has possible values of: "LAT_LONG", "CITY", "COUNTRY", "SUBDIVISION"
+	Granularity      string `json:"granularity,omitempty"`
+	RadiusKilometers int    `json:"radiusKilometers,omitempty"`
+	VelocityKph      int    `json:"velocityKph,omitempty"`
+}
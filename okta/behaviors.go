@@ -0,0 +1,105 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// BehaviorsService is the service providing access to the Behavior Detection Rules Resource in the Okta API
+type BehaviorsService service
+
+// List fetches all behavior detection rules.
+//
+// https://developer.okta.com/docs/reference/api/behavior-rules/#list-behavior-detection-rules
+func (s *BehaviorsService) List(ctx context.Context) ([]*BehaviorRule, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "behaviors"
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rules []*BehaviorRule
+	resp, err := s.client.Do(ctx, req, &rules)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rules, resp, nil
+}
+
+// GetByID fetches a behavior detection rule by ID.
+//
+// https://developer.okta.com/docs/reference/api/behavior-rules/#get-behavior-detection-rule
+func (s *BehaviorsService) GetByID(ctx context.Context, id string) (*BehaviorRule, *Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("behaviors/%s", id), nil)
+}
+
+// Add creates a new behavior detection rule.
+//
+// https://developer.okta.com/docs/reference/api/behavior-rules/#create-behavior-detection-rule
+func (s *BehaviorsService) Add(ctx context.Context, ruleIn *BehaviorRule) (*BehaviorRule, *Response, error) {
+	return s.do(ctx, "POST", "behaviors", ruleIn)
+}
+
+// Update modifies a behavior detection rule.
+//
+// Note that delta updates are not supported. You must pass a full BehaviorRule object.
+//
+// https://developer.okta.com/docs/reference/api/behavior-rules/#update-behavior-detection-rule
+func (s *BehaviorsService) Update(ctx context.Context, id string, ruleIn *BehaviorRule) (*BehaviorRule, *Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("behaviors/%s", id), ruleIn)
+}
+
+// Remove deletes a behavior detection rule.
+//
+// https://developer.okta.com/docs/reference/api/behavior-rules/#delete-behavior-detection-rule
+func (s *BehaviorsService) Remove(ctx context.Context, id string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("behaviors/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Activate activates a behavior detection rule.
+//
+// https://developer.okta.com/docs/reference/api/behavior-rules/#activate-behavior-detection-rule
+func (s *BehaviorsService) Activate(ctx context.Context, id string) (*BehaviorRule, *Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("behaviors/%s/lifecycle/activate", id), nil)
+}
+
+// Deactivate deactivates a behavior detection rule.
+//
+// https://developer.okta.com/docs/reference/api/behavior-rules/#deactivate-behavior-detection-rule
+func (s *BehaviorsService) Deactivate(ctx context.Context, id string) (*BehaviorRule, *Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("behaviors/%s/lifecycle/deactivate", id), nil)
+}
+
+// do is a helper function for the single rule operations.
+func (s *BehaviorsService) do(ctx context.Context, method string, path string, ruleIn *BehaviorRule) (*BehaviorRule, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if ruleIn != nil {
+		body = ruleIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleOut := new(BehaviorRule)
+	resp, err := s.client.Do(ctx, req, ruleOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ruleOut, resp, nil
+}
@@ -0,0 +1,163 @@
+package okta
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// rateLimitWindow is the window of the rate limits of Okta, which reset every minute.
+const rateLimitWindow = time.Minute
+
+// ErrBudgetExceeded is returned by Budget.Reserve() when the calls can't be made in the current
+// rate limit window.
+var ErrBudgetExceeded = errors.New("okta: rate limit budget exceeded")
+
+// ErrBudgetUnknown is returned by Budget.Reserve() and Budget.Estimate() before the client has
+// seen the rate limit of the category in a response.
+var ErrBudgetUnknown = errors.New("okta: rate limit of the category not observed yet")
+
+// Budget accounts for the calls a Client makes in a rate limit category, so that orchestration
+// layers can plan large jobs against the observed limits, e.g. reserve the calls of a batch, or
+// estimate how long a sync of 12000 calls takes. Every request sent counts, retries included, so
+// do the calls coalesced with CoalesceGets only once.
+//
+// https://developer.okta.com/docs/reference/rl-global-mgmt/
+type Budget struct {
+	client   *Client
+	category RateLimitCategory
+}
+
+// budgetState is the accounting of the calls of a rate limit category in the current window.
+type budgetState struct {
+	reset    time.Time
+	spent    int
+	reserved int
+	total    int
+}
+
+// Budget returns the Budget of a rate limit category, e.g. RateLimitCategoryUsersGetByID, or of
+// the category of a request, as returned by RequestCategory().
+func (c *Client) Budget(category RateLimitCategory) (*Budget, error) {
+	if category < 0 || category >= categories {
+		return nil, fmt.Errorf("okta: unknown rate limit category %d", int(category))
+	}
+	return &Budget{client: c, category: category}, nil
+}
+
+// Spent returns the number of calls made in the current window.
+func (b *Budget) Spent() int {
+	b.client.rateMu.Lock()
+	defer b.client.rateMu.Unlock()
+	return b.client.budgetState(b.category).spent
+}
+
+// Total returns the number of calls made since the client was created.
+func (b *Budget) Total() int {
+	b.client.rateMu.Lock()
+	defer b.client.rateMu.Unlock()
+	return b.client.budgetState(b.category).total
+}
+
+// Remaining returns the number of calls that can still be made in the current window, less those
+// reserved, and false if the rate limit of the category hasn't been observed yet.
+func (b *Budget) Remaining() (int, bool) {
+	b.client.rateMu.Lock()
+	defer b.client.rateMu.Unlock()
+	return b.client.remaining(b.category)
+}
+
+// Reserve sets aside n calls of the current window, or returns ErrBudgetExceeded if there aren't
+// as many left. The calls made afterwards use up the reservation, which lapses when the window
+// resets.
+func (b *Budget) Reserve(n int) error {
+	b.client.rateMu.Lock()
+	defer b.client.rateMu.Unlock()
+
+	remaining, ok := b.client.remaining(b.category)
+	if !ok {
+		return ErrBudgetUnknown
+	}
+	if n > remaining {
+		reset := b.client.budgetState(b.category).reset.Sub(b.client.now()).Round(time.Second)
+		return fmt.Errorf("%w: %d calls requested, %d left for the next %v", ErrBudgetExceeded, n, remaining, reset)
+	}
+	b.client.budgetState(b.category).reserved += n
+	return nil
+}
+
+// Estimate returns how long it takes to make n calls at the observed rate limit: zero if they fit
+// in what remains of the current window, otherwise until the window the last of them fits in.
+func (b *Budget) Estimate(n int) (time.Duration, error) {
+	b.client.rateMu.Lock()
+	defer b.client.rateMu.Unlock()
+
+	remaining, ok := b.client.remaining(b.category)
+	limit := b.client.rateLimits[b.category].Limit
+	if !ok || limit == 0 {
+		return 0, ErrBudgetUnknown
+	}
+	if n <= remaining {
+		return 0, nil
+	}
+	windows := (n - remaining + limit - 1) / limit
+	return b.client.budgetState(b.category).reset.Sub(b.client.now()) + time.Duration(windows-1)*rateLimitWindow, nil
+}
+
+// budgetState returns the state of the budget of category, starting a new window if the current
+// one is over. c.rateMu must be held.
+func (c *Client) budgetState(category RateLimitCategory) *budgetState {
+	state := &c.budgets[category]
+	now := c.now()
+	reset := c.rateLimits[category].Reset.Time
+	if now.Before(state.reset) {
+		// The window started before the rate limit was observed ends with it.
+		if reset.After(now) && reset.Before(state.reset) {
+			state.reset = reset
+		}
+		return state
+	}
+
+	state.spent, state.reserved = 0, 0
+	state.reset = now.Add(rateLimitWindow)
+	if reset.After(now) {
+		state.reset = reset
+	}
+	return state
+}
+
+// remaining returns the calls left in the current window of category, less those reserved, and
+// whether the rate limit has been observed. c.rateMu must be held.
+func (c *Client) remaining(category RateLimitCategory) (int, bool) {
+	rate := c.rateLimits[category]
+	if rate.Limit == 0 {
+		return 0, false
+	}
+	remaining := rate.Remaining
+	if !c.now().Before(rate.Reset.Time) {
+		remaining = rate.Limit
+	}
+	remaining -= c.budgetState(category).reserved
+	if remaining < 0 {
+		remaining = 0
+	}
+	return remaining, true
+}
+
+// spend records a call about to be sent in the budget of the category of req.
+func (c *Client) spend(req *http.Request) {
+	category, ok := RequestCategory(req)
+	if !ok {
+		return
+	}
+
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	state := c.budgetState(category)
+	state.spent++
+	state.total++
+	if state.reserved > 0 {
+		state.reserved--
+	}
+}
@@ -0,0 +1,217 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// BulkItemResult represents the outcome of one item of a bulk operation.
+type BulkItemResult struct {
+	// Item identifies the item, e.g. the ID of a user or the login of a user to create.
+	Item string
+	// RequestID is the X-Okta-Request-Id of the request of the item, if one was made.
+	RequestID string
+	// Err is the error of the item, nil on success. It is the error returned by the request,
+	// e.g. an *ErrorResponse or a *RateLimitError.
+	Err error
+}
+
+// BulkResult records the outcome of every item of a bulk operation, so that callers can retry
+// only the items that failed.
+type BulkResult struct {
+	Items []*BulkItemResult
+}
+
+// record records the outcome of item.
+func (r *BulkResult) record(item string, resp *Response, err error) {
+	result := &BulkItemResult{Item: item, Err: err}
+	if resp != nil {
+		result.RequestID = resp.OktaRequestID
+	}
+	r.Items = append(r.Items, result)
+}
+
+// Succeeded returns the items that succeeded.
+func (r *BulkResult) Succeeded() []string {
+	var items []string
+	for _, result := range r.Items {
+		if result.Err == nil {
+			items = append(items, result.Item)
+		}
+	}
+	return items
+}
+
+// Failed returns the results of the items that failed.
+func (r *BulkResult) Failed() []*BulkItemResult {
+	var failed []*BulkItemResult
+	for _, result := range r.Items {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// FailedItems returns the items that failed, e.g. to retry them.
+func (r *BulkResult) FailedItems() []string {
+	var items []string
+	for _, result := range r.Failed() {
+		items = append(items, result.Item)
+	}
+	return items
+}
+
+// Err returns a *BulkError if any item failed, or nil.
+func (r *BulkResult) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	return &BulkError{Total: len(r.Items), Failures: failed}
+}
+
+// BulkError represents the failure of some items of a bulk operation. errors.Is and errors.As
+// match the errors of the items, e.g. errors.As(err, &rateLimitErr).
+type BulkError struct {
+	Total    int
+	Failures []*BulkItemResult
+}
+
+func (e *BulkError) Error() string {
+	var parts []string
+	for _, f := range e.Failures {
+		parts = append(parts, fmt.Sprintf("%s: %v", f.Item, f.Err))
+	}
+	return fmt.Sprintf("%d of %d items failed: %s", len(e.Failures), e.Total, strings.Join(parts, "; "))
+}
+
+// Unwrap returns the errors of the failed items.
+func (e *BulkError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, f := range e.Failures {
+		errs[i] = f.Err
+	}
+	return errs
+}
+
+// AddUsers adds users to a group, continuing past failures. Items are user IDs. If ctx is done,
+// the error is a *PartialResultError[*BulkItemResult] with the results of the users handled until
+// then.
+//
+// https://developer.okta.com/docs/reference/api/groups/#add-user-to-group
+func (s *GroupsService) AddUsers(ctx context.Context, id string, userIDs []string) (*BulkResult, error) {
+	return bulk(ctx, userIDs, func(_ int, userID string) (*Response, error) {
+		return s.AddUser(ctx, id, userID)
+	})
+}
+
+// RemoveUsers removes users from a group, continuing past failures. Items are user IDs. If ctx is
+// done, the error is a *PartialResultError[*BulkItemResult] with the results of the users handled
+// until then.
+//
+// https://developer.okta.com/docs/reference/api/groups/#remove-user-from-group
+func (s *GroupsService) RemoveUsers(ctx context.Context, id string, userIDs []string) (*BulkResult, error) {
+	return bulk(ctx, userIDs, func(_ int, userID string) (*Response, error) {
+		return s.RemoveUser(ctx, id, userID)
+	})
+}
+
+// bulk calls fn with every item of items and its index, recording the outcomes, until ctx is
+// done.
+func bulk(ctx context.Context, items []string, fn func(i int, item string) (*Response, error)) (*BulkResult, error) {
+	result := new(BulkResult)
+	for i, item := range items {
+		if err := ctx.Err(); err != nil {
+			return nil, partialResult(result.Items, err)
+		}
+		resp, err := fn(i, item)
+		result.record(item, resp, err)
+	}
+	return result, nil
+}
+
+// SyncUsers makes userIDs the members of a group, adding the missing users and removing the
+// others. Items are the IDs of the users added or removed. The error reports a failure to list
+// the current members, in which case nothing is changed, or ctx being done, as a
+// *PartialResultError[*BulkItemResult] with the results of the users handled until then.
+func (s *GroupsService) SyncUsers(ctx context.Context, id string, userIDs []string) (*BulkResult, error) {
+	page, _, err := s.ListUsers(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	members, _, err := page.All(ctx)
+	if err != nil {
+		return nil, partialResult[*BulkItemResult](nil, err)
+	}
+
+	desired := make(map[string]bool, len(userIDs))
+	for _, userID := range userIDs {
+		desired[userID] = true
+	}
+	current := make(map[string]bool, len(members))
+	var remove []string
+	for _, u := range members {
+		current[u.ID] = true
+		if !desired[u.ID] {
+			remove = append(remove, u.ID)
+		}
+	}
+	var add []string
+	for _, userID := range userIDs {
+		if !current[userID] {
+			add = append(add, userID)
+			current[userID] = true
+		}
+	}
+
+	added, err := s.AddUsers(ctx, id, add)
+	if err != nil {
+		return nil, err
+	}
+	removed, err := s.RemoveUsers(ctx, id, remove)
+	if err != nil {
+		return nil, partialResult(append(added.Items, partialItems[*BulkItemResult](err)...), err)
+	}
+	added.Items = append(added.Items, removed.Items...)
+	return added, nil
+}
+
+// AssignGroups assigns groups to an application with the default assignment, continuing past
+// failures. Items are group IDs. If ctx is done, the error is a
+// *PartialResultError[*BulkItemResult] with the results of the groups handled until then.
+//
+// https://developer.okta.com/docs/reference/api/apps/#assign-group-to-application
+func (s *AppsService) AssignGroups(ctx context.Context, id string, groupIDs []string) (*BulkResult, error) {
+	return bulk(ctx, groupIDs, func(_ int, groupID string) (*Response, error) {
+		_, resp, err := s.AssignGroup(ctx, id, groupID, nil)
+		return resp, err
+	})
+}
+
+// AddMany creates users, continuing past failures. Items are the logins of the users, and the
+// created users are returned in the order of profiles, with nil for the failures; a nil profile
+// fails, with an empty item. If ctx is done, the error is a *PartialResultError[*BulkItemResult]
+// with the results of the users handled until then, along with the users created.
+//
+// https://developer.okta.com/docs/reference/api/users/#create-user
+func (s *UsersService) AddMany(ctx context.Context, profiles []*UserProfile, activate bool) ([]*User, *BulkResult, error) {
+	logins := make([]string, len(profiles))
+	for i, profile := range profiles {
+		if profile != nil {
+			logins[i] = profile.Login
+		}
+	}
+
+	users := make([]*User, len(profiles))
+	result, err := bulk(ctx, logins, func(i int, _ string) (*Response, error) {
+		if profiles[i] == nil {
+			return nil, fmt.Errorf("okta: nil profile at index %d", i)
+		}
+		user, resp, err := s.Add(ctx, profiles[i], activate)
+		users[i] = user
+		return resp, err
+	})
+	return users, result, err
+}
@@ -0,0 +1,150 @@
+package okta
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CacheResource is a type for the CacheResource enum, the resource types that can be cached.
+type CacheResource string
+
+// CacheResource Constants
+const (
+	CacheResourceApps      CacheResource = "apps"
+	CacheResourceGroups                  = "groups"
+	CacheResourceUISchemas               = "uischemas"
+)
+
+// DefaultCacheTTLs are the time to live of the cached resources used by NewCache().
+var DefaultCacheTTLs = map[CacheResource]time.Duration{
+	CacheResourceApps:      5 * time.Minute,
+	CacheResourceGroups:    5 * time.Minute,
+	CacheResourceUISchemas: 15 * time.Minute,
+}
+
+// Cache is an in-memory cache of read-heavy, slow-changing resources, keyed by resource type and
+// ID. Set Client.Cache to have GetByID() of the cached resource types served from the cache, which
+// spares the rate limit of tools resolving the same IDs over and over.
+//
+// A resource served from the cache is returned with a Response of status 200 OK, without headers,
+// so its Rate and OktaRequestID are zero.
+//
+// Mutations through the client, e.g. Update() or Remove(), invalidate the entry of the resource.
+// Changes made outside the client are seen once the entry expires; call Invalidate() or Purge()
+// to see them earlier. A Cache is safe for concurrent use and can be shared by clients of the same
+// org.
+type Cache struct {
+	mu      sync.Mutex
+	ttls    map[CacheResource]time.Duration
+	entries map[cacheKey]cacheEntry
+}
+
+type cacheKey struct {
+	resource CacheResource
+	id       string
+}
+
+type cacheEntry struct {
+	data    []byte
+	expires time.Time
+}
+
+// NewCache creates a new Cache. ttls sets the time to live of the resource types, missing types
+// use DefaultCacheTTLs; a zero or negative TTL disables caching of the type.
+func NewCache(ttls map[CacheResource]time.Duration) *Cache {
+	c := &Cache{
+		ttls:    make(map[CacheResource]time.Duration),
+		entries: make(map[cacheKey]cacheEntry),
+	}
+	for resource, ttl := range DefaultCacheTTLs {
+		c.ttls[resource] = ttl
+	}
+	for resource, ttl := range ttls {
+		c.ttls[resource] = ttl
+	}
+	return c
+}
+
+// Invalidate removes the entry of the resource with ID id.
+func (c *Cache) Invalidate(resource CacheResource, id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, cacheKey{resource, id})
+}
+
+// Purge removes all entries.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[cacheKey]cacheEntry)
+}
+
+// get decodes the entry of the resource with ID id into v, and reports whether there was an
+// entry unexpired at now. Entries are stored encoded so that callers never share a value.
+func (c *Cache) get(resource CacheResource, id string, v interface{}, now time.Time) bool {
+	c.mu.Lock()
+	entry, ok := c.entries[cacheKey{resource, id}]
+	if ok && !now.Before(entry.expires) {
+		delete(c.entries, cacheKey{resource, id})
+		ok = false
+	}
+	c.mu.Unlock()
+
+	if !ok || json.Unmarshal(entry.data, v) != nil {
+		return false
+	}
+	setRaw(v, entry.data)
+	return true
+}
+
+// set stores v as the entry of the resource with ID id, at now.
+func (c *Cache) set(resource CacheResource, id string, v interface{}, now time.Time) {
+	ttl := c.ttls[resource]
+	if ttl <= 0 {
+		return
+	}
+	// The JSON v was decoded from keeps the attributes the model doesn't cover, for Raw().
+	var data []byte
+	if r, ok := v.(interface{ Raw() json.RawMessage }); ok && r.Raw() != nil {
+		data = r.Raw()
+	} else if data, _ = json.Marshal(v); data == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[cacheKey{resource, id}] = cacheEntry{data: data, expires: now.Add(ttl)}
+}
+
+// cached decodes the cached resource with ID id into v, and returns the Response standing for the
+// request spared, or nil if it wasn't cached.
+func (c *Client) cached(resource CacheResource, id string, v interface{}) *Response {
+	if c.Cache == nil || !c.Cache.get(resource, id, v, c.now()) {
+		return nil
+	}
+	return &Response{
+		Response: &http.Response{
+			Status:     "200 OK",
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       http.NoBody,
+		},
+		client: c,
+	}
+}
+
+// cache caches v as the resource with ID id.
+func (c *Client) cache(resource CacheResource, id string, v interface{}) {
+	if c.Cache != nil {
+		c.Cache.set(resource, id, v, c.now())
+	}
+}
+
+// invalidate invalidates the cached resource with ID id.
+func (c *Client) invalidate(resource CacheResource, id string) {
+	if c.Cache != nil {
+		c.Cache.Invalidate(resource, id)
+	}
+}
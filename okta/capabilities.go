@@ -0,0 +1,346 @@
+package okta
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// TokenType is the type of the token a Client authenticates with.
+type TokenType string
+
+// TokenType Constants
+const (
+	// TokenTypeSSWS is an API token, which has the administrator roles of the admin who created it.
+	TokenTypeSSWS TokenType = "SSWS"
+	// TokenTypeBearer is an OAuth 2.0 access token for Okta, limited to its scopes and to the
+	// administrator roles of its principal.
+	TokenTypeBearer = "Bearer"
+)
+
+// TokenType returns the type of the token of the client: TokenTypeBearer for a client created with
+// NewClientWithAccessToken(), TokenTypeSSWS otherwise.
+func (c *Client) TokenType() TokenType {
+	if c.tokenType == "" {
+		return TokenTypeSSWS
+	}
+	return c.tokenType
+}
+
+// OperationScopes maps the operations of the client, named "Service.Method", to the OAuth 2.0
+// scope they require. For API tokens, the scope is granted by an administrator role instead.
+//
+// https://developer.okta.com/docs/guides/implement-oauth-for-okta/main/#scopes-and-supported-endpoints
+var OperationScopes = map[string]string{
+	"Apps.GetByID":            "okta.apps.read",
+	"Apps.List":               "okta.apps.read",
+	"Apps.ListAssignedUsers":  "okta.apps.read",
+	"Apps.ListAssignedGroups": "okta.apps.read",
+	"Apps.GetAssignedUser":    "okta.apps.read",
+	"Apps.Add":                "okta.apps.manage",
+	"Apps.Update":             "okta.apps.manage",
+	"Apps.Activate":           "okta.apps.manage",
+	"Apps.Deactivate":         "okta.apps.manage",
+	"Apps.Remove":             "okta.apps.manage",
+	"Apps.AssignGroup":        "okta.apps.manage",
+	"Apps.UnassignUser":       "okta.apps.manage",
+
+	"AuthorizationServers.List":   "okta.authorizationServers.read",
+	"AuthorizationServers.Add":    "okta.authorizationServers.manage",
+	"AuthorizationServers.Update": "okta.authorizationServers.manage",
+	"AuthorizationServers.Remove": "okta.authorizationServers.manage",
+
+	"Authenticators.List":   "okta.authenticators.read",
+	"Authenticators.Update": "okta.authenticators.manage",
+
+	"Domains.List": "okta.domains.read",
+	"Domains.Add":  "okta.domains.manage",
+
+	"EventHooks.List":         "okta.eventHooks.read",
+	"EventHooks.Add":          "okta.eventHooks.manage",
+	"EventHooks.Update":       "okta.eventHooks.manage",
+	"EventHooks.Remove":       "okta.eventHooks.manage",
+	"EventHooks.RotateSecret": "okta.eventHooks.manage",
+
+	"Factors.List":     "okta.users.read",
+	"Factors.Enroll":   "okta.users.manage",
+	"Factors.Activate": "okta.users.manage",
+	"Factors.Verify":   "okta.users.manage",
+	"Factors.Remove":   "okta.users.manage",
+
+	"Groups.GetByID":    "okta.groups.read",
+	"Groups.List":       "okta.groups.read",
+	"Groups.ListUsers":  "okta.groups.read",
+	"Groups.ListRules":  "okta.groups.read",
+	"Groups.Add":        "okta.groups.manage",
+	"Groups.Update":     "okta.groups.manage",
+	"Groups.Remove":     "okta.groups.manage",
+	"Groups.AddUser":    "okta.groups.manage",
+	"Groups.RemoveUser": "okta.groups.manage",
+
+	"IdentityProviders.List":   "okta.idps.read",
+	"IdentityProviders.Add":    "okta.idps.manage",
+	"IdentityProviders.Update": "okta.idps.manage",
+	"IdentityProviders.Remove": "okta.idps.manage",
+
+	"LogStreams.List": "okta.logStreams.read",
+	"LogStreams.Add":  "okta.logStreams.manage",
+
+	"Logs.List": "okta.logs.read",
+
+	"NetworkZones.List":          "okta.networkZones.read",
+	"NetworkZones.Add":           "okta.networkZones.manage",
+	"NetworkZones.Update":        "okta.networkZones.manage",
+	"NetworkZones.Remove":        "okta.networkZones.manage",
+	"NetworkZones.SyncAddresses": "okta.networkZones.manage",
+
+	"Org.GetSettings":    "okta.orgs.read",
+	"Org.UpdateSettings": "okta.orgs.manage",
+
+	"Policies.List":   "okta.policies.read",
+	"Policies.Add":    "okta.policies.manage",
+	"Policies.Update": "okta.policies.manage",
+	"Policies.Remove": "okta.policies.manage",
+
+	"Roles.List":        "okta.roles.read",
+	"Roles.Add":         "okta.roles.manage",
+	"Roles.Remove":      "okta.roles.manage",
+	"ResourceSets.List": "okta.roles.read",
+	"ResourceSets.Add":  "okta.roles.manage",
+
+	"Sessions.Get":               "okta.sessions.read",
+	"Sessions.Close":             "okta.sessions.manage",
+	"Sessions.CloseUserSessions": "okta.users.manage",
+
+	"UISchemas.List":   "okta.uischemas.read",
+	"UISchemas.Update": "okta.uischemas.manage",
+
+	"Users.GetByID":      "okta.users.read",
+	"Users.List":         "okta.users.read",
+	"Users.ListRoles":    "okta.roles.read",
+	"Users.ListGroups":   "okta.users.read",
+	"Users.Add":          "okta.users.manage",
+	"Users.Import":       "okta.users.manage",
+	"Users.Deactivate":   "okta.users.manage",
+	"Users.Remove":       "okta.users.manage",
+	"Users.Suspend":      "okta.users.manage",
+	"Users.ResetFactors": "okta.users.manage",
+	"Users.RevokeGrants": "okta.users.manage",
+}
+
+// roleScopes are the scopes granted by the standard administrator roles. The super administrator
+// is granted every scope.
+var roleScopes = map[RoleType][]string{
+	RoleTypeUserAdmin:                {"okta.users.read", "okta.users.manage", "okta.groups.read", "okta.groups.manage"},
+	RoleTypeGroupMembershipAdmin:     {"okta.users.read", "okta.groups.read", "okta.groups.manage"},
+	RoleTypeHelpDeskAdmin:            {"okta.users.read", "okta.groups.read"},
+	RoleTypeAppAdmin:                 {"okta.apps.read", "okta.apps.manage", "okta.users.read", "okta.groups.read"},
+	RoleTypeAPIAccessManagementAdmin: {"okta.authorizationServers.read", "okta.authorizationServers.manage", "okta.apps.read"},
+	RoleTypeReportAdmin:              {"okta.logs.read"},
+	RoleTypeMobileAdmin:              {"okta.users.read"},
+}
+
+// Capabilities represents what the token of a Client is allowed to do, see
+// Client.CheckCapabilities().
+type Capabilities struct {
+	TokenType TokenType
+	// Scopes are the scopes granted to the token, for access tokens.
+	Scopes []string
+	// Roles are the administrator roles of the principal of the token. They are unknown, and
+	// not taken into account, when RolesKnown is false.
+	Roles      []*RoleAssignment
+	RolesKnown bool
+	// Operations reports, for each operation of OperationScopes, whether it is allowed.
+	Operations map[string]bool
+
+	// roleGrants are the scopes granted by the roles, "*" standing for all.
+	roleGrants map[string]bool
+}
+
+// CheckCapabilities inspects the token of the client, its OAuth 2.0 scopes for access tokens and
+// the administrator roles of its principal, and returns the operations that will succeed. Tools
+// can call Capabilities.Check() up front to fail fast with a clear message rather than with 403
+// errors along the way.
+//
+// Custom roles are bound to resource sets, so an operation allowed by a custom role may still
+// fail for the resources outside of its resource set.
+func (c *Client) CheckCapabilities(ctx context.Context) (*Capabilities, error) {
+	caps := &Capabilities{
+		TokenType:  c.TokenType(),
+		Operations: make(map[string]bool, len(OperationScopes)),
+		roleGrants: make(map[string]bool),
+	}
+
+	if caps.TokenType == TokenTypeBearer {
+		scopes, err := tokenScopes(c.apiToken)
+		if err != nil {
+			return nil, err
+		}
+		caps.Scopes = scopes
+	}
+
+	// Access tokens of service apps have no user to read the roles of, and access tokens without
+	// the okta.roles.read scope can't read them: the scopes are then all there is to go by.
+	roles, _, err := c.Users.ListRoles(ctx, "me")
+	if errResp, ok := err.(*ErrorResponse); ok && caps.TokenType == TokenTypeBearer &&
+		(errResp.Response.StatusCode == http.StatusForbidden || errResp.Response.StatusCode == http.StatusNotFound) {
+		err = nil
+		roles = nil
+	} else if err == nil {
+		caps.RolesKnown = true
+	}
+	if err != nil {
+		return nil, err
+	}
+	caps.Roles = roles
+
+	for _, role := range roles {
+		if role.Status != "" && role.Status != "ACTIVE" {
+			continue
+		}
+		switch role.Type {
+		case RoleTypeSuperAdmin:
+			caps.roleGrants["*"] = true
+		case RoleTypeOrgAdmin, RoleTypeReadOnlyAdmin:
+			for _, scope := range OperationScopes {
+				if role.Type == RoleTypeOrgAdmin && !strings.HasPrefix(scope, "okta.roles.") ||
+					strings.HasSuffix(scope, ".read") {
+					caps.roleGrants[scope] = true
+				}
+			}
+		case RoleTypeCustom:
+			permissions, _, err := c.Roles.ListPermissions(ctx, role.Role)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range permissions {
+				for _, scope := range permissionScopes(p.Label) {
+					caps.roleGrants[scope] = true
+				}
+			}
+		default:
+			for _, scope := range roleScopes[role.Type] {
+				caps.roleGrants[scope] = true
+			}
+		}
+	}
+
+	for operation, scope := range OperationScopes {
+		caps.Operations[operation] = caps.allows(scope)
+	}
+	return caps, nil
+}
+
+// Allows reports whether operation, e.g. "Users.Add", is allowed. Operations missing from
+// OperationScopes are assumed to be allowed.
+func (c *Capabilities) Allows(operation string) bool {
+	allowed, ok := c.Operations[operation]
+	return allowed || !ok
+}
+
+// Check returns a *CapabilityError for the first of operations that isn't allowed, or nil.
+func (c *Capabilities) Check(operations ...string) error {
+	for _, operation := range operations {
+		if c.Allows(operation) {
+			continue
+		}
+
+		scope := OperationScopes[operation]
+		err := &CapabilityError{Operation: operation, Scope: scope, TokenType: c.TokenType}
+		err.MissingScope = c.TokenType == TokenTypeBearer && !c.hasScope(scope)
+		return err
+	}
+	return nil
+}
+
+// Missing returns the operations that aren't allowed, sorted.
+func (c *Capabilities) Missing() []string {
+	var missing []string
+	for operation, allowed := range c.Operations {
+		if !allowed {
+			missing = append(missing, operation)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// allows reports whether scope is both granted to the token and, if they are known, by the roles.
+func (c *Capabilities) allows(scope string) bool {
+	if c.TokenType == TokenTypeBearer && !c.hasScope(scope) {
+		return false
+	}
+	if !c.RolesKnown {
+		return true
+	}
+	return c.roleGrants["*"] || c.roleGrants[scope]
+}
+
+// hasScope reports whether scope is granted to the token. A manage scope implies the read scope of
+// the same resource.
+func (c *Capabilities) hasScope(scope string) bool {
+	for _, s := range c.Scopes {
+		if s == scope || strings.HasSuffix(scope, ".read") && s == strings.TrimSuffix(scope, ".read")+".manage" {
+			return true
+		}
+	}
+	return false
+}
+
+// CapabilityError is returned by Capabilities.Check() for an operation that isn't allowed.
+type CapabilityError struct {
+	Operation string
+	Scope     string
+	TokenType TokenType
+	// MissingScope is set when the access token lacks Scope. Otherwise no administrator role of
+	// the principal of the token grants it.
+	MissingScope bool
+}
+
+func (e *CapabilityError) Error() string {
+	if e.MissingScope {
+		return fmt.Sprintf("okta: %s requires the %s scope, missing from the access token", e.Operation, e.Scope)
+	}
+	return fmt.Sprintf("okta: %s requires an administrator role granting %s", e.Operation, e.Scope)
+}
+
+// permissionScopes returns the scopes equivalent to a custom role permission: every permission on
+// a resource, e.g. "okta.users.lifecycle.manage", grants reading it, and its manage permissions
+// grant managing it.
+func permissionScopes(permission string) []string {
+	parts := strings.Split(permission, ".")
+	if len(parts) < 3 || parts[0] != "okta" {
+		return nil
+	}
+	resource := "okta." + parts[1]
+	scopes := []string{resource + ".read"}
+	if strings.HasSuffix(permission, ".manage") || strings.HasSuffix(permission, ".create") {
+		scopes = append(scopes, resource+".manage")
+	}
+	return scopes
+}
+
+// tokenScopes returns the scopes of an access token, read from its "scp" claim. The token isn't
+// verified, which is up to the API.
+func tokenScopes(token string) ([]string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("okta: malformed access token")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("okta: malformed access token: %v", err)
+	}
+
+	var claims struct {
+		Scopes []string `json:"scp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("okta: malformed access token: %v", err)
+	}
+	return claims.Scopes, nil
+}
@@ -0,0 +1,38 @@
+package okta
+
+// CAPTCHA represents a CAPTCHA instance used to protect the sign-in widget.
+//
+// https://developer.okta.com/docs/reference/api/captchas/#captcha-instance-object
+type CAPTCHA struct {
+	RawJSON
+	ID      string      `json:"id,omitempty"`
+	Name    string      `json:"name"`
+	Type    CAPTCHAType `json:"type"`
+	SiteKey string      `json:"siteKey"`
+	// SecretKey is a write only property.
+	SecretKey string      `json:"secretKey,omitempty"`
+	Links     interface{} `json:"_links,omitempty"`
+}
+
+// CAPTCHAType is a type for the CAPTCHAType enum.
+//
+// https://developer.okta.com/docs/reference/api/captchas/#captcha-instance-object
+type CAPTCHAType string
+
+// CAPTCHAType Constants
+//
+// https://developer.okta.com/docs/reference/api/captchas/#captcha-instance-object
+const (
+	CAPTCHATypeHCaptcha    CAPTCHAType = "HCAPTCHA"
+	CAPTCHATypeReCaptchaV2             = "RECAPTCHA_V2"
+)
+
+// OrgCAPTCHASettings represents which CAPTCHA instance is used on which pages of the org.
+//
+// https://developer.okta.com/docs/reference/api/captchas/#org-wide-captcha-settings-object
+type OrgCAPTCHASettings struct {
+	CAPTCHAID string `json:"captchaId"`
+	// EnabledPages has possible values of: "SSR", "SSPR", "SIGN_IN"
+	EnabledPages []string    `json:"enabledPages"`
+	Links        interface{} `json:"_links,omitempty"`
+}
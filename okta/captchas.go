@@ -0,0 +1,144 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// CAPTCHAsService is the service providing access to the CAPTCHAs Resource in the Okta API
+type CAPTCHAsService service
+
+// List fetches all CAPTCHA instances.
+//
+// https://developer.okta.com/docs/reference/api/captchas/#list-all-captcha-instances
+func (s *CAPTCHAsService) List(ctx context.Context) ([]*CAPTCHA, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "captchas"
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var captchas []*CAPTCHA
+	resp, err := s.client.Do(ctx, req, &captchas)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return captchas, resp, nil
+}
+
+// GetByID fetches a CAPTCHA instance by ID.
+//
+// https://developer.okta.com/docs/reference/api/captchas/#retrieve-captcha-instance
+func (s *CAPTCHAsService) GetByID(ctx context.Context, id string) (*CAPTCHA, *Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("captchas/%s", id), nil)
+}
+
+// Add creates a new CAPTCHA instance.
+//
+// https://developer.okta.com/docs/reference/api/captchas/#create-captcha-instance
+func (s *CAPTCHAsService) Add(ctx context.Context, captchaIn *CAPTCHA) (*CAPTCHA, *Response, error) {
+	return s.do(ctx, "POST", "captchas", captchaIn)
+}
+
+// Update modifies a CAPTCHA instance.
+//
+// Note that delta updates are not supported. You must pass a full CAPTCHA object, including the SecretKey.
+//
+// https://developer.okta.com/docs/reference/api/captchas/#update-captcha-instance
+func (s *CAPTCHAsService) Update(ctx context.Context, id string, captchaIn *CAPTCHA) (*CAPTCHA, *Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("captchas/%s", id), captchaIn)
+}
+
+// Remove deletes a CAPTCHA instance. Instances in use by the org wide settings can't be deleted.
+//
+// https://developer.okta.com/docs/reference/api/captchas/#delete-captcha-instance
+func (s *CAPTCHAsService) Remove(ctx context.Context, id string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("captchas/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// do is a helper function for the single CAPTCHA instance operations.
+func (s *CAPTCHAsService) do(ctx context.Context, method string, path string, captchaIn *CAPTCHA) (*CAPTCHA, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if captchaIn != nil {
+		body = captchaIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	captchaOut := new(CAPTCHA)
+	resp, err := s.client.Do(ctx, req, captchaOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return captchaOut, resp, nil
+}
+
+// GetOrgSettings fetches the org wide CAPTCHA settings.
+//
+// https://developer.okta.com/docs/reference/api/captchas/#retrieve-org-wide-captcha-settings
+func (s *CAPTCHAsService) GetOrgSettings(ctx context.Context) (*OrgCAPTCHASettings, *Response, error) {
+	return s.doOrgSettings(ctx, "GET", nil)
+}
+
+// UpdateOrgSettings replaces the org wide CAPTCHA settings.
+//
+// https://developer.okta.com/docs/reference/api/captchas/#update-org-wide-captcha-settings
+func (s *CAPTCHAsService) UpdateOrgSettings(ctx context.Context, settings *OrgCAPTCHASettings) (*OrgCAPTCHASettings, *Response, error) {
+	return s.doOrgSettings(ctx, "PUT", settings)
+}
+
+// doOrgSettings is a helper function for the org wide settings operations.
+func (s *CAPTCHAsService) doOrgSettings(ctx context.Context, method string, settings *OrgCAPTCHASettings) (*OrgCAPTCHASettings, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "org/captcha"
+
+	var body interface{}
+	if settings != nil {
+		body = settings
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settingsOut := new(OrgCAPTCHASettings)
+	resp, err := s.client.Do(ctx, req, settingsOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settingsOut, resp, nil
+}
+
+// RemoveOrgSettings resets the org wide CAPTCHA settings, disabling CAPTCHA on all pages.
+//
+// https://developer.okta.com/docs/reference/api/captchas/#delete-org-wide-captcha-settings
+func (s *CAPTCHAsService) RemoveOrgSettings(ctx context.Context) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "org/captcha"
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,243 @@
+package okta
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// clientAssertionType is the client_assertion_type of the private_key_jwt client authentication.
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// tokenExpiryDelta is how long before its expiry a token is renewed, so that it doesn't expire in
+// flight.
+const tokenExpiryDelta = 10 * time.Second
+
+// OAuthToken represents an access token obtained from an authorization server.
+//
+// https://developer.okta.com/docs/reference/api/oidc/#response-properties-2
+type OAuthToken struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+	Scope       string `json:"scope,omitempty"`
+	// RefreshToken and IDToken are only issued by the authorization code flow, for the
+	// offline_access and openid scopes.
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	// Expiry is the time the token expires, computed from ExpiresIn when it was obtained. It is
+	// zero if the authorization server didn't tell, and the token is then taken not to expire.
+	Expiry time.Time `json:"-"`
+}
+
+// Valid reports whether the token is set and isn't about to expire.
+func (t *OAuthToken) Valid() bool {
+	return t != nil && t.AccessToken != "" &&
+		(t.Expiry.IsZero() || time.Now().Add(tokenExpiryDelta).Before(t.Expiry))
+}
+
+// setExpiry sets the Expiry of the token, obtained at obtained, from its ExpiresIn.
+func (t *OAuthToken) setExpiry(obtained time.Time) {
+	if t.ExpiresIn > 0 {
+		t.Expiry = obtained.Add(time.Duration(t.ExpiresIn) * time.Second)
+	}
+}
+
+// SetAuthHeader sets the Authorization header of req to the token.
+func (t *OAuthToken) SetAuthHeader(req *http.Request) {
+	tokenType := t.TokenType
+	if tokenType == "" {
+		tokenType = "Bearer"
+	}
+	req.Header.Set("Authorization", tokenType+" "+t.AccessToken)
+}
+
+// ClientCredentials obtains access tokens from an authorization server with the client credentials
+// grant, for a service calling APIs protected by Okta, and caches them until they are about to
+// expire. The client authenticates with its secret, or with a JWT signed by its private key when
+// PrivateKey is set.
+//
+// This package doesn't depend on golang.org/x/oauth2: oauth2adapter.TokenSource() adapts a
+// ClientCredentials to an oauth2.TokenSource. Without golang.org/x/oauth2, Transport()
+// authenticates the requests of an http.Client.
+//
+// https://developer.okta.com/docs/guides/implement-grant-type/clientcreds/main/
+type ClientCredentials struct {
+	// Issuer is the issuer of the authorization server, e.g. https://example.okta.com/oauth2/default.
+	Issuer   string
+	ClientID string
+	// ClientSecret authenticates the client with client_secret_basic, unless PrivateKey is set.
+	ClientSecret string
+	// PrivateKey authenticates the client with private_key_jwt, the key whose public key is
+	// registered for the client.
+	PrivateKey *rsa.PrivateKey
+	// KeyID is the kid of PrivateKey among the keys of the client, optional if it has one.
+	KeyID string
+	// Scopes are the scopes requested.
+	Scopes []string
+	// HTTPClient makes the token requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	mu    sync.Mutex
+	token *OAuthToken
+}
+
+// NewClientCredentials is a helper method to create a new ClientCredentials of the client clientID
+// authenticating with clientSecret, requesting scopes.
+func NewClientCredentials(issuer, clientID, clientSecret string, scopes ...string) *ClientCredentials {
+	return &ClientCredentials{
+		Issuer:       strings.TrimSuffix(issuer, "/"),
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Scopes:       scopes,
+	}
+}
+
+// NewPrivateKeyJWTCredentials is a helper method to create a new ClientCredentials of the client
+// clientID authenticating with a JWT signed by key, whose kid is keyID, requesting scopes.
+func NewPrivateKeyJWTCredentials(issuer, clientID string, key *rsa.PrivateKey, keyID string, scopes ...string) *ClientCredentials {
+	return &ClientCredentials{
+		Issuer:     strings.TrimSuffix(issuer, "/"),
+		ClientID:   clientID,
+		PrivateKey: key,
+		KeyID:      keyID,
+		Scopes:     scopes,
+	}
+}
+
+// Token returns the cached access token, or obtains a new one if it is about to expire.
+func (c *ClientCredentials) Token() (*OAuthToken, error) {
+	return c.TokenContext(context.Background())
+}
+
+// TokenContext is Token() with a context for the token request.
+func (c *ClientCredentials) TokenContext(ctx context.Context) (*OAuthToken, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token.Valid() {
+		token := *c.token
+		return &token, nil
+	}
+
+	tokenURL := oauthEndpoint(c.Issuer, "token")
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(c.Scopes) > 0 {
+		form.Set("scope", strings.Join(c.Scopes, " "))
+	}
+	auth := func(req *http.Request) {
+		req.SetBasicAuth(url.QueryEscape(c.ClientID), url.QueryEscape(c.ClientSecret))
+	}
+	if c.PrivateKey != nil {
+		assertion, err := c.clientAssertion(tokenURL)
+		if err != nil {
+			return nil, err
+		}
+		form.Set("client_id", c.ClientID)
+		form.Set("client_assertion_type", clientAssertionType)
+		form.Set("client_assertion", assertion)
+		auth = func(req *http.Request) {}
+	}
+
+	obtained := time.Now()
+	data, err := postOAuthForm(ctx, c.HTTPClient, tokenURL, form, auth)
+	if err != nil {
+		return nil, err
+	}
+	token := new(OAuthToken)
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, errors.New("okta: no access token in the token response")
+	}
+	token.setExpiry(obtained)
+
+	c.token = token
+	tokenCopy := *token
+	return &tokenCopy, nil
+}
+
+// Transport returns an http.RoundTripper authenticating the requests made with base, or
+// http.DefaultTransport if base is nil, with the access tokens of c.
+func (c *ClientCredentials) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &clientCredentialsTransport{credentials: c, base: base}
+}
+
+type clientCredentialsTransport struct {
+	credentials *ClientCredentials
+	base        http.RoundTripper
+}
+
+func (t *clientCredentialsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.credentials.TokenContext(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	token.SetAuthHeader(req)
+	return t.base.RoundTrip(req)
+}
+
+// clientAssertion returns a JWT authenticating the client to the token endpoint at audience,
+// signed with RS256 by its private key.
+//
+// https://developer.okta.com/docs/reference/api/oidc/#jwt-with-private-key
+func (c *ClientCredentials) clientAssertion(audience string) (string, error) {
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+	now := time.Now()
+
+	header := map[string]string{"alg": "RS256", "typ": "JWT"}
+	if c.KeyID != "" {
+		header["kid"] = c.KeyID
+	}
+	claims := map[string]interface{}{
+		"iss": c.ClientID,
+		"sub": c.ClientID,
+		"aud": audience,
+		"iat": now.Unix(),
+		"exp": now.Add(5 * time.Minute).Unix(),
+		"jti": hex.EncodeToString(jti),
+	}
+
+	headerPart, err := encodeJWTPart(header)
+	if err != nil {
+		return "", err
+	}
+	claimsPart, err := encodeJWTPart(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput := headerPart + "." + claimsPart
+	hash := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, c.PrivateKey, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", err
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// encodeJWTPart encodes v as a base64url encoded JSON part of a JWT.
+func encodeJWTPart(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
@@ -0,0 +1,55 @@
+package okta
+
+import (
+	"context"
+	"time"
+)
+
+// Clock tells the time, and waits, for a Client: for its rate limit checks, the backoff of its
+// retries, its waits for a rate limit to reset, the windows of its reports, the expiry of its
+// caches, and the durations it records, e.g. in audit records. Tests can set a
+// fake one, e.g. advancing its time on Sleep() instead of sleeping, to exercise throttling
+// deterministically.
+type Clock interface {
+	Now() time.Time
+	// Sleep waits for d, or until ctx is done, and returns the error of ctx then.
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+// systemClock is the Clock of the system, the default one.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// clock returns the Clock of the client, the system one if none is set or c is nil.
+func (c *Client) clock() Clock {
+	if c == nil || c.Clock == nil {
+		return systemClock{}
+	}
+	return c.Clock
+}
+
+// now returns the current time of the Clock of the client.
+func (c *Client) now() time.Time {
+	return c.clock().Now()
+}
+
+// sleepUntil blocks until t, by the Clock of the client, or until ctx is done.
+func (c *Client) sleepUntil(ctx context.Context, t time.Time) error {
+	clock := c.clock()
+	return clock.Sleep(ctx, t.Sub(clock.Now()))
+}
@@ -0,0 +1,99 @@
+package okta
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// coalescedCall is a GET request in flight, whose response is shared by the identical requests
+// made meanwhile.
+type coalescedCall struct {
+	done chan struct{}
+	resp *http.Response
+	body []byte
+	err  error
+}
+
+// coalescer makes a single request for the identical GET requests in flight at the same time.
+type coalescer struct {
+	mu    sync.Mutex
+	calls map[string]*coalescedCall
+}
+
+// send makes req with c.httpClient, or waits for the response of the identical request in flight
+// if there is one. Every caller receives its own copy of the response.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	if !c.CoalesceGets || req.Method != http.MethodGet {
+		c.spend(req)
+		return c.httpClient.Do(req)
+	}
+
+	key := coalesceKey(req)
+	c.coalescer.mu.Lock()
+	if c.coalescer.calls == nil {
+		c.coalescer.calls = make(map[string]*coalescedCall)
+	}
+	if call, ok := c.coalescer.calls[key]; ok {
+		c.coalescer.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.response()
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+	call := &coalescedCall{done: make(chan struct{})}
+	c.coalescer.calls[key] = call
+	c.coalescer.mu.Unlock()
+
+	c.spend(req)
+	call.resp, call.err = c.httpClient.Do(req)
+	if call.err == nil {
+		call.body, call.err = ioutil.ReadAll(call.resp.Body)
+		call.resp.Body.Close()
+	}
+
+	c.coalescer.mu.Lock()
+	delete(c.coalescer.calls, key)
+	c.coalescer.mu.Unlock()
+	close(call.done)
+
+	return call.response()
+}
+
+// coalesceKey returns the key of the identical requests to req: those with its URL and its final
+// headers, e.g. with the same credentials and the same per-call options.
+func coalesceKey(req *http.Request) string {
+	keys := make([]string, 0, len(req.Header))
+	for key := range req.Header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(req.URL.String())
+	for _, key := range keys {
+		for _, value := range req.Header[key] {
+			b.WriteString("\n")
+			b.WriteString(key)
+			b.WriteString(": ")
+			b.WriteString(value)
+		}
+	}
+	return b.String()
+}
+
+// response returns a copy of the response of the call.
+func (call *coalescedCall) response() (*http.Response, error) {
+	if call.err != nil {
+		return nil, call.err
+	}
+	resp := *call.resp
+	resp.Header = call.resp.Header.Clone()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(call.body))
+	return &resp, nil
+}
@@ -0,0 +1,91 @@
+package okta
+
+import (
+	"context"
+	"sort"
+)
+
+// CollectOptions configures Collect().
+type CollectOptions[T any] struct {
+	// Key returns the ID of an item. When set, an item seen several times, e.g. because it was
+	// updated and moved between pages during the listing, is only kept once, in its last seen
+	// version.
+	Key func(T) string
+	// Less orders the items. The sort is stable, so items Less considers equal stay in the
+	// order of the API. When nil the order of the API is kept.
+	Less func(a, b T) bool
+	// Passes is the number of times the collection is listed, the items of all passes being
+	// merged. Cursor based pagination skips the items that move before the cursor while it
+	// advances, so a long listing of a collection that changes can miss items; a second pass
+	// catches them. It requires Key, and defaults to 1.
+	Passes int
+}
+
+// Collect fetches all pages of the collection returned by list, as All() does, then deduplicates
+// and sorts the items according to opts. Items deleted during the listing may still be returned.
+// If a page can't be fetched, or ctx is done, after the first one, the error is a
+// *PartialResultError with the items of the pages fetched until then, deduplicated and sorted.
+//
+//	users, _, err := okta.Collect(ctx, func(ctx context.Context) (*okta.Collection[*okta.User], *okta.Response, error) {
+//		return client.Users.List(ctx, nil)
+//	}, &okta.CollectOptions[*okta.User]{
+//		Key:  func(u *okta.User) string { return u.ID },
+//		Less: func(a, b *okta.User) bool { return a.Profile.Login < b.Profile.Login },
+//	})
+func Collect[T any](ctx context.Context, list func(ctx context.Context) (*Collection[T], *Response, error), opts *CollectOptions[T]) ([]T, *Response, error) {
+	if opts == nil {
+		opts = new(CollectOptions[T])
+	}
+	passes := opts.Passes
+	if passes < 1 || opts.Key == nil {
+		passes = 1
+	}
+
+	var items []T
+	var resp *Response
+	index := make(map[string]int)
+	merge := func(all []T) {
+		for _, item := range all {
+			if opts.Key == nil {
+				items = append(items, item)
+				continue
+			}
+			key := opts.Key(item)
+			if i, ok := index[key]; ok {
+				items[i] = item
+				continue
+			}
+			index[key] = len(items)
+			items = append(items, item)
+		}
+	}
+	sortItems := func() {
+		if opts.Less != nil {
+			sort.SliceStable(items, func(i, j int) bool {
+				return opts.Less(items[i], items[j])
+			})
+		}
+	}
+
+	for pass := 0; pass < passes; pass++ {
+		page, _, err := list(ctx)
+		if err != nil && pass == 0 {
+			return nil, nil, err
+		}
+		if err != nil {
+			sortItems()
+			return nil, resp, partialResult(items, err)
+		}
+		var all []T
+		all, resp, err = page.All(ctx)
+		if err != nil {
+			merge(partialItems[T](err))
+			sortItems()
+			return nil, resp, partialResult(items, err)
+		}
+		merge(all)
+	}
+
+	sortItems()
+	return items, resp, nil
+}
@@ -0,0 +1,252 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ErrNoNextPage is returned by Collection.NextPage() on the last page of a collection.
+var ErrNoNextPage = errors.New("okta: no next page")
+
+// The backoff of the pages of a collection failing with a transient error, e.g. a 429 or 503
+// during an org-wide throttling: the delay between pages starts at minPageDelay, doubles with
+// every failed page up to maxPageDelay, and halves with every page fetched. A page is retried up
+// to maxPageRetries times, unless the Retry policy of the client retries the requests, and the
+// errors are classified by its classifier.
+const (
+	minPageDelay   = time.Second
+	maxPageDelay   = time.Minute
+	maxPageRetries = 5
+)
+
+// Collection represents a page of a paginated list of T, e.g. of *User, and the Response it was
+// read from. The List methods return the first page; use NextPage() to fetch the following ones,
+// or All() to fetch all items at once.
+//
+// https://developer.okta.com/docs/reference/core-okta-api/#pagination
+type Collection[T any] struct {
+	Items    []T
+	Response *Response
+
+	client     *Client
+	next       string
+	fetch      func(ctx context.Context, path string) (*Collection[T], *Response, error)
+	progress   Progress
+	onProgress func(Progress)
+}
+
+// Progress represents the progress of the listing of a collection up to a page, e.g. to report
+// the progress of a long export.
+type Progress struct {
+	// Pages is the number of pages fetched.
+	Pages int
+	// Items is the number of items fetched.
+	Items int
+	// Started is the time the first page was requested.
+	Started time.Time
+	// Elapsed is the time from Started until the page was fetched.
+	Elapsed time.Duration
+	// Done reports whether the page is the last one.
+	Done bool
+	// Delay is the wait before the next page, slowing the listing down while pages fail with
+	// transient errors, zero once it has recovered.
+	Delay time.Duration
+	// Retries is the number of times a page was fetched again after a transient error.
+	Retries int
+}
+
+// add returns p updated with pages more pages of items items, done if the last one is, at now.
+func (p Progress) add(pages int, items int, done bool, now time.Time) Progress {
+	p.Pages += pages
+	p.Items += items
+	p.Elapsed = now.Sub(p.Started)
+	p.Done = done
+	return p
+}
+
+// HasNextPage reports whether there is a page after this one.
+func (c *Collection[T]) HasNextPage() bool {
+	return c.next != ""
+}
+
+// Cursor returns the URL of the page after this one, or an empty string on the last page. A
+// cursor can be saved to continue a listing later with Resume().
+func (c *Collection[T]) Cursor() string {
+	return c.next
+}
+
+// Resume returns an empty page of the same collection whose next page is at cursor, as returned
+// by Cursor(), so that NextPage() and All() continue a listing where an earlier one stopped.
+func (c *Collection[T]) Resume(cursor string) *Collection[T] {
+	return &Collection[T]{
+		client:     c.client,
+		next:       cursor,
+		fetch:      c.fetch,
+		progress:   Progress{Started: c.client.now(), Done: cursor == ""},
+		onProgress: c.onProgress,
+	}
+}
+
+// Progress returns the progress of the listing up to this page. The counts of a page returned by
+// Resume() start over.
+func (c *Collection[T]) Progress() Progress {
+	return c.progress
+}
+
+// OnProgress sets fn to be called with the Progress of every page fetched after this one with
+// NextPage() or All(), the last call having Progress.Done set, e.g. to drive a progress bar. It
+// returns c.
+//
+//	users, _, err := page.OnProgress(func(p okta.Progress) {
+//		log.Printf("%d users in %d pages", p.Items, p.Pages)
+//	}).All(ctx)
+func (c *Collection[T]) OnProgress(fn func(Progress)) *Collection[T] {
+	c.onProgress = fn
+	return c
+}
+
+// NextPage fetches the page after this one, or returns ErrNoNextPage on the last page. A page
+// failing with a transient error, e.g. a 429 or a 5xx, is fetched again after a delay which grows
+// with the failures, and shrinks back as pages succeed, see Progress.Delay.
+func (c *Collection[T]) NextPage(ctx context.Context) (*Collection[T], error) {
+	next, _, err := c.nextPage(ctx)
+	return next, err
+}
+
+// All fetches the pages after this one and returns their items appended to the items of this
+// page, along with the Response of the last page. If a page can't be fetched, or ctx is done
+// between pages, the error is a *PartialResultError with the items fetched until then.
+func (c *Collection[T]) All(ctx context.Context) ([]T, *Response, error) {
+	items := append([]T(nil), c.Items...)
+	page := c
+	for page.HasNextPage() {
+		if err := ctx.Err(); err != nil {
+			return nil, page.Response, partialResult(items, err)
+		}
+		next, resp, err := page.nextPage(ctx)
+		if err != nil {
+			return nil, resp, partialResult(items, err)
+		}
+		items = append(items, next.Items...)
+		page = next
+	}
+	return items, page.Response, nil
+}
+
+// nextPage fetches the page after this one, backing off from transient errors, carries the
+// progress over and reports it.
+func (c *Collection[T]) nextPage(ctx context.Context) (*Collection[T], *Response, error) {
+	if !c.HasNextPage() {
+		return nil, nil, ErrNoNextPage
+	}
+
+	delay, wait, retries := c.progress.Delay, c.progress.Delay, c.progress.Retries
+	var next *Collection[T]
+	for attempt := 0; ; attempt++ {
+		if wait > 0 {
+			if err := c.client.sleepUntil(ctx, c.client.now().Add(wait)); err != nil {
+				return nil, nil, err
+			}
+		}
+		var resp *Response
+		var err error
+		next, resp, err = c.fetch(ctx, c.next)
+		if err == nil {
+			break
+		}
+		// A page the RetryPolicy of the client retried already isn't retried again.
+		class := c.client.retryClassifier().Classify(err)
+		if class == RetryClassFatal || attempt == maxPageRetries || c.client.retries() {
+			return nil, resp, err
+		}
+
+		retries++
+		if delay *= 2; delay < minPageDelay {
+			delay = minPageDelay
+		} else if delay > maxPageDelay {
+			delay = maxPageDelay
+		}
+		wait = delay
+		var rateErr *RateLimitError
+		if errors.As(err, &rateErr) && rateErr.Rate.Reset.Sub(c.client.now()) > wait {
+			wait = rateErr.Rate.Reset.Sub(c.client.now())
+		}
+	}
+	if delay /= 2; delay < minPageDelay {
+		delay = 0
+	}
+
+	next.progress = c.progress.add(1, len(next.Items), !next.HasNextPage(), c.client.now())
+	next.progress.Delay, next.progress.Retries = delay, retries
+	next.onProgress = c.onProgress
+	if next.onProgress != nil {
+		next.onProgress(next.progress)
+	}
+	return next, next.Response, nil
+}
+
+// listPage is a helper function that fetches the page at path of a collection returned as a JSON
+// array, paginated with Link headers.
+func listPage[T any](ctx context.Context, client *Client, category RateLimitCategory, path string) (*Collection[T], *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, category)
+	started := client.now()
+	req, err := client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []T
+	resp, err := client.Do(ctx, req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	c := &Collection[T]{Items: items, Response: resp, client: client, next: resp.Pagination.Next}
+	c.progress = Progress{Started: started}.add(1, len(items), !c.HasNextPage(), client.now())
+	c.fetch = func(ctx context.Context, path string) (*Collection[T], *Response, error) {
+		return listPage[T](ctx, client, category, path)
+	}
+	return c, resp, nil
+}
+
+// listIAMPage is a helper function that fetches the page at path of a collection of the IAM
+// endpoints, returned as a JSON object with the items in the attribute key and the next page in
+// _links.
+func listIAMPage[T any](ctx context.Context, client *Client, key string, path string) (*Collection[T], *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	started := client.now()
+	req, err := client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var page map[string]json.RawMessage
+	resp, err := client.Do(ctx, req, &page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var items []T
+	if data, ok := page[key]; ok {
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, resp, err
+		}
+		setRaw(&items, data)
+		client.checkEnums(req, &items)
+	}
+	var links iamLinks
+	if data, ok := page["_links"]; ok {
+		if err := json.Unmarshal(data, &links); err != nil {
+			return nil, resp, err
+		}
+	}
+
+	c := &Collection[T]{Items: items, Response: resp, client: client, next: links.Next.Link}
+	c.progress = Progress{Started: started}.add(1, len(items), !c.HasNextPage(), client.now())
+	c.fetch = func(ctx context.Context, path string) (*Collection[T], *Response, error) {
+		return listIAMPage[T](ctx, client, key, path)
+	}
+	return c, resp, nil
+}
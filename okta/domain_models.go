@@ -0,0 +1,47 @@
+package okta
+
+// Domain represents a custom domain of the org.
+//
+// https://developer.okta.com/docs/reference/api/domains/#domain-response-object
+type Domain struct {
+	RawJSON
+	ID     string `json:"id,omitempty"`
+	Domain string `json:"domain"`
+	// CertificateSourceType has possible values of: "MANUAL", "OKTA_MANAGED"
+	CertificateSourceType string `json:"certificateSourceType,omitempty"`
+	// ValidationStatus has possible values of: "NOT_STARTED", "IN_PROGRESS", "VERIFIED", "COMPLETED", "FAILED_TO_VERIFY", "DOMAIN_TAKEN"
+	ValidationStatus  string                   `json:"validationStatus,omitempty"`
+	DNSRecords        []DomainDNSRecord        `json:"dnsRecords,omitempty"`
+	PublicCertificate *DomainPublicCertificate `json:"publicCertificate,omitempty"`
+	Links             interface{}              `json:"_links,omitempty"`
+}
+
+// DomainDNSRecord represents a DNS record that must be provisioned to verify a custom domain.
+//
+// https://developer.okta.com/docs/reference/api/domains/#dns-record-object
+type DomainDNSRecord struct {
+	FQDN string `json:"fqdn"`
+	// RecordType has possible values of: "TXT", "CNAME"
+	RecordType string    `json:"recordType"`
+	Values     []string  `json:"values"`
+	Expiration Timestamp `json:"expiration,omitempty"`
+}
+
+// DomainPublicCertificate represents the TLS certificate installed for a custom domain.
+//
+// https://developer.okta.com/docs/reference/api/domains/#public-certificate-object
+type DomainPublicCertificate struct {
+	Subject     string    `json:"subject"`
+	Fingerprint string    `json:"fingerprint"`
+	Expiration  Timestamp `json:"expiration"`
+}
+
+// DomainCertificate is the PEM encoded TLS certificate, chain and private key uploaded for a custom domain.
+//
+// https://developer.okta.com/docs/reference/api/domains/#certificate-object
+type DomainCertificate struct {
+	Type             string `json:"type"`
+	Certificate      string `json:"certificate"`
+	CertificateChain string `json:"certificateChain"`
+	PrivateKey       string `json:"privateKey"`
+}
@@ -0,0 +1,111 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrVerificationFailed is wrapped by the error returned when the verification of a domain fails
+// for good, e.g. a custom domain taken by another org.
+var ErrVerificationFailed = errors.New("okta: domain verification failed")
+
+// VerificationWaitParams configures the polling of WaitVerified().
+type VerificationWaitParams struct {
+	// Interval is the wait before the second poll, doubled for every following poll. Defaults to
+	// 15 seconds.
+	Interval time.Duration
+	// MaxInterval caps the wait between polls. Defaults to 5 minutes.
+	MaxInterval time.Duration
+}
+
+// wait waits before the poll number poll, from 1.
+func (p *VerificationWaitParams) wait(ctx context.Context, client *Client, poll int) error {
+	interval, maxInterval := 15*time.Second, 5*time.Minute
+	if p != nil && p.Interval > 0 {
+		interval = p.Interval
+	}
+	if p != nil && p.MaxInterval > 0 {
+		maxInterval = p.MaxInterval
+	}
+
+	wait := interval << (poll - 1)
+	if wait <= 0 || wait > maxInterval {
+		wait = maxInterval
+	}
+	return client.sleepUntil(ctx, client.now().Add(wait))
+}
+
+// WaitVerified verifies the DNS records of a custom domain until they are, polling with backoff,
+// e.g. while the records propagate, and returns the verified domain. Bound the wait with the
+// deadline of ctx; the last state of the domain is returned alongside the error of ctx, or the
+// error of a failed verification, which wraps ErrVerificationFailed.
+//
+// A domain is verified once its status is VERIFIED, or COMPLETED when its certificate is issued
+// too; its certificate is then still to be uploaded, for the MANUAL certificate source type.
+//
+// https://developer.okta.com/docs/reference/api/domains/#verify-domain
+func (s *DomainsService) WaitVerified(ctx context.Context, id string, params *VerificationWaitParams) (*Domain, error) {
+	var domain *Domain
+	for poll := 0; ; poll++ {
+		if poll > 0 {
+			if err := params.wait(ctx, s.client, poll); err != nil {
+				return domain, err
+			}
+		}
+
+		next, _, err := s.Verify(ctx, id)
+		if err != nil {
+			return domain, err
+		}
+		domain = next
+
+		switch domain.ValidationStatus {
+		case "VERIFIED", "COMPLETED":
+			return domain, nil
+		case "DOMAIN_TAKEN":
+			return domain, fmt.Errorf("%w: %s is %s", ErrVerificationFailed, domain.Domain, domain.ValidationStatus)
+		}
+	}
+}
+
+// WaitVerified verifies the DNS records of an email domain until they are, polling with backoff,
+// e.g. while the records propagate, and returns the verified email domain. Bound the wait with
+// the deadline of ctx; the last state of the email domain is returned alongside the error of ctx,
+// or the error of a failed verification, which wraps ErrVerificationFailed.
+//
+// Okta checks the records of a domain whose status is POLLING on its own; the verification is
+// only started again when it's NOT_STARTED, or in ERROR.
+//
+// https://developer.okta.com/docs/reference/api/email-domains/#verify-email-domain
+func (s *EmailDomainsService) WaitVerified(ctx context.Context, id string, params *VerificationWaitParams) (*EmailDomain, error) {
+	emailDomain, _, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for poll := 0; ; poll++ {
+		if poll > 0 {
+			if err := params.wait(ctx, s.client, poll); err != nil {
+				return emailDomain, err
+			}
+		}
+
+		var next *EmailDomain
+		switch emailDomain.ValidationStatus {
+		case "VERIFIED":
+			return emailDomain, nil
+		case "DELETED":
+			return emailDomain, fmt.Errorf("%w: %s is %s", ErrVerificationFailed, emailDomain.Domain, emailDomain.ValidationStatus)
+		case "POLLING":
+			next, _, err = s.GetByID(ctx, id)
+		default:
+			next, _, err = s.Verify(ctx, id)
+		}
+		if err != nil {
+			return emailDomain, err
+		}
+		emailDomain = next
+	}
+}
@@ -0,0 +1,111 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// DomainsService is the service providing access to the Domains Resource in the Okta API
+type DomainsService service
+
+// List fetches all custom domains of the org.
+//
+// https://developer.okta.com/docs/reference/api/domains/#list-domains
+func (s *DomainsService) List(ctx context.Context) ([]*Domain, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "domains"
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var page struct {
+		Domains []*Domain `json:"domains"`
+	}
+	resp, err := s.client.Do(ctx, req, &page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return page.Domains, resp, nil
+}
+
+// GetByID fetches a custom domain by ID, including the DNS records needed to verify it.
+//
+// https://developer.okta.com/docs/reference/api/domains/#get-domain
+func (s *DomainsService) GetByID(ctx context.Context, id string) (*Domain, *Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("domains/%s", id), nil)
+}
+
+// Add creates a new custom domain. The returned Domain contains the DNS records that must
+// be provisioned before calling Verify().
+//
+// https://developer.okta.com/docs/reference/api/domains/#create-domain
+func (s *DomainsService) Add(ctx context.Context, domain string, certificateSourceType string) (*Domain, *Response, error) {
+	body := map[string]interface{}{
+		"domain":                domain,
+		"certificateSourceType": certificateSourceType,
+	}
+	return s.do(ctx, "POST", "domains", body)
+}
+
+// Verify starts the verification of the DNS records of a custom domain.
+//
+// https://developer.okta.com/docs/reference/api/domains/#verify-domain
+func (s *DomainsService) Verify(ctx context.Context, id string) (*Domain, *Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("domains/%s/verify", id), nil)
+}
+
+// Remove deletes a custom domain.
+//
+// https://developer.okta.com/docs/reference/api/domains/#delete-domain
+func (s *DomainsService) Remove(ctx context.Context, id string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("domains/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// UploadCertificate uploads or replaces the TLS certificate of a custom domain that uses the MANUAL
+// certificate source type.
+//
+// https://developer.okta.com/docs/reference/api/domains/#create-certificate
+func (s *DomainsService) UploadCertificate(ctx context.Context, id string, cert *DomainCertificate) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("domains/%s/certificate", id)
+
+	certCopy := *cert
+	if certCopy.Type == "" {
+		certCopy.Type = "PEM"
+	}
+
+	req, err := s.client.NewRequest("PUT", path, &certCopy)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// do is a helper function for the single domain operations.
+func (s *DomainsService) do(ctx context.Context, method string, path string, body interface{}) (*Domain, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	domainOut := new(Domain)
+	resp, err := s.client.Do(ctx, req, domainOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return domainOut, resp, nil
+}
@@ -0,0 +1,29 @@
+package okta
+
+// EmailDomain represents a custom sender domain used for the emails Okta sends on behalf of a brand.
+//
+// https://developer.okta.com/docs/reference/api/email-domains/#email-domain-response-object
+type EmailDomain struct {
+	RawJSON
+	ID          string `json:"id,omitempty"`
+	BrandID     string `json:"brandId,omitempty"`
+	Domain      string `json:"domain"`
+	DisplayName string `json:"displayName"`
+	UserName    string `json:"userName"`
+	// ValidationStatus has possible values of: "NOT_STARTED", "POLLING", "VERIFIED", "ERROR", "DELETED"
+	ValidationStatus     string                 `json:"validationStatus,omitempty"`
+	DNSValidationRecords []EmailDomainDNSRecord `json:"dnsValidationRecords,omitempty"`
+	Links                interface{}            `json:"_links,omitempty"`
+}
+
+// EmailDomainDNSRecord represents a DKIM, SPF or verification record that must be provisioned
+// to verify an email domain.
+//
+// https://developer.okta.com/docs/reference/api/email-domains/#dns-record-object
+type EmailDomainDNSRecord struct {
+	FQDN string `json:"fqdn"`
+	// RecordType has possible values of: "TXT", "CNAME"
+	RecordType        string    `json:"recordType"`
+	VerificationValue string    `json:"verificationValue"`
+	Expiration        Timestamp `json:"expiration,omitempty"`
+}
@@ -0,0 +1,95 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// EmailDomainsService is the service providing access to the Email Domains Resource in the Okta API
+type EmailDomainsService service
+
+// List fetches all email domains of the org.
+//
+// https://developer.okta.com/docs/reference/api/email-domains/#list-email-domains
+func (s *EmailDomainsService) List(ctx context.Context) ([]*EmailDomain, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "email-domains"
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var emailDomains []*EmailDomain
+	resp, err := s.client.Do(ctx, req, &emailDomains)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return emailDomains, resp, nil
+}
+
+// GetByID fetches an email domain by ID, including the DNS records needed to verify it.
+//
+// https://developer.okta.com/docs/reference/api/email-domains/#get-email-domain
+func (s *EmailDomainsService) GetByID(ctx context.Context, id string) (*EmailDomain, *Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("email-domains/%s", id), nil)
+}
+
+// Add creates a new email domain for a brand. The returned EmailDomain contains the DKIM and SPF
+// records that must be provisioned in DNS before calling Verify().
+//
+// https://developer.okta.com/docs/reference/api/email-domains/#create-email-domain
+func (s *EmailDomainsService) Add(ctx context.Context, emailDomainIn *EmailDomain) (*EmailDomain, *Response, error) {
+	return s.do(ctx, "POST", "email-domains", emailDomainIn)
+}
+
+// Update modifies the display name and user name of an email domain.
+//
+// https://developer.okta.com/docs/reference/api/email-domains/#update-email-domain
+func (s *EmailDomainsService) Update(ctx context.Context, id string, emailDomainIn *EmailDomain) (*EmailDomain, *Response, error) {
+	body := map[string]interface{}{
+		"displayName": emailDomainIn.DisplayName,
+		"userName":    emailDomainIn.UserName,
+	}
+	return s.do(ctx, "PUT", fmt.Sprintf("email-domains/%s", id), body)
+}
+
+// Verify starts the verification of the DNS records of an email domain.
+//
+// https://developer.okta.com/docs/reference/api/email-domains/#verify-email-domain
+func (s *EmailDomainsService) Verify(ctx context.Context, id string) (*EmailDomain, *Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("email-domains/%s/verify", id), nil)
+}
+
+// Remove deletes an email domain.
+//
+// https://developer.okta.com/docs/reference/api/email-domains/#delete-email-domain
+func (s *EmailDomainsService) Remove(ctx context.Context, id string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("email-domains/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// do is a helper function for the single email domain operations.
+func (s *EmailDomainsService) do(ctx context.Context, method string, path string, body interface{}) (*EmailDomain, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	emailDomainOut := new(EmailDomain)
+	resp, err := s.client.Do(ctx, req, emailDomainOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return emailDomainOut, resp, nil
+}
@@ -0,0 +1,34 @@
+package okta
+
+// EmailTemplate represents one of the email templates of a brand, e.g. "UserActivation".
+//
+// https://developer.okta.com/docs/reference/api/brands/#email-template
+type EmailTemplate struct {
+	RawJSON
+	Name  string      `json:"name"`
+	Links interface{} `json:"_links,omitempty"`
+}
+
+// EmailCustomization represents the customization of an email template for one language.
+//
+// https://developer.okta.com/docs/reference/api/brands/#email-customization
+type EmailCustomization struct {
+	RawJSON
+	ID          string      `json:"id,omitempty"`
+	Language    string      `json:"language"`
+	Subject     string      `json:"subject"`
+	Body        string      `json:"body"`
+	IsDefault   bool        `json:"isDefault"`
+	Created     Timestamp   `json:"created,omitempty"`
+	LastUpdated Timestamp   `json:"lastUpdated,omitempty"`
+	Links       interface{} `json:"_links,omitempty"`
+}
+
+// EmailPreview represents an email template rendered for the current user.
+//
+// https://developer.okta.com/docs/reference/api/brands/#email-preview
+type EmailPreview struct {
+	Subject string      `json:"subject"`
+	Body    string      `json:"body"`
+	Links   interface{} `json:"_links,omitempty"`
+}
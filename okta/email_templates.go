@@ -0,0 +1,168 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// EmailTemplatesService is the service providing access to the Email Templates of a brand in the Okta API
+type EmailTemplatesService service
+
+// List fetches the first page of the email templates of a brand.
+//
+// https://developer.okta.com/docs/reference/api/brands/#list-email-templates
+func (s *EmailTemplatesService) List(ctx context.Context, brandID string) (*Collection[*EmailTemplate], *Response, error) {
+	path := fmt.Sprintf("brands/%s/templates/email?limit=%d", brandID, 200)
+	return listPage[*EmailTemplate](ctx, s.client, RateLimitCategoryCore, path)
+}
+
+// ListCustomizations fetches the customizations of an email template.
+//
+// https://developer.okta.com/docs/reference/api/brands/#list-email-customizations
+func (s *EmailTemplatesService) ListCustomizations(ctx context.Context, brandID string, templateName string) ([]*EmailCustomization, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("brands/%s/templates/email/%s/customizations", brandID, templateName)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var customizations []*EmailCustomization
+	resp, err := s.client.Do(ctx, req, &customizations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return customizations, resp, nil
+}
+
+// GetCustomization fetches a customization of an email template.
+//
+// https://developer.okta.com/docs/reference/api/brands/#get-email-customization
+func (s *EmailTemplatesService) GetCustomization(ctx context.Context, brandID string, templateName string, customizationID string) (*EmailCustomization, *Response, error) {
+	path := fmt.Sprintf("brands/%s/templates/email/%s/customizations/%s", brandID, templateName, customizationID)
+	return s.doCustomization(ctx, "GET", path, nil)
+}
+
+// AddCustomization creates a customization of an email template for a language.
+//
+// https://developer.okta.com/docs/reference/api/brands/#create-email-customization
+func (s *EmailTemplatesService) AddCustomization(ctx context.Context, brandID string, templateName string, customizationIn *EmailCustomization) (*EmailCustomization, *Response, error) {
+	path := fmt.Sprintf("brands/%s/templates/email/%s/customizations", brandID, templateName)
+	return s.doCustomization(ctx, "POST", path, customizationIn)
+}
+
+// UpdateCustomization modifies a customization of an email template.
+//
+// Note that delta updates are not supported. You must pass a full EmailCustomization object.
+//
+// https://developer.okta.com/docs/reference/api/brands/#update-email-customization
+func (s *EmailTemplatesService) UpdateCustomization(ctx context.Context, brandID string, templateName string, customizationID string, customizationIn *EmailCustomization) (*EmailCustomization, *Response, error) {
+	path := fmt.Sprintf("brands/%s/templates/email/%s/customizations/%s", brandID, templateName, customizationID)
+	return s.doCustomization(ctx, "PUT", path, customizationIn)
+}
+
+// RemoveCustomization deletes a customization of an email template.
+//
+// https://developer.okta.com/docs/reference/api/brands/#delete-email-customization
+func (s *EmailTemplatesService) RemoveCustomization(ctx context.Context, brandID string, templateName string, customizationID string) (*Response, error) {
+	path := fmt.Sprintf("brands/%s/templates/email/%s/customizations/%s", brandID, templateName, customizationID)
+	return s.delete(ctx, path)
+}
+
+// RemoveCustomizations deletes all customizations of an email template.
+//
+// https://developer.okta.com/docs/reference/api/brands/#delete-all-email-customizations
+func (s *EmailTemplatesService) RemoveCustomizations(ctx context.Context, brandID string, templateName string) (*Response, error) {
+	path := fmt.Sprintf("brands/%s/templates/email/%s/customizations", brandID, templateName)
+	return s.delete(ctx, path)
+}
+
+// doCustomization is a helper function for the single customization operations.
+func (s *EmailTemplatesService) doCustomization(ctx context.Context, method string, path string, customizationIn *EmailCustomization) (*EmailCustomization, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if customizationIn != nil {
+		body = customizationIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	customizationOut := new(EmailCustomization)
+	resp, err := s.client.Do(ctx, req, customizationOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return customizationOut, resp, nil
+}
+
+// delete is a helper function for the delete operations.
+func (s *EmailTemplatesService) delete(ctx context.Context, path string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// PreviewCustomization renders a customization of an email template for the current user.
+//
+// https://developer.okta.com/docs/reference/api/brands/#preview-email-customization
+func (s *EmailTemplatesService) PreviewCustomization(ctx context.Context, brandID string, templateName string, customizationID string) (*EmailPreview, *Response, error) {
+	path := fmt.Sprintf("brands/%s/templates/email/%s/customizations/%s/preview", brandID, templateName, customizationID)
+	return s.preview(ctx, path)
+}
+
+// PreviewDefaultContent renders the default content of an email template for the current user.
+//
+// https://developer.okta.com/docs/reference/api/brands/#preview-email-template-default-content
+func (s *EmailTemplatesService) PreviewDefaultContent(ctx context.Context, brandID string, templateName string) (*EmailPreview, *Response, error) {
+	path := fmt.Sprintf("brands/%s/templates/email/%s/default-content/preview", brandID, templateName)
+	return s.preview(ctx, path)
+}
+
+// preview is a helper function for the preview operations.
+func (s *EmailTemplatesService) preview(ctx context.Context, path string) (*EmailPreview, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	previewOut := new(EmailPreview)
+	resp, err := s.client.Do(ctx, req, previewOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return previewOut, resp, nil
+}
+
+// SendTestEmail sends a test of an email template to the current user. The customization for
+// language is used if it exists, otherwise the default customization or default content is used.
+//
+// https://developer.okta.com/docs/reference/api/brands/#send-test-email
+func (s *EmailTemplatesService) SendTestEmail(ctx context.Context, brandID string, templateName string, language string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("brands/%s/templates/email/%s/test", brandID, templateName)
+
+	var body interface{}
+	if language != "" {
+		body = map[string]interface{}{"language": language}
+	}
+
+	req, err := s.client.NewRequest("POST", path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
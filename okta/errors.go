@@ -3,6 +3,7 @@ package okta
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -22,26 +23,69 @@ func (r *ErrorResponse) Error() string {
 		r.Response.StatusCode, r.Code, r.Summary, r.ID, r.Causes)
 }
 
+// FieldErrors returns the messages of the causes of the error by the property they are about, e.g.
+// "login" or "profile.email", so that a form can show which attribute was rejected. The causes not
+// about a property are under "".
+func (r *ErrorResponse) FieldErrors() map[string][]string {
+	fields := make(map[string][]string)
+	for _, cause := range r.Causes {
+		field, message := cause.Field()
+		fields[field] = append(fields[field], message)
+	}
+	return fields
+}
+
 // ErrorCause represents on cause for an error
 type ErrorCause struct {
 	Summary string `json:"errorSummary"`
+	// Reason is the kind of the cause, e.g. UNIQUE_CONSTRAINT.
+	Reason string `json:"reason,omitempty"`
+	// LocationType, Location and Domain tell where the cause is, e.g. a Location of "login" in the
+	// "body" LocationType for a property of the request body.
+	LocationType string `json:"locationType,omitempty"`
+	Location     string `json:"location,omitempty"`
+	Domain       string `json:"domain,omitempty"`
 }
 
 func (e *ErrorCause) Error() string {
 	return fmt.Sprintf("%s", e.Summary)
 }
 
+// Field returns the property the cause is about, or an empty string, and the message of the cause.
+// Okta doesn't always set Location; the property is then read from the summary of the validation
+// errors, e.g. "login: An object with this field already exists in the current organization".
+func (e *ErrorCause) Field() (field string, message string) {
+	field, message = e.Location, e.Summary
+	prefix, rest, found := strings.Cut(e.Summary, ": ")
+	if found && (field == "" || prefix == field) && prefix != "" && !strings.ContainsAny(prefix, " \t") {
+		field, message = prefix, rest
+	}
+	return field, message
+}
+
 // RateLimitError represents an error when RateLimits are exceeded.
 type RateLimitError struct {
 	Rate     Rate           // Rate specifies last known rate limit for the client
 	Response *http.Response // HTTP response that caused this error
 	Message  string         `json:"message"` // error message
+	// Category is the rate limit bucket that was exhausted, e.g. "users-get-by-id" once printed.
+	Category RateLimitCategory
+
+	clock Clock
 }
 
 func (r *RateLimitError) Error() string {
-	return fmt.Sprintf("%v %v: %d %v %v",
+	return fmt.Sprintf("%v %v: %d %v %v [category %v]",
 		r.Response.Request.Method, r.Response.Request.URL,
-		r.Response.StatusCode, r.Message, formatRateReset(r.Rate.Reset.Sub(time.Now())))
+		r.Response.StatusCode, r.Message, formatRateReset(r.Rate.Reset.Sub(r.now())), r.Category)
+}
+
+// now returns the current time of the Clock of the client which returned the error.
+func (r *RateLimitError) now() time.Time {
+	if r.clock == nil {
+		return time.Now()
+	}
+	return r.clock.Now()
 }
 
 // formatRateReset formats d to look like "[rate reset in 2s]" or
@@ -0,0 +1,113 @@
+package okta
+
+// EventHookRequest represents a delivery of events by Okta to an event hook. The events have the
+// same shape as the events of the System Log.
+//
+// https://developer.okta.com/docs/concepts/event-hooks/#sample-event-delivery-payload
+type EventHookRequest struct {
+	EventType          string    `json:"eventType"`
+	EventTypeVersion   string    `json:"eventTypeVersion"`
+	CloudEventsVersion string    `json:"cloudEventsVersion"`
+	Source             string    `json:"source"`
+	EventID            string    `json:"eventId"`
+	EventTime          Timestamp `json:"eventTime"`
+	ContentType        string    `json:"contentType"`
+	Data               struct {
+		Events []*LogEvent `json:"events"`
+	} `json:"data"`
+}
+
+// EventHookVerificationResponse represents the response to the one time verification request
+// Okta sends to an event hook.
+//
+// https://developer.okta.com/docs/concepts/event-hooks/#one-time-verification-request
+type EventHookVerificationResponse struct {
+	Verification string `json:"verification"`
+}
+
+// Event hook header Constants
+const (
+	// EventHookVerificationHeader carries the challenge of the one time verification request.
+	EventHookVerificationHeader = "X-Okta-Verification-Challenge"
+)
+
+// EventHook represents an event hook, which delivers events of the types it subscribes to to an
+// external service.
+//
+// https://developer.okta.com/docs/reference/api/event-hooks/#event-hook-object
+type EventHook struct {
+	RawJSON
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+	// Status has possible values of: ACTIVE, INACTIVE
+	Status string `json:"status,omitempty"`
+	// VerificationStatus has possible values of: UNVERIFIED, VERIFIED
+	VerificationStatus string           `json:"verificationStatus,omitempty"`
+	Events             EventHookEvents  `json:"events"`
+	Channel            EventHookChannel `json:"channel"`
+	Created            Timestamp        `json:"created,omitempty"`
+	LastUpdated        Timestamp        `json:"lastUpdated,omitempty"`
+	Links              interface{}      `json:"_links,omitempty"`
+}
+
+// EventHookEvents represents the events an event hook subscribes to.
+//
+// https://developer.okta.com/docs/reference/api/event-hooks/#events-object
+type EventHookEvents struct {
+	// Type has possible values of: EVENT_TYPE
+	Type  string   `json:"type"`
+	Items []string `json:"items"`
+}
+
+// EventHookChannel represents the external service an event hook delivers to.
+//
+// https://developer.okta.com/docs/reference/api/event-hooks/#channel-object
+type EventHookChannel struct {
+	// Type has possible values of: HTTP
+	Type    string                 `json:"type"`
+	Version string                 `json:"version"`
+	Config  EventHookChannelConfig `json:"config"`
+}
+
+// EventHookChannelConfig represents the endpoint and the authentication of an event hook channel.
+//
+// https://developer.okta.com/docs/reference/api/event-hooks/#config-object
+type EventHookChannelConfig struct {
+	URI        string               `json:"uri"`
+	Headers    []*EventHookHeader   `json:"headers,omitempty"`
+	AuthScheme *EventHookAuthScheme `json:"authScheme,omitempty"`
+}
+
+// EventHookHeader represents a custom header sent with every delivery of an event hook.
+type EventHookHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// EventHookAuthScheme represents the header authenticating the deliveries of an event hook. Okta
+// never returns Value.
+//
+// https://developer.okta.com/docs/reference/api/event-hooks/#auth-scheme-object
+type EventHookAuthScheme struct {
+	// Type has possible values of: HEADER
+	Type  string `json:"type"`
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
+
+// NewEventHook is a helper method to create a new EventHook delivering events of eventTypes to
+// uri, authenticated by the Authorization header with value authorization.
+func NewEventHook(name string, uri string, authorization string, eventTypes ...string) *EventHook {
+	return &EventHook{
+		Name:   name,
+		Events: EventHookEvents{Type: "EVENT_TYPE", Items: eventTypes},
+		Channel: EventHookChannel{
+			Type:    "HTTP",
+			Version: "1.0.0",
+			Config: EventHookChannelConfig{
+				URI:        uri,
+				AuthScheme: &EventHookAuthScheme{Type: "HEADER", Key: "Authorization", Value: authorization},
+			},
+		},
+	}
+}
@@ -0,0 +1,147 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// EventHooksService is the service providing access to the Event Hooks Resource in the Okta API
+type EventHooksService service
+
+// List fetches the event hooks.
+//
+// https://developer.okta.com/docs/reference/api/event-hooks/#list-event-hooks
+func (s *EventHooksService) List(ctx context.Context) ([]*EventHook, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	req, err := s.client.NewRequest("GET", "eventHooks", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var hooks []*EventHook
+	resp, err := s.client.Do(ctx, req, &hooks)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return hooks, resp, nil
+}
+
+// GetByID fetches an event hook by ID.
+//
+// https://developer.okta.com/docs/reference/api/event-hooks/#get-event-hook
+func (s *EventHooksService) GetByID(ctx context.Context, id string) (*EventHook, *Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("eventHooks/%s", id), nil)
+}
+
+// Add creates a new event hook, see NewEventHook(). It must be verified, see Verify(), before
+// Okta delivers events to it.
+//
+// https://developer.okta.com/docs/reference/api/event-hooks/#create-event-hook
+func (s *EventHooksService) Add(ctx context.Context, hookIn *EventHook) (*EventHook, *Response, error) {
+	return s.do(ctx, "POST", "eventHooks", hookIn)
+}
+
+// Update modifies an event hook.
+//
+// Note that delta updates are not supported. You must pass a full EventHook object, including the
+// value of its authorization scheme, which Okta doesn't return.
+//
+// https://developer.okta.com/docs/reference/api/event-hooks/#update-event-hook
+func (s *EventHooksService) Update(ctx context.Context, id string, hookIn *EventHook) (*EventHook, *Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("eventHooks/%s", id), hookIn)
+}
+
+// Remove deletes an inactive event hook.
+//
+// https://developer.okta.com/docs/reference/api/event-hooks/#delete-event-hook
+func (s *EventHooksService) Remove(ctx context.Context, id string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("eventHooks/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Activate activates an event hook.
+//
+// https://developer.okta.com/docs/reference/api/event-hooks/#activate-event-hook
+func (s *EventHooksService) Activate(ctx context.Context, id string) (*EventHook, *Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("eventHooks/%s/lifecycle/activate", id), nil)
+}
+
+// Deactivate deactivates an event hook.
+//
+// https://developer.okta.com/docs/reference/api/event-hooks/#deactivate-event-hook
+func (s *EventHooksService) Deactivate(ctx context.Context, id string) (*EventHook, *Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("eventHooks/%s/lifecycle/deactivate", id), nil)
+}
+
+// Verify makes Okta send the one time verification request to the endpoint of an event hook.
+//
+// https://developer.okta.com/docs/reference/api/event-hooks/#verify-event-hook
+func (s *EventHooksService) Verify(ctx context.Context, id string) (*EventHook, *Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("eventHooks/%s/lifecycle/verify", id), nil)
+}
+
+// RotateSecret replaces the value of the authorization header of an event hook with value, and
+// stages value in secret, the receiving side: secret accepts both values once the hook is
+// updated, or only the previous value again if the update fails. Okta may still deliver events
+// signed with the previous value for a while after the update, so the rotation is left to the
+// caller to complete, with secret.Promote() after a grace period:
+//
+//	hook, _, err := client.EventHooks.RotateSecret(ctx, id, secret, value)
+//	if err != nil {
+//		return err
+//	}
+//	time.AfterFunc(10*time.Minute, secret.Promote)
+//
+// Deliveries are never rejected in between.
+func (s *EventHooksService) RotateSecret(ctx context.Context, id string, secret *HookSecret, value string) (*EventHook, *Response, error) {
+	hook, resp, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+	if hook.Channel.Config.AuthScheme == nil {
+		return nil, resp, errors.New("event hook has no authorization scheme to rotate")
+	}
+
+	secret.Stage(value)
+	hook.Channel.Config.AuthScheme.Value = value
+	hookOut, resp, err := s.Update(ctx, id, hook)
+	if err != nil {
+		secret.Abort()
+		return nil, resp, err
+	}
+
+	return hookOut, resp, nil
+}
+
+// do is a helper function for the single event hook operations.
+func (s *EventHooksService) do(ctx context.Context, method string, path string, hookIn *EventHook) (*EventHook, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if hookIn != nil {
+		body = hookIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hookOut := new(EventHook)
+	resp, err := s.client.Do(ctx, req, hookOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return hookOut, resp, nil
+}
@@ -0,0 +1,317 @@
+package okta
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// FactorType is a type for the FactorType enum.
+//
+// https://developer.okta.com/docs/reference/api/factors/#factor-type
+type FactorType string
+
+// FactorType Constants
+//
+// https://developer.okta.com/docs/reference/api/factors/#factor-type
+const (
+	FactorTypeCall          FactorType = "call"
+	FactorTypeEmail                    = "email"
+	FactorTypeHOTP                     = "token:hotp"
+	FactorTypePush                     = "push"
+	FactorTypeQuestion                 = "question"
+	FactorTypeSMS                      = "sms"
+	FactorTypeToken                    = "token"
+	FactorTypeTokenHardware            = "token:hardware"
+	FactorTypeTOTP                     = "token:software:totp"
+	FactorTypeU2F                      = "u2f"
+	FactorTypeWeb                      = "web"
+	FactorTypeWebAuthn                 = "webauthn"
+)
+
+// FactorTypes is the list of the valid FactorType values.
+var FactorTypes = []FactorType{
+	FactorTypeCall,
+	FactorTypeEmail,
+	FactorTypeHOTP,
+	FactorTypePush,
+	FactorTypeQuestion,
+	FactorTypeSMS,
+	FactorTypeToken,
+	FactorTypeTokenHardware,
+	FactorTypeTOTP,
+	FactorTypeU2F,
+	FactorTypeWeb,
+	FactorTypeWebAuthn,
+}
+
+// Valid reports whether t is a known factor type.
+func (t FactorType) Valid() bool {
+	for _, v := range FactorTypes {
+		if t == v {
+			return true
+		}
+	}
+	return false
+}
+
+// FactorProvider is a type for the FactorProvider enum.
+//
+// https://developer.okta.com/docs/reference/api/factors/#provider-type
+type FactorProvider string
+
+// FactorProvider Constants
+//
+// https://developer.okta.com/docs/reference/api/factors/#provider-type
+const (
+	FactorProviderCustom   FactorProvider = "CUSTOM"
+	FactorProviderDuo                     = "DUO"
+	FactorProviderFIDO                    = "FIDO"
+	FactorProviderGoogle                  = "GOOGLE"
+	FactorProviderOkta                    = "OKTA"
+	FactorProviderRSA                     = "RSA"
+	FactorProviderSymantec                = "SYMANTEC"
+	FactorProviderYubico                  = "YUBICO"
+)
+
+// FactorProviders is the list of the valid FactorProvider values.
+var FactorProviders = []FactorProvider{
+	FactorProviderCustom,
+	FactorProviderDuo,
+	FactorProviderFIDO,
+	FactorProviderGoogle,
+	FactorProviderOkta,
+	FactorProviderRSA,
+	FactorProviderSymantec,
+	FactorProviderYubico,
+}
+
+// Valid reports whether p is a known factor provider.
+func (p FactorProvider) Valid() bool {
+	for _, v := range FactorProviders {
+		if p == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Factor represents a factor enrolled by a user.
+//
+// https://developer.okta.com/docs/reference/api/factors/#factor-object
+type Factor struct {
+	RawJSON
+	ID         string         `json:"id,omitempty"`
+	FactorType FactorType     `json:"factorType"`
+	Provider   FactorProvider `json:"provider"`
+	VendorName string         `json:"vendorName,omitempty"`
+	// Status has possible values of: NOT_SETUP, PENDING_ACTIVATION, ENROLLED, ACTIVE, INACTIVE, EXPIRED
+	Status      string          `json:"status,omitempty"`
+	Created     Timestamp       `json:"created,omitempty"`
+	LastUpdated Timestamp       `json:"lastUpdated,omitempty"`
+	Profile     *FactorProfile  `json:"profile,omitempty"`
+	Embedded    *FactorEmbedded `json:"_embedded,omitempty"`
+	Links       Links           `json:"_links,omitempty"`
+}
+
+// FactorProfile represents the profile of a factor, whose attributes depend on the factor type.
+//
+// https://developer.okta.com/docs/reference/api/factors/#factor-profile-object
+type FactorProfile struct {
+	// CredentialID is the ID of the credential of the user, e.g. the login for token factors.
+	CredentialID string `json:"credentialId,omitempty"`
+	// PhoneNumber is the phone number of the call and sms factors.
+	PhoneNumber string `json:"phoneNumber,omitempty"`
+	// PhoneExtension is the extension of the phone number of the call factor.
+	PhoneExtension string `json:"phoneExtension,omitempty"`
+	// Email is the email address of the email factor.
+	Email string `json:"email,omitempty"`
+	// Question and Answer are those of the security question factor. Okta never returns the answer.
+	Question     string `json:"question,omitempty"`
+	QuestionText string `json:"questionText,omitempty"`
+	Answer       string `json:"answer,omitempty"`
+	// DeviceType, Name, Platform and Version describe the device of the push factor.
+	DeviceType string `json:"deviceType,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Platform   string `json:"platform,omitempty"`
+	Version    string `json:"version,omitempty"`
+	// AuthenticatorName is the name of the authenticator of the webauthn factor.
+	AuthenticatorName string `json:"authenticatorName,omitempty"`
+}
+
+// FactorEmbedded represents the embedded resources of a factor.
+type FactorEmbedded struct {
+	// Activation is set on the factors returned by FactorsService.Enroll() that are pending
+	// activation.
+	Activation *FactorActivation `json:"activation,omitempty"`
+}
+
+// FactorActivation represents the activation object of a factor pending activation: what the user
+// needs to activate it, e.g. the shared secret and QR code of a TOTP or push factor.
+//
+// https://developer.okta.com/docs/reference/api/factors/#factor-activation-object
+type FactorActivation struct {
+	// TimeStep, SharedSecret, Encoding and KeyLength are the parameters of the TOTP factors.
+	TimeStep     int    `json:"timeStep,omitempty"`
+	SharedSecret string `json:"sharedSecret,omitempty"`
+	Encoding     string `json:"encoding,omitempty"`
+	KeyLength    int    `json:"keyLength,omitempty"`
+	// ExpiresAt and FactorResult are the state of the activation of a push factor.
+	ExpiresAt Timestamp `json:"expiresAt,omitempty"`
+	// FactorResult has possible values of: WAITING, TIMEOUT, REJECTED
+	FactorResult string `json:"factorResult,omitempty"`
+	// The remaining attributes are the credential creation options of a webauthn factor, to
+	// pass to navigator.credentials.create() in the browser. Challenge and User.ID are base64url
+	// encoded.
+	RP                     *WebAuthnEntity          `json:"rp,omitempty"`
+	User                   *WebAuthnUser            `json:"user,omitempty"`
+	PubKeyCredParams       []WebAuthnCredentialType `json:"pubKeyCredParams,omitempty"`
+	Challenge              string                   `json:"challenge,omitempty"`
+	Attestation            string                   `json:"attestation,omitempty"`
+	AuthenticatorSelection *WebAuthnSelection       `json:"authenticatorSelection,omitempty"`
+	ExcludeCredentials     []WebAuthnCredential     `json:"excludeCredentials,omitempty"`
+	Links                  Links                    `json:"_links,omitempty"`
+}
+
+// QRCode returns the link to the QR code image to scan with the authenticator app, and whether
+// there is one; FactorsService.QRCode() fetches the image.
+func (a *FactorActivation) QRCode() (*Link, bool) {
+	return a.Links.Get("qrcode")
+}
+
+// SendLinks returns the links that send the activation link of a push factor to the user by
+// email or sms, named after the channel.
+func (a *FactorActivation) SendLinks() []Link {
+	return a.Links["send"]
+}
+
+// KeyURI returns the otpauth:// URI of the shared secret of a TOTP factor, for which enrollment
+// UIs can render a QR code of their own. issuer is shown by the authenticator app along with
+// account, usually the login of the user.
+//
+// https://github.com/google/google-authenticator/wiki/Key-Uri-Format
+func (a *FactorActivation) KeyURI(issuer string, account string) string {
+	query := url.Values{}
+	query.Set("secret", a.SharedSecret)
+	query.Set("issuer", issuer)
+	query.Set("digits", "6")
+	if a.TimeStep != 0 {
+		query.Set("period", strconv.Itoa(a.TimeStep))
+	}
+	label := url.PathEscape(fmt.Sprintf("%s:%s", issuer, account))
+	return fmt.Sprintf("otpauth://totp/%s?%s", label, query.Encode())
+}
+
+// FactorEnrollParams represents the query parameters of FactorsService.Enroll().
+//
+// https://developer.okta.com/docs/reference/api/factors/#enroll-factor
+type FactorEnrollParams struct {
+	// Activate activates the factor right away, for the factors that support it, e.g. sms.
+	Activate bool
+	// UpdatePhone replaces the phone number of a sms factor already enrolled.
+	UpdatePhone bool
+	// TokenLifetimeSeconds is the lifetime of the activation code of sms and email factors.
+	TokenLifetimeSeconds int
+}
+
+// FactorActivationRequest represents the body of a factor activation request.
+//
+// https://developer.okta.com/docs/reference/api/factors/#activate-factor
+type FactorActivationRequest struct {
+	// PassCode is the code sent to, or generated by, the user.
+	PassCode string `json:"passCode,omitempty"`
+	// Attestation and ClientData are the base64url encoded attestation object and client data
+	// of the credential created for a webauthn factor, see NewWebAuthnActivation().
+	Attestation string `json:"attestation,omitempty"`
+	ClientData  string `json:"clientData,omitempty"`
+}
+
+// FactorVerifyRequest represents the body of a factor verification request.
+//
+// https://developer.okta.com/docs/reference/api/factors/#verify-factor
+type FactorVerifyRequest struct {
+	// PassCode is the code sent to, or generated by, the user; omit it to send a challenge to the
+	// call, sms, email and push factors.
+	PassCode string `json:"passCode,omitempty"`
+	// Answer is the answer to the security question factor.
+	Answer string `json:"answer,omitempty"`
+	// ClientData, AuthenticatorData and SignatureData are the base64url encoded assertion of a
+	// webauthn factor, see NewWebAuthnAssertion().
+	ClientData        string `json:"clientData,omitempty"`
+	AuthenticatorData string `json:"authenticatorData,omitempty"`
+	SignatureData     string `json:"signatureData,omitempty"`
+}
+
+// FactorVerification represents the result of a factor verification.
+//
+// https://developer.okta.com/docs/reference/api/factors/#factor-verify-result-object
+type FactorVerification struct {
+	// FactorResult has possible values of: SUCCESS, CHALLENGE, WAITING, FAILED, REJECTED, TIMEOUT,
+	// TIME_WINDOW_EXCEEDED, PASSCODE_REPLAYED, ERROR
+	FactorResult        string    `json:"factorResult"`
+	FactorResultMessage string    `json:"factorResultMessage,omitempty"`
+	ExpiresAt           Timestamp `json:"expiresAt,omitempty"`
+	Embedded            *struct {
+		// Challenge is the challenge of a webauthn factor, whose factor result is CHALLENGE.
+		Challenge *WebAuthnChallenge `json:"challenge,omitempty"`
+	} `json:"_embedded,omitempty"`
+	Links Links `json:"_links,omitempty"`
+}
+
+// UserVerification is a type for the UserVerification enum, whether a webauthn authenticator
+// must verify the user, e.g. with a PIN or biometrics.
+//
+// https://www.w3.org/TR/webauthn-2/#enumdef-userverificationrequirement
+type UserVerification string
+
+// UserVerification Constants
+const (
+	UserVerificationRequired    UserVerification = "required"
+	UserVerificationPreferred                    = "preferred"
+	UserVerificationDiscouraged                  = "discouraged"
+)
+
+// WebAuthnEntity represents the relying party of webauthn credential creation options.
+type WebAuthnEntity struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+// WebAuthnUser represents the user of webauthn credential creation options.
+type WebAuthnUser struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+// WebAuthnCredentialType represents a type of public key accepted by the relying party, e.g. an
+// alg of -7 for ES256.
+type WebAuthnCredentialType struct {
+	Type string `json:"type"`
+	Alg  int    `json:"alg"`
+}
+
+// WebAuthnSelection represents the authenticator selection criteria of webauthn credential
+// creation options.
+type WebAuthnSelection struct {
+	AuthenticatorAttachment string           `json:"authenticatorAttachment,omitempty"`
+	RequireResidentKey      bool             `json:"requireResidentKey,omitempty"`
+	UserVerification        UserVerification `json:"userVerification,omitempty"`
+}
+
+// WebAuthnCredential represents a credential already registered, which the authenticator must
+// not register again, or may use to sign a challenge.
+type WebAuthnCredential struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// WebAuthnChallenge represents the challenge of a webauthn factor verification, to pass to
+// navigator.credentials.get() in the browser. Challenge is base64url encoded.
+//
+// https://developer.okta.com/docs/reference/api/factors/#verify-webauthn-factor
+type WebAuthnChallenge struct {
+	Challenge        string                 `json:"challenge"`
+	UserVerification UserVerification       `json:"userVerification,omitempty"`
+	Extensions       map[string]interface{} `json:"extensions,omitempty"`
+}
@@ -0,0 +1,135 @@
+package okta
+
+import (
+	"context"
+	"sync"
+)
+
+// defaultFactorReportConcurrency is the number of users whose factors are fetched at once when
+// FactorReportParams.Concurrency isn't set.
+const defaultFactorReportConcurrency = 4
+
+// FactorReportParams represents the parameters of FactorsService.Report().
+type FactorReportParams struct {
+	// Users selects the users to check, e.g. with a filter of `status eq "ACTIVE"`. nil checks all
+	// the users, but the deprovisioned ones.
+	Users *UserListParams
+	// Required are the factor types every user must have enrolled and active.
+	Required []FactorType
+	// Concurrency is the number of users whose factors are fetched at once, 4 by default. Keep it
+	// low enough for the core rate limit of the org.
+	Concurrency int
+}
+
+// FactorReport represents the users lacking some of the required factor types, see
+// FactorsService.Report().
+type FactorReport struct {
+	// Checked is the number of users checked.
+	Checked int
+	// Users are the users lacking some of the required factor types, in the order they were listed.
+	Users []*FactorReportEntry
+	// Result records the outcome of fetching the factors of every user; items are user IDs. The
+	// users whose factors couldn't be fetched aren't in Users.
+	Result *BulkResult
+}
+
+// FactorReportEntry represents a user lacking some of the required factor types.
+type FactorReportEntry struct {
+	User *User
+	// Active are the types of the active factors of the user.
+	Active []FactorType
+	// Missing are the required factor types the user has no active factor of.
+	Missing []FactorType
+}
+
+// Report walks the users selected by params and their enrolled factors, and returns those lacking
+// an active factor of some of the required types, e.g. to reset their MFA or to chase them for
+// enrollment. The factors of several users are fetched concurrently.
+//
+// Failures to fetch the factors of a user are recorded in the Result of the report, and don't stop
+// it. If listing the users fails, or ctx is done, the factors of the users listed until then are
+// still checked, unless ctx is done, and the error is a *PartialResultError[*FactorReportEntry]
+// with the users found lacking factors.
+func (s *FactorsService) Report(ctx context.Context, params *FactorReportParams) (*FactorReport, error) {
+	if params == nil {
+		params = new(FactorReportParams)
+	}
+	page, _, err := s.client.Users.List(ctx, params.Users)
+	if err != nil {
+		return nil, err
+	}
+	users, _, listErr := page.All(ctx)
+	if listErr != nil {
+		users = partialItems[*User](listErr)
+	}
+
+	concurrency := params.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultFactorReportConcurrency
+	}
+
+	type outcome struct {
+		factors []*Factor
+		resp    *Response
+		err     error
+	}
+	outcomes := make([]outcome, len(users))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := ctx.Err(); err != nil {
+					outcomes[i] = outcome{err: err}
+					continue
+				}
+				factors, resp, err := s.List(ctx, users[i].ID)
+				outcomes[i] = outcome{factors, resp, err}
+			}
+		}()
+	}
+	for i := range users {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	report := &FactorReport{Checked: len(users), Result: new(BulkResult)}
+	for i, user := range users {
+		o := outcomes[i]
+		report.Result.record(user.ID, o.resp, o.err)
+		if o.err != nil {
+			continue
+		}
+		if entry := missingFactors(user, o.factors, params.Required); len(entry.Missing) > 0 {
+			report.Users = append(report.Users, entry)
+		}
+	}
+	if listErr != nil {
+		return nil, partialResult(report.Users, listErr)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, partialResult(report.Users, err)
+	}
+	return report, nil
+}
+
+// missingFactors returns the entry of user, whose factors are factors, in a FactorReport.
+func missingFactors(user *User, factors []*Factor, required []FactorType) *FactorReportEntry {
+	entry := &FactorReportEntry{User: user}
+	active := make(map[FactorType]bool)
+	for _, f := range factors {
+		if f.Status == "ACTIVE" && !active[f.FactorType] {
+			active[f.FactorType] = true
+			entry.Active = append(entry.Active, f.FactorType)
+		}
+	}
+	for _, factorType := range required {
+		if !active[factorType] {
+			entry.Missing = append(entry.Missing, factorType)
+		}
+	}
+	return entry
+}
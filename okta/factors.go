@@ -0,0 +1,170 @@
+package okta
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// FactorsService is the service providing access to the Factors Resource in the Okta API
+type FactorsService service
+
+// List fetches the factors enrolled by a user.
+//
+// https://developer.okta.com/docs/reference/api/factors/#list-enrolled-factors
+func (s *FactorsService) List(ctx context.Context, userID string) ([]*Factor, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("users/%s/factors", userID)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var factors []*Factor
+	resp, err := s.client.Do(ctx, req, &factors)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return factors, resp, nil
+}
+
+// GetByID fetches a factor of a user by ID.
+//
+// https://developer.okta.com/docs/reference/api/factors/#get-factor
+func (s *FactorsService) GetByID(ctx context.Context, userID string, factorID string) (*Factor, *Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("users/%s/factors/%s", userID, factorID), nil)
+}
+
+// Enroll enrolls a factor for a user. Factors that need to be activated are returned with the
+// status PENDING_ACTIVATION and their activation object in Embedded.Activation, e.g. the shared
+// secret and QR code of a TOTP or push factor.
+//
+// https://developer.okta.com/docs/reference/api/factors/#enroll-factor
+func (s *FactorsService) Enroll(ctx context.Context, userID string, factorIn *Factor, params *FactorEnrollParams) (*Factor, *Response, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Activate {
+			query.Set("activate", "true")
+		}
+		if params.UpdatePhone {
+			query.Set("updatePhone", "true")
+		}
+		if params.TokenLifetimeSeconds != 0 {
+			query.Set("tokenLifetimeSeconds", strconv.Itoa(params.TokenLifetimeSeconds))
+		}
+	}
+	path := fmt.Sprintf("users/%s/factors", userID)
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	return s.do(ctx, "POST", path, factorIn)
+}
+
+// Activate activates a factor pending activation with the code the user received or generated.
+//
+// https://developer.okta.com/docs/reference/api/factors/#activate-factor
+func (s *FactorsService) Activate(ctx context.Context, userID string, factorID string, activationIn *FactorActivationRequest) (*Factor, *Response, error) {
+	if activationIn == nil {
+		activationIn = new(FactorActivationRequest)
+	}
+	return s.do(ctx, "POST", fmt.Sprintf("users/%s/factors/%s/lifecycle/activate", userID, factorID), activationIn)
+}
+
+// PollActivation fetches the state of the activation of a push factor, returned by Enroll(). The
+// activation is complete once the status of the factor is ACTIVE; until then the factor result of
+// its activation object is WAITING.
+//
+// https://developer.okta.com/docs/reference/api/factors/#activate-push-factor
+func (s *FactorsService) PollActivation(ctx context.Context, factor *Factor) (*Factor, *Response, error) {
+	poll, ok := factor.Links.Get("poll")
+	if !ok {
+		return nil, nil, errors.New("factor has no activation to poll")
+	}
+
+	factorOut := new(Factor)
+	resp, err := s.client.FollowLink(ctx, "POST", poll.Href, factorOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return factorOut, resp, nil
+}
+
+// QRCode fetches the PNG image of the QR code of the activation of a TOTP or push factor, to
+// display to the user enrolling it.
+func (s *FactorsService) QRCode(ctx context.Context, activation *FactorActivation) ([]byte, *Response, error) {
+	qrcode, ok := activation.QRCode()
+	if !ok {
+		return nil, nil, errors.New("factor activation has no QR code")
+	}
+
+	ctx = WithHeader(ctx, "Accept", "image/png")
+	var image bytes.Buffer
+	resp, err := s.client.FollowLink(ctx, "GET", qrcode.Href, &image)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return image.Bytes(), resp, nil
+}
+
+// Verify verifies a factor of a user, or sends a challenge to the call, sms, email and push
+// factors when verifyIn has no pass code.
+//
+// https://developer.okta.com/docs/reference/api/factors/#verify-factor
+func (s *FactorsService) Verify(ctx context.Context, userID string, factorID string, verifyIn *FactorVerifyRequest) (*FactorVerification, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("users/%s/factors/%s/verify", userID, factorID)
+
+	req, err := s.client.NewRequest("POST", path, verifyIn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	verification := new(FactorVerification)
+	resp, err := s.client.Do(ctx, req, verification)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return verification, resp, nil
+}
+
+// Remove unenrolls a factor of a user.
+//
+// https://developer.okta.com/docs/reference/api/factors/#reset-factor
+func (s *FactorsService) Remove(ctx context.Context, userID string, factorID string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("users/%s/factors/%s", userID, factorID)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// do is a helper function for the single factor operations.
+func (s *FactorsService) do(ctx context.Context, method string, path string, bodyIn interface{}) (*Factor, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	req, err := s.client.NewRequest(method, path, bodyIn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	factorOut := new(Factor)
+	resp, err := s.client.Do(ctx, req, factorOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return factorOut, resp, nil
+}
@@ -0,0 +1,92 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// FeatureComparison represents the differences between the features of two orgs, see
+// FeaturesService.Compare().
+type FeatureComparison struct {
+	// Matching is the number of features with the same status in both orgs.
+	Matching int
+	// Differences are the features whose status differs, or that only one of the orgs has, sorted
+	// by name.
+	Differences []*FeatureDifference
+}
+
+// FeatureDifference represents a feature whose status differs between two orgs.
+type FeatureDifference struct {
+	Name string
+	// Status is the status of the feature in the org of the FeaturesService, or an empty string if
+	// the org doesn't have the feature.
+	Status string
+	// OtherStatus is the status of the feature in the other org, or an empty string if the org
+	// doesn't have the feature.
+	OtherStatus string
+	// Stage is the release stage of the feature in the org of the FeaturesService, or in the other
+	// org if the former doesn't have the feature.
+	Stage FeatureStage
+}
+
+// String returns a line of an environment promotion checklist for the difference.
+func (d *FeatureDifference) String() string {
+	return fmt.Sprintf("%s (%s): %s in this org, %s in the other", d.Name, d.Stage.Value, featureStatusOrMissing(d.Status), featureStatusOrMissing(d.OtherStatus))
+}
+
+// Compare lists the features of the org and of the org of other, e.g. preview and production,
+// and reports those whose status differs, e.g. to check before promoting a change from one
+// environment to the other that the features it relies on are enabled in both. Features are
+// matched by name, as their IDs may differ between orgs.
+//
+// https://developer.okta.com/docs/reference/api/features/#list-features
+func (s *FeaturesService) Compare(ctx context.Context, other *Client) (*FeatureComparison, error) {
+	features, _, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	otherFeatures, _, err := other.Features.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return compareFeatures(features, otherFeatures), nil
+}
+
+// compareFeatures returns the comparison of features with otherFeatures.
+func compareFeatures(features []*Feature, otherFeatures []*Feature) *FeatureComparison {
+	others := make(map[string]*Feature, len(otherFeatures))
+	for _, f := range otherFeatures {
+		others[f.Name] = f
+	}
+
+	comparison := new(FeatureComparison)
+	for _, f := range features {
+		o, ok := others[f.Name]
+		delete(others, f.Name)
+		switch {
+		case !ok:
+			comparison.Differences = append(comparison.Differences, &FeatureDifference{Name: f.Name, Status: f.Status, Stage: f.Stage})
+		case o.Status != f.Status:
+			comparison.Differences = append(comparison.Differences, &FeatureDifference{Name: f.Name, Status: f.Status, OtherStatus: o.Status, Stage: f.Stage})
+		default:
+			comparison.Matching++
+		}
+	}
+	for _, o := range others {
+		comparison.Differences = append(comparison.Differences, &FeatureDifference{Name: o.Name, OtherStatus: o.Status, Stage: o.Stage})
+	}
+
+	sort.Slice(comparison.Differences, func(i, j int) bool {
+		return comparison.Differences[i].Name < comparison.Differences[j].Name
+	})
+	return comparison
+}
+
+// featureStatusOrMissing returns status, or "missing" if it's empty.
+func featureStatusOrMissing(status string) string {
+	if status == "" {
+		return "missing"
+	}
+	return status
+}
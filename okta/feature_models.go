@@ -0,0 +1,32 @@
+package okta
+
+// Feature represents a self-service Early Access or Beta feature of the org.
+//
+// https://developer.okta.com/docs/reference/api/features/#feature-object
+type Feature struct {
+	RawJSON
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Status has possible values of: "ENABLED", "DISABLED"
+	Status string       `json:"status"`
+	Stage  FeatureStage `json:"stage"`
+	Links  interface{}  `json:"_links,omitempty"`
+}
+
+// FeatureStage represents the release stage of a feature.
+//
+// https://developer.okta.com/docs/reference/api/features/#stage-object
+type FeatureStage struct {
+	// State has possible values of: "OPEN", "CLOSED"
+	State string `json:"state"`
+	// Value has possible values of: "EA", "BETA"
+	Value string `json:"value"`
+}
+
+// FeatureStatus Constants
+const (
+	FeatureStatusEnabled  = "ENABLED"
+	FeatureStatusDisabled = "DISABLED"
+)
@@ -0,0 +1,140 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// FeaturesService is the service providing access to the Features Resource in the Okta API
+type FeaturesService service
+
+// List fetches all self-service features of the org.
+//
+// https://developer.okta.com/docs/reference/api/features/#list-features
+func (s *FeaturesService) List(ctx context.Context) ([]*Feature, *Response, error) {
+	return s.list(ctx, "features")
+}
+
+// GetByID fetches a feature by ID.
+//
+// https://developer.okta.com/docs/reference/api/features/#get-a-feature
+func (s *FeaturesService) GetByID(ctx context.Context, id string) (*Feature, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("features/%s", id)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	featureOut := new(Feature)
+	resp, err := s.client.Do(ctx, req, featureOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return featureOut, resp, nil
+}
+
+// ListDependencies fetches the features that must be enabled before a feature can be enabled.
+//
+// https://developer.okta.com/docs/reference/api/features/#get-dependencies
+func (s *FeaturesService) ListDependencies(ctx context.Context, id string) ([]*Feature, *Response, error) {
+	return s.list(ctx, fmt.Sprintf("features/%s/dependencies", id))
+}
+
+// ListDependents fetches the features that must be disabled before a feature can be disabled.
+//
+// https://developer.okta.com/docs/reference/api/features/#get-dependents
+func (s *FeaturesService) ListDependents(ctx context.Context, id string) ([]*Feature, *Response, error) {
+	return s.list(ctx, fmt.Sprintf("features/%s/dependents", id))
+}
+
+// list is a helper function.
+func (s *FeaturesService) list(ctx context.Context, path string) ([]*Feature, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var features []*Feature
+	resp, err := s.client.Do(ctx, req, &features)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return features, resp, nil
+}
+
+// Enable enables a feature. Unless force is true, Enable checks that all dependencies of the feature
+// are enabled first and returns an error naming the disabled ones otherwise. With force, Okta enables
+// the dependencies as well.
+//
+// https://developer.okta.com/docs/reference/api/features/#update-a-feature-lifecycle
+func (s *FeaturesService) Enable(ctx context.Context, id string, force bool) (*Feature, *Response, error) {
+	if !force {
+		dependencies, resp, err := s.ListDependencies(ctx, id)
+		if err != nil {
+			return nil, resp, err
+		}
+		if blocking := featuresWithStatus(dependencies, FeatureStatusDisabled); len(blocking) > 0 {
+			return nil, resp, fmt.Errorf("Feature %s can't be enabled, dependencies are disabled: %s", id, strings.Join(blocking, ", "))
+		}
+	}
+
+	return s.lifecycle(ctx, id, "enable", force)
+}
+
+// Disable disables a feature. Unless force is true, Disable checks that all dependents of the feature
+// are disabled first and returns an error naming the enabled ones otherwise. With force, Okta disables
+// the dependents as well.
+//
+// https://developer.okta.com/docs/reference/api/features/#update-a-feature-lifecycle
+func (s *FeaturesService) Disable(ctx context.Context, id string, force bool) (*Feature, *Response, error) {
+	if !force {
+		dependents, resp, err := s.ListDependents(ctx, id)
+		if err != nil {
+			return nil, resp, err
+		}
+		if blocking := featuresWithStatus(dependents, FeatureStatusEnabled); len(blocking) > 0 {
+			return nil, resp, fmt.Errorf("Feature %s can't be disabled, dependents are enabled: %s", id, strings.Join(blocking, ", "))
+		}
+	}
+
+	return s.lifecycle(ctx, id, "disable", force)
+}
+
+// lifecycle is a helper function for the lifecycle operations.
+func (s *FeaturesService) lifecycle(ctx context.Context, id string, operation string, force bool) (*Feature, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("features/%s/%s", id, operation)
+	if force {
+		path += "?mode=force"
+	}
+
+	req, err := s.client.NewRequest("POST", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	featureOut := new(Feature)
+	resp, err := s.client.Do(ctx, req, featureOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return featureOut, resp, nil
+}
+
+// featuresWithStatus returns the names of the features that have the given status.
+func featuresWithStatus(features []*Feature, status string) []string {
+	var names []string
+	for _, f := range features {
+		if f.Status == status {
+			names = append(names, f.Name)
+		}
+	}
+	return names
+}
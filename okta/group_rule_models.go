@@ -0,0 +1,65 @@
+package okta
+
+// GroupRule represents a rule that assigns users to groups based on an expression on their profile.
+//
+// https://developer.okta.com/docs/reference/api/groups/#group-rule-object
+type GroupRule struct {
+	RawJSON
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type"`
+	Name string `json:"name"`
+	// Status has possible values of: "ACTIVE", "INACTIVE", "INVALID"
+	Status         string              `json:"status,omitempty"`
+	Created        Timestamp           `json:"created,omitempty"`
+	LastUpdated    Timestamp           `json:"lastUpdated,omitempty"`
+	Conditions     GroupRuleConditions `json:"conditions"`
+	Actions        GroupRuleActions    `json:"actions"`
+	AllGroupsValid bool                `json:"allGroupsValid,omitempty"`
+}
+
+// GroupRuleConditions represents the conditions of a GroupRule.
+//
+// https://developer.okta.com/docs/reference/api/groups/#group-rule-conditions-object
+type GroupRuleConditions struct {
+	People *struct {
+		Users struct {
+			Exclude []string `json:"exclude,omitempty"`
+		} `json:"users"`
+		Groups struct {
+			Exclude []string `json:"exclude,omitempty"`
+		} `json:"groups"`
+	} `json:"people,omitempty"`
+	Expression GroupRuleExpression `json:"expression"`
+}
+
+// GroupRuleExpression represents the Okta Expression Language expression of a GroupRule.
+type GroupRuleExpression struct {
+	Value string `json:"value"`
+	Type  string `json:"type"`
+}
+
+// GroupRuleActions represents the actions of a GroupRule.
+//
+// https://developer.okta.com/docs/reference/api/groups/#group-rule-action-object
+type GroupRuleActions struct {
+	AssignUserToGroups struct {
+		GroupIDs []string `json:"groupIds"`
+	} `json:"assignUserToGroups"`
+}
+
+// NewGroupRule is a helper method to create a new GroupRule assigning the users matching expression
+// to groupIDs.
+func NewGroupRule(name, expression string, groupIDs ...string) *GroupRule {
+	rule := &GroupRule{
+		Type: "group_rule",
+		Name: name,
+		Conditions: GroupRuleConditions{
+			Expression: GroupRuleExpression{
+				Value: expression,
+				Type:  "urn:okta:expression:1.0",
+			},
+		},
+	}
+	rule.Actions.AssignUserToGroups.GroupIDs = groupIDs
+	return rule
+}
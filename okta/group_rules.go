@@ -0,0 +1,110 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// ListRules fetches the first page of group rules, optionally only those whose name matches search.
+//
+// https://developer.okta.com/docs/reference/api/groups/#list-group-rules
+func (s *GroupsService) ListRules(ctx context.Context, search string) (*Collection[*GroupRule], *Response, error) {
+	query := url.Values{}
+	query.Set("limit", "200")
+	if search != "" {
+		query.Set("search", search)
+	}
+	path := fmt.Sprintf("groups/rules?%s", query.Encode())
+
+	return listPage[*GroupRule](ctx, s.client, RateLimitCategoryCore, path)
+}
+
+// GetRule fetches a group rule by ID.
+//
+// https://developer.okta.com/docs/reference/api/groups/#get-group-rule
+func (s *GroupsService) GetRule(ctx context.Context, ruleID string) (*GroupRule, *Response, error) {
+	return s.doRule(ctx, "GET", fmt.Sprintf("groups/rules/%s", ruleID), nil)
+}
+
+// AddRule creates a new, inactive, group rule.
+//
+// https://developer.okta.com/docs/reference/api/groups/#create-group-rule
+func (s *GroupsService) AddRule(ctx context.Context, ruleIn *GroupRule) (*GroupRule, *Response, error) {
+	return s.doRule(ctx, "POST", "groups/rules", ruleIn)
+}
+
+// UpdateRule modifies an inactive group rule.
+//
+// Note that delta updates are not supported. You must pass a full GroupRule object.
+//
+// https://developer.okta.com/docs/reference/api/groups/#update-group-rule
+func (s *GroupsService) UpdateRule(ctx context.Context, ruleID string, ruleIn *GroupRule) (*GroupRule, *Response, error) {
+	return s.doRule(ctx, "PUT", fmt.Sprintf("groups/rules/%s", ruleID), ruleIn)
+}
+
+// RemoveRule deletes a group rule. If removeUsers is true the users assigned by the rule are
+// removed from its groups.
+//
+// https://developer.okta.com/docs/reference/api/groups/#delete-a-group-rule
+func (s *GroupsService) RemoveRule(ctx context.Context, ruleID string, removeUsers bool) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("groups/rules/%s?removeUsers=%t", ruleID, removeUsers)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ActivateRule activates a group rule.
+//
+// https://developer.okta.com/docs/reference/api/groups/#activate-a-group-rule
+func (s *GroupsService) ActivateRule(ctx context.Context, ruleID string) (*Response, error) {
+	return s.ruleLifecycle(ctx, ruleID, "activate")
+}
+
+// DeactivateRule deactivates a group rule.
+//
+// https://developer.okta.com/docs/reference/api/groups/#deactivate-a-group-rule
+func (s *GroupsService) DeactivateRule(ctx context.Context, ruleID string) (*Response, error) {
+	return s.ruleLifecycle(ctx, ruleID, "deactivate")
+}
+
+// ruleLifecycle is a helper function for the group rule lifecycle operations.
+func (s *GroupsService) ruleLifecycle(ctx context.Context, ruleID, operation string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("groups/rules/%s/lifecycle/%s", ruleID, operation)
+
+	req, err := s.client.NewRequest("POST", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// doRule is a helper function for the requests returning a GroupRule.
+func (s *GroupsService) doRule(ctx context.Context, method, path string, ruleIn *GroupRule) (*GroupRule, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if ruleIn != nil {
+		body = ruleIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleOut := new(GroupRule)
+	resp, err := s.client.Do(ctx, req, ruleOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ruleOut, resp, nil
+}
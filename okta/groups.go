@@ -3,6 +3,7 @@ package okta
 import (
 	"context"
 	"fmt"
+	"net/url"
 )
 
 // GroupsService is the service providing access to the Groups Resource in the Okta API
@@ -12,13 +13,43 @@ type GroupsService service
 //
 // https://developer.okta.com/docs/api/resources/groups#group-model
 type Group struct {
+	RawJSON
 	ID                    string       `json:"id,omitempty"`
 	Created               Timestamp    `json:"created,omitempty"`
 	LastUpdated           Timestamp    `json:"lastUpdated,omitempty"`
 	LastMembershipUpdated Timestamp    `json:"lastMembershipUpdated,omitempty"`
 	ObjectClass           []string     `json:"objectClass,omitempty"`
-	Type                  string       `json:"type,omitempty"`
+	Type                  GroupType    `json:"type,omitempty"`
 	Profile               GroupProfile `json:"profile"`
+	Links                 interface{}  `json:"_links,omitempty"`
+}
+
+// GroupType is a type for the GroupType enum. Okta may return values added since, see Valid().
+//
+// https://developer.okta.com/docs/reference/api/groups/#group-type
+type GroupType string
+
+// GroupType Constants
+const (
+	// GroupTypeOkta is a group mastered in Okta.
+	GroupTypeOkta GroupType = "OKTA_GROUP"
+	// GroupTypeApp is a group imported from an application, e.g. Active Directory.
+	GroupTypeApp = "APP_GROUP"
+	// GroupTypeBuiltIn is the Everyone group.
+	GroupTypeBuiltIn = "BUILT_IN"
+)
+
+// GroupTypes is the list of the valid GroupType values.
+var GroupTypes = []GroupType{GroupTypeOkta, GroupTypeApp, GroupTypeBuiltIn}
+
+// Valid reports whether t is a known group type.
+func (t GroupType) Valid() bool {
+	for _, v := range GroupTypes {
+		if t == v {
+			return true
+		}
+	}
+	return false
 }
 
 // GroupProfile represents an Okta Group Profile.
@@ -35,9 +66,17 @@ type GroupProfile struct {
 
 // GetByID fetches a group by ID.
 //
+// When Client.Cache is set, a cached group is returned with a Response of status 200 OK and
+// no headers, see Cache.
+//
 // https://developer.okta.com/docs/api/resources/groups#get-group
 func (s *GroupsService) GetByID(ctx context.Context, id string) (*Group, *Response, error) {
-	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, rateLimitGroupsGetUpdateDeleteCategory)
+	groupOut := new(Group)
+	if resp := s.client.cached(CacheResourceGroups, id, groupOut); resp != nil {
+		return groupOut, resp, nil
+	}
+
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryGroupsGetUpdateDelete)
 	path := fmt.Sprintf("groups/%s", id)
 
 	req, err := s.client.NewRequest("GET", path, nil)
@@ -45,12 +84,12 @@ func (s *GroupsService) GetByID(ctx context.Context, id string) (*Group, *Respon
 		return nil, nil, err
 	}
 
-	groupOut := new(Group)
 	resp, err := s.client.Do(ctx, req, groupOut)
 	if err != nil {
 		return nil, resp, err
 	}
 
+	s.client.cache(CacheResourceGroups, id, groupOut)
 	return groupOut, resp, nil
 
 }
@@ -59,7 +98,7 @@ func (s *GroupsService) GetByID(ctx context.Context, id string) (*Group, *Respon
 //
 // https://developer.okta.com/docs/api/resources/groups#add-group
 func (s *GroupsService) Add(ctx context.Context, profile *GroupProfile) (*Group, *Response, error) {
-	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, rateLimitGroupsCreateListCategory)
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryGroupsCreateList)
 	path := "groups"
 
 	body := map[string]interface{}{"profile": profile}
@@ -103,7 +142,8 @@ func (s *GroupsService) UpdateWithGroup(ctx context.Context, id string, group *G
 //
 // https://developer.okta.com/docs/api/resources/groups#update-group
 func (s *GroupsService) Update(ctx context.Context, id string, profile *GroupProfile) (*Group, *Response, error) {
-	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, rateLimitGroupsGetUpdateDeleteCategory)
+	defer s.client.invalidate(CacheResourceGroups, id)
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryGroupsGetUpdateDelete)
 	path := fmt.Sprintf("groups/%s", id)
 
 	body := map[string]interface{}{"profile": profile}
@@ -123,11 +163,16 @@ func (s *GroupsService) Update(ctx context.Context, id string, profile *GroupPro
 
 }
 
-// Remove deletes a group.
+// Remove deletes a group. With Guard.MaxGroupMembers set on the client, it refuses to delete a
+// group with more members, see Guard.
 //
 // https://developer.okta.com/docs/api/resources/groups#remove-group
 func (s *GroupsService) Remove(ctx context.Context, id string) (*Response, error) {
-	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, rateLimitGroupsGetUpdateDeleteCategory)
+	if err := s.client.guardGroupRemoval(ctx, id); err != nil {
+		return nil, err
+	}
+	defer s.client.invalidate(CacheResourceGroups, id)
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryGroupsGetUpdateDelete)
 	path := fmt.Sprintf("groups/%s", id)
 
 	req, err := s.client.NewRequest("DELETE", path, nil)
@@ -143,3 +188,77 @@ func (s *GroupsService) Remove(ctx context.Context, id string) (*Response, error
 
 	return resp, nil
 }
+
+// ListUsers fetches the first page of the members of a group.
+//
+// https://developer.okta.com/docs/reference/api/groups/#list-group-members
+func (s *GroupsService) ListUsers(ctx context.Context, id string) (*Collection[*User], *Response, error) {
+	path := fmt.Sprintf("groups/%s/users?limit=%d", id, 200)
+	return listPage[*User](ctx, s.client, RateLimitCategoryCore, path)
+}
+
+// AddUser adds a user to a group.
+//
+// https://developer.okta.com/docs/reference/api/groups/#add-user-to-group
+func (s *GroupsService) AddUser(ctx context.Context, id string, userID string) (*Response, error) {
+	return s.membership(ctx, "PUT", id, userID)
+}
+
+// RemoveUser removes a user from a group.
+//
+// https://developer.okta.com/docs/reference/api/groups/#remove-user-from-group
+func (s *GroupsService) RemoveUser(ctx context.Context, id string, userID string) (*Response, error) {
+	return s.membership(ctx, "DELETE", id, userID)
+}
+
+// membership is a helper function for the group membership operations.
+func (s *GroupsService) membership(ctx context.Context, method, id, userID string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("groups/%s/users/%s", id, userID)
+
+	req, err := s.client.NewRequest(method, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GroupListParams is a helper struct for calling List().
+//
+// https://developer.okta.com/docs/reference/api/groups/#list-groups
+type GroupListParams struct {
+	// Q matches the beginning of the name of groups.
+	Q string
+	// Filter is a filter expression, e.g. `lastUpdated gt "2020-01-01T00:00:00.000Z"`.
+	Filter string
+	// Type restricts the groups to a type, and is combined with Filter.
+	Type GroupType
+	// Search is a search expression on any group attribute, e.g. `profile.name sw "eng"`.
+	Search string
+}
+
+// List fetches the first page of groups, optionally filtered by params.
+//
+// https://developer.okta.com/docs/reference/api/groups/#list-groups
+func (s *GroupsService) List(ctx context.Context, params *GroupListParams) (*Collection[*Group], *Response, error) {
+	query := url.Values{}
+	query.Set("limit", "200")
+	if params != nil {
+		if params.Q != "" {
+			query.Set("q", params.Q)
+		}
+		if params.Type != "" && !params.Type.Valid() {
+			return nil, nil, fmt.Errorf("okta: invalid GroupType %q", params.Type)
+		}
+		if filter := andFilter(params.Filter, "type", string(params.Type)); filter != "" {
+			query.Set("filter", filter)
+		}
+		if params.Search != "" {
+			query.Set("search", params.Search)
+		}
+	}
+	path := fmt.Sprintf("groups?%s", query.Encode())
+
+	return listPage[*Group](ctx, s.client, RateLimitCategoryGroupsCreateList, path)
+}
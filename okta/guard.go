@@ -0,0 +1,98 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+const forceCtxKey contextKey = "force"
+
+// Guard configures the guardrails of the destructive methods of a Client, which refuse the calls
+// that look like mistakes of an automation, e.g. deleting a populated group because of a wrong ID.
+// Set Client.Guard to enforce them; a call made with a context returned by WithForce() bypasses
+// them. The zero value of a field disables its check.
+type Guard struct {
+	// MaxGroupMembers is the number of members above which GroupsService.Remove() refuses to
+	// delete a group.
+	MaxGroupMembers int
+	// ProtectAssignedApps makes AppsService.Remove() refuse to delete an application that has
+	// users or groups assigned.
+	ProtectAssignedApps bool
+}
+
+// GuardError is returned by the destructive methods of a Client when the call is refused by the
+// Guard of the client.
+type GuardError struct {
+	// Operation is the refused operation, e.g. "Groups.Remove".
+	Operation string
+	// ID is the ID of the resource of the operation.
+	ID string
+	// Reason is why the operation was refused.
+	Reason string
+}
+
+func (e *GuardError) Error() string {
+	return fmt.Sprintf("okta: %s of %s refused by the guard: %s; use WithForce to proceed anyway", e.Operation, e.ID, e.Reason)
+}
+
+// WithForce returns a copy of ctx with which the calls of a Client bypass the checks of its Guard.
+func WithForce(ctx context.Context) context.Context {
+	return context.WithValue(ctx, forceCtxKey, true)
+}
+
+// guarded reports whether the checks of the Guard of the client apply to the calls made with ctx.
+func (c *Client) guarded(ctx context.Context) bool {
+	force, _ := ctx.Value(forceCtxKey).(bool)
+	return c.Guard != nil && !force
+}
+
+// guardGroupRemoval returns a *GuardError if the group id has more than Guard.MaxGroupMembers
+// members. The members are counted up to the limit only.
+func (c *Client) guardGroupRemoval(ctx context.Context, id string) error {
+	if !c.guarded(ctx) || c.Guard.MaxGroupMembers <= 0 {
+		return nil
+	}
+
+	page, _, err := c.Groups.ListUsers(ctx, id)
+	members := 0
+	for err == nil {
+		members += len(page.Items)
+		if members > c.Guard.MaxGroupMembers {
+			return &GuardError{
+				Operation: "Groups.Remove",
+				ID:        id,
+				Reason:    fmt.Sprintf("the group has more than %d members", c.Guard.MaxGroupMembers),
+			}
+		}
+		if !page.HasNextPage() {
+			return nil
+		}
+		page, err = page.NextPage(ctx)
+	}
+	return err
+}
+
+// guardAppRemoval returns a *GuardError if users or groups are assigned to the application id and
+// Guard.ProtectAssignedApps is set.
+func (c *Client) guardAppRemoval(ctx context.Context, id string) error {
+	if !c.guarded(ctx) || !c.Guard.ProtectAssignedApps {
+		return nil
+	}
+
+	users, _, err := c.Apps.ListAssignedUsers(ctx, id)
+	if err != nil {
+		return err
+	}
+	groups, _, err := c.Apps.ListAssignedGroups(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(users.Items) == 0 && len(groups.Items) == 0 {
+		return nil
+	}
+	return &GuardError{
+		Operation: "Apps.Remove",
+		ID:        id,
+		Reason:    "the application has assigned users or groups",
+	}
+}
@@ -0,0 +1,126 @@
+package okta
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// HookSecret is the shared secret authenticating the requests Okta sends to an event or inline
+// hook, checked in constant time. It is safe for concurrent use, so that it can be rotated while
+// a handler serves requests: during a rotation it accepts both the current and the next value.
+//
+// Okta authenticates hook requests with a header whose value is the secret, see
+// ValidateHeader(). A relay that signs the requests it forwards can use the secret as an HMAC key
+// instead, see ValidateSignature().
+type HookSecret struct {
+	// Header is the name of the header holding the secret. Defaults to Authorization.
+	Header string
+
+	mu      sync.RWMutex
+	current string
+	next    string
+}
+
+// NewHookSecret is a helper method to create a new HookSecret held in the Authorization header.
+func NewHookSecret(value string) *HookSecret {
+	return &HookSecret{Header: "Authorization", current: value}
+}
+
+// Stage makes the secret accept value along with the current value, at the start of a rotation.
+func (s *HookSecret) Stage(value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.next = value
+}
+
+// Promote completes a rotation: the staged value becomes the only value accepted. Promote once
+// no request can still carry the current value, e.g. after a grace period.
+func (s *HookSecret) Promote() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.next != "" {
+		s.current, s.next = s.next, ""
+	}
+}
+
+// Abort cancels a rotation: the staged value is no longer accepted.
+func (s *HookSecret) Abort() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.next = ""
+}
+
+// Value returns the current value of the secret.
+func (s *HookSecret) Value() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.current
+}
+
+// values returns the accepted values of the secret.
+func (s *HookSecret) values() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.next != "" {
+		return []string{s.current, s.next}
+	}
+	return []string{s.current}
+}
+
+// ValidateHeader reports whether value is an accepted value of the secret. Every accepted value
+// is compared, so the time taken doesn't tell which matched.
+func (s *HookSecret) ValidateHeader(value string) bool {
+	match := 0
+	for _, v := range s.values() {
+		if v != "" {
+			match |= subtle.ConstantTimeCompare([]byte(value), []byte(v))
+		}
+	}
+	return match == 1
+}
+
+// ValidateRequest reports whether the header of r holding the secret has an accepted value.
+func (s *HookSecret) ValidateRequest(r *http.Request) bool {
+	header := s.Header
+	if header == "" {
+		header = "Authorization"
+	}
+	return s.ValidateHeader(r.Header.Get(header))
+}
+
+// Sign returns the HMAC-SHA256 of body keyed by the current value of the secret, hex encoded.
+func (s *HookSecret) Sign(body []byte) string {
+	return hex.EncodeToString(hookHMAC(s.Value(), body))
+}
+
+// ValidateSignature reports whether signature is the HMAC-SHA256 of body keyed by an accepted
+// value of the secret. The signature is hex or base64 encoded, optionally prefixed with "sha256=".
+func (s *HookSecret) ValidateSignature(body []byte, signature string) bool {
+	signature = strings.TrimPrefix(signature, "sha256=")
+	mac, err := hex.DecodeString(signature)
+	if err != nil {
+		if mac, err = base64.StdEncoding.DecodeString(signature); err != nil {
+			return false
+		}
+	}
+
+	match := 0
+	for _, v := range s.values() {
+		if v != "" && hmac.Equal(mac, hookHMAC(v, body)) {
+			match = 1
+		}
+	}
+	return match == 1
+}
+
+func hookHMAC(key string, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(key))
+	h.Write(body)
+	return h.Sum(nil)
+}
@@ -0,0 +1,195 @@
+package okta
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+)
+
+// ListKeys fetches all X.509 certificates in the Identity Provider key store.
+//
+// https://developer.okta.com/docs/reference/api/idps/#list-keys
+func (s *IdentityProvidersService) ListKeys(ctx context.Context) ([]*JSONWebKey, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "idps/credentials/keys"
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var keys []*JSONWebKey
+	resp, err := s.client.Do(ctx, req, &keys)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return keys, resp, nil
+}
+
+// GetKey fetches a certificate from the Identity Provider key store by its key ID.
+//
+// https://developer.okta.com/docs/reference/api/idps/#get-key
+func (s *IdentityProvidersService) GetKey(ctx context.Context, kid string) (*JSONWebKey, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("idps/credentials/keys/%s", kid)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyOut := new(JSONWebKey)
+	resp, err := s.client.Do(ctx, req, keyOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return keyOut, resp, nil
+}
+
+// AddKey adds a trusted X.509 certificate to the Identity Provider key store. x5c is the
+// base64 encoded DER certificate, the same format used by the x5c attribute of a JSON Web Key.
+//
+// https://developer.okta.com/docs/reference/api/idps/#add-x-509-certificate-public-key
+func (s *IdentityProvidersService) AddKey(ctx context.Context, x5c string) (*JSONWebKey, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "idps/credentials/keys"
+
+	body := map[string]interface{}{"x5c": []string{x5c}}
+
+	req, err := s.client.NewRequest("POST", path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyOut := new(JSONWebKey)
+	resp, err := s.client.Do(ctx, req, keyOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return keyOut, resp, nil
+}
+
+// RemoveKey deletes a certificate from the Identity Provider key store. Keys which are referenced
+// by an Identity Provider can't be deleted.
+//
+// https://developer.okta.com/docs/reference/api/idps/#delete-key
+func (s *IdentityProvidersService) RemoveKey(ctx context.Context, kid string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("idps/credentials/keys/%s", kid)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListCSRs fetches the Certificate Signing Requests of an Identity Provider.
+//
+// https://developer.okta.com/docs/reference/api/idps/#list-signing-certificate-signing-requests-for-idp
+func (s *IdentityProvidersService) ListCSRs(ctx context.Context, idpID string) ([]*CSR, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("idps/%s/credentials/csrs", idpID)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var csrs []*CSR
+	resp, err := s.client.Do(ctx, req, &csrs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return csrs, resp, nil
+}
+
+// GetCSR fetches a Certificate Signing Request of an Identity Provider.
+//
+// https://developer.okta.com/docs/reference/api/idps/#get-signing-certificate-signing-request-for-idp
+func (s *IdentityProvidersService) GetCSR(ctx context.Context, idpID string, csrID string) (*CSR, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("idps/%s/credentials/csrs/%s", idpID, csrID)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	csrOut := new(CSR)
+	resp, err := s.client.Do(ctx, req, csrOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return csrOut, resp, nil
+}
+
+// GenerateCSR generates a new key pair and returns a Certificate Signing Request for it. The key
+// pair isn't used until the signed certificate is published with PublishCSR().
+//
+// https://developer.okta.com/docs/reference/api/idps/#generate-certificate-signing-request-for-idp
+func (s *IdentityProvidersService) GenerateCSR(ctx context.Context, idpID string, metadata *CSRMetadata) (*CSR, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("idps/%s/credentials/csrs", idpID)
+
+	req, err := s.client.NewRequest("POST", path, metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	csrOut := new(CSR)
+	resp, err := s.client.Do(ctx, req, csrOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return csrOut, resp, nil
+}
+
+// RevokeCSR revokes a Certificate Signing Request and deletes the key pair.
+//
+// https://developer.okta.com/docs/reference/api/idps/#revoke-a-certificate-signing-request-for-idp
+func (s *IdentityProvidersService) RevokeCSR(ctx context.Context, idpID string, csrID string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("idps/%s/credentials/csrs/%s", idpID, csrID)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// PublishCSR publishes the certificate signed from a Certificate Signing Request, making the key
+// pair available as a signing key of the Identity Provider. cert may be DER or PEM encoded.
+//
+// https://developer.okta.com/docs/reference/api/idps/#publish-a-certificate-signing-request-for-idp
+func (s *IdentityProvidersService) PublishCSR(ctx context.Context, idpID string, csrID string, cert []byte) (*JSONWebKey, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("idps/%s/credentials/csrs/%s/lifecycle/publish", idpID, csrID)
+
+	mediaType := "application/pkix-cert"
+	if bytes.HasPrefix(bytes.TrimSpace(cert), []byte("-----BEGIN")) {
+		mediaType = "application/x-pem-file"
+	}
+
+	req, err := s.client.NewUploadRequest(path, bytes.NewReader(cert), int64(len(cert)), mediaType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyOut := new(JSONWebKey)
+	resp, err := s.client.Do(ctx, req, keyOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return keyOut, resp, nil
+}
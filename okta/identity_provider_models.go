@@ -0,0 +1,275 @@
+package okta
+
+// IdentityProvider represents an Identity Provider in Okta.
+//
+// https://developer.okta.com/docs/reference/api/idps/#identity-provider-object
+type IdentityProvider struct {
+	RawJSON
+	ID          string                   `json:"id,omitempty"`
+	Type        IdentityProviderType     `json:"type"`
+	IssuerMode  string                   `json:"issuerMode,omitempty"`
+	Name        string                   `json:"name"`
+	Status      string                   `json:"status,omitempty"`
+	Created     Timestamp                `json:"created,omitempty"`
+	LastUpdated Timestamp                `json:"lastUpdated,omitempty"`
+	Protocol    IdentityProviderProtocol `json:"protocol"`
+	Policy      IdentityProviderPolicy   `json:"policy"`
+	Links       interface{}              `json:"_links,omitempty"`
+}
+
+// IdentityProviderType is a type for the IdentityProviderType enum.
+//
+// https://developer.okta.com/docs/reference/api/idps/#identity-provider-type
+type IdentityProviderType string
+
+// IdentityProviderType Constants
+//
+// https://developer.okta.com/docs/reference/api/idps/#identity-provider-type
+const (
+	IdentityProviderTypeSAML2       IdentityProviderType = "SAML2"
+	IdentityProviderTypeOIDC                             = "OIDC"
+	IdentityProviderTypeGoogle                           = "GOOGLE"
+	IdentityProviderTypeFacebook                         = "FACEBOOK"
+	IdentityProviderTypeLinkedIn                         = "LINKEDIN"
+	IdentityProviderTypeMicrosoft                        = "MICROSOFT"
+	IdentityProviderTypeApple                            = "APPLE"
+	IdentityProviderTypeX509                             = "X509"
+	IdentityProviderTypeOktaOrg2Org                      = "OKTA"
+)
+
+// IdentityProviderProtocol represents the protocol settings of an Identity Provider. Which
+// attributes are required depends on the protocol type.
+//
+// https://developer.okta.com/docs/reference/api/idps/#protocol-object
+type IdentityProviderProtocol struct {
+	// Type has possible values of: "SAML2", "OIDC", "OAUTH2", "MTLS"
+	Type        string                               `json:"type"`
+	Endpoints   *IdentityProviderEndpoints           `json:"endpoints,omitempty"`
+	Scopes      []string                             `json:"scopes,omitempty"`
+	Algorithms  *IdentityProviderAlgorithms          `json:"algorithms,omitempty"`
+	Settings    *IdentityProviderProtocolSettings    `json:"settings,omitempty"`
+	Credentials *IdentityProviderProtocolCredentials `json:"credentials,omitempty"`
+	Issuer      *IdentityProviderEndpoint            `json:"issuer,omitempty"`
+	RelayState  *IdentityProviderRelayState          `json:"relayState,omitempty"`
+}
+
+// IdentityProviderEndpoints represents the endpoints of an Identity Provider.
+//
+// https://developer.okta.com/docs/reference/api/idps/#saml-2-0-endpoints-object
+type IdentityProviderEndpoints struct {
+	SSO           *IdentityProviderEndpoint `json:"sso,omitempty"`
+	ACS           *IdentityProviderEndpoint `json:"acs,omitempty"`
+	Authorization *IdentityProviderEndpoint `json:"authorization,omitempty"`
+	Token         *IdentityProviderEndpoint `json:"token,omitempty"`
+	UserInfo      *IdentityProviderEndpoint `json:"userInfo,omitempty"`
+	JWKS          *IdentityProviderEndpoint `json:"jwks,omitempty"`
+}
+
+// IdentityProviderEndpoint represents a single endpoint of an Identity Provider.
+type IdentityProviderEndpoint struct {
+	URL         string `json:"url,omitempty"`
+	Binding     string `json:"binding,omitempty"`
+	Destination string `json:"destination,omitempty"`
+	Type        string `json:"type,omitempty"`
+}
+
+// IdentityProviderAlgorithms represents the signature algorithms used for requests and responses.
+//
+// https://developer.okta.com/docs/reference/api/idps/#saml-2-0-algorithms-object
+type IdentityProviderAlgorithms struct {
+	Request  *IdentityProviderAlgorithm `json:"request,omitempty"`
+	Response *IdentityProviderAlgorithm `json:"response,omitempty"`
+}
+
+// IdentityProviderAlgorithm is a helper struct.
+type IdentityProviderAlgorithm struct {
+	Signature struct {
+		Algorithm string `json:"algorithm,omitempty"`
+		// Scope has possible values of: "RESPONSE", "ASSERTION", "ANY", "REQUEST", "NONE"
+		Scope string `json:"scope,omitempty"`
+	} `json:"signature"`
+}
+
+// IdentityProviderProtocolSettings represents the SAML settings of an Identity Provider.
+//
+// https://developer.okta.com/docs/reference/api/idps/#saml-2-0-settings-object
+type IdentityProviderProtocolSettings struct {
+	NameFormat string `json:"nameFormat,omitempty"`
+}
+
+// IdentityProviderProtocolCredentials represents the credentials for the protocol.
+//
+// https://developer.okta.com/docs/reference/api/idps/#saml-2-0-credentials-object
+type IdentityProviderProtocolCredentials struct {
+	Client  *IdentityProviderClientCredential  `json:"client,omitempty"`
+	Trust   *IdentityProviderTrustCredential   `json:"trust,omitempty"`
+	Signing *IdentityProviderSigningCredential `json:"signing,omitempty"`
+}
+
+// IdentityProviderClientCredential represents the OAuth 2.0 client credentials of an OIDC or social Identity Provider.
+type IdentityProviderClientCredential struct {
+	ClientID                string `json:"client_id,omitempty"`
+	ClientSecret            string `json:"client_secret,omitempty"`
+	TokenEndpointAuthMethod string `json:"token_endpoint_auth_method,omitempty"`
+}
+
+// IdentityProviderTrustCredential represents the trust settings used to validate SAML assertions.
+type IdentityProviderTrustCredential struct {
+	Issuer                  string `json:"issuer,omitempty"`
+	Audience                string `json:"audience,omitempty"`
+	KID                     string `json:"kid,omitempty"`
+	Revocation              string `json:"revocation,omitempty"`
+	RevocationCacheLifetime int    `json:"revocationCacheLifetime,omitempty"`
+}
+
+// IdentityProviderSigningCredential determines the key used for signing requests to the Identity Provider.
+type IdentityProviderSigningCredential struct {
+	KID string `json:"kid,omitempty"`
+}
+
+// IdentityProviderRelayState is a helper struct.
+type IdentityProviderRelayState struct {
+	Format string `json:"format,omitempty"`
+}
+
+// IdentityProviderPolicy represents the provisioning and account linking policy of an Identity Provider.
+//
+// https://developer.okta.com/docs/reference/api/idps/#identity-provider-policy-object
+type IdentityProviderPolicy struct {
+	Provisioning IdentityProviderProvisioningPolicy `json:"provisioning"`
+	AccountLink  IdentityProviderAccountLinkPolicy  `json:"accountLink"`
+	Subject      IdentityProviderSubjectPolicy      `json:"subject"`
+	MaxClockSkew int                                `json:"maxClockSkew"`
+}
+
+// IdentityProviderProvisioningPolicy determines how users are provisioned from an Identity Provider.
+//
+// https://developer.okta.com/docs/reference/api/idps/#provisioning-policy-object
+type IdentityProviderProvisioningPolicy struct {
+	// Action has possible values of: "AUTO", "CALLOUT", "DISABLED"
+	Action        string `json:"action"`
+	ProfileMaster bool   `json:"profileMaster"`
+	Groups        struct {
+		// Action has possible values of: "NONE", "APPEND", "SYNC", "ASSIGN"
+		Action              string   `json:"action"`
+		Assignments         []string `json:"assignments,omitempty"`
+		Filter              []string `json:"filter,omitempty"`
+		SourceAttributeName string   `json:"sourceAttributeName,omitempty"`
+	} `json:"groups"`
+	Conditions struct {
+		Deprovisioned struct {
+			// Action has possible values of: "NONE", "REACTIVATE"
+			Action string `json:"action"`
+		} `json:"deprovisioned"`
+		Suspended struct {
+			// Action has possible values of: "NONE", "UNSUSPEND"
+			Action string `json:"action"`
+		} `json:"suspended"`
+	} `json:"conditions"`
+}
+
+// IdentityProviderAccountLinkPolicy determines how Identity Provider users are linked to Okta users.
+//
+// https://developer.okta.com/docs/reference/api/idps/#account-link-policy-object
+type IdentityProviderAccountLinkPolicy struct {
+	// Action has possible values of: "AUTO", "DISABLED"
+	Action string `json:"action"`
+	Filter *struct {
+		Groups struct {
+			Include []string `json:"include"`
+		} `json:"groups"`
+	} `json:"filter,omitempty"`
+}
+
+// IdentityProviderSubjectPolicy determines how the Identity Provider subject is matched to an Okta user.
+//
+// https://developer.okta.com/docs/reference/api/idps/#subject-policy-object
+type IdentityProviderSubjectPolicy struct {
+	UserNameTemplate struct {
+		Template string `json:"template"`
+	} `json:"userNameTemplate"`
+	Filter string `json:"filter,omitempty"`
+	// MatchType has possible values of: "USERNAME", "EMAIL", "USERNAME_OR_EMAIL", "CUSTOM_ATTRIBUTE"
+	MatchType      string `json:"matchType"`
+	MatchAttribute string `json:"matchAttribute,omitempty"`
+}
+
+// IdentityProviderListParams is a helper struct for calling List().
+type IdentityProviderListParams struct {
+	Q    string
+	Type IdentityProviderType
+}
+
+// JSONWebKey represents a key in the Identity Provider key store, or a signing key.
+//
+// https://developer.okta.com/docs/reference/api/idps/#identity-provider-key-credential-object
+type JSONWebKey struct {
+	KID         string      `json:"kid"`
+	Kty         string      `json:"kty"`
+	Use         string      `json:"use,omitempty"`
+	Alg         string      `json:"alg,omitempty"`
+	E           string      `json:"e,omitempty"`
+	N           string      `json:"n,omitempty"`
+	X5C         []string    `json:"x5c,omitempty"`
+	X5TS256     string      `json:"x5t#S256,omitempty"`
+	Status      string      `json:"status,omitempty"`
+	Created     Timestamp   `json:"created,omitempty"`
+	LastUpdated Timestamp   `json:"lastUpdated,omitempty"`
+	ExpiresAt   Timestamp   `json:"expiresAt,omitempty"`
+	Links       interface{} `json:"_links,omitempty"`
+}
+
+// CSR represents a Certificate Signing Request generated for an Identity Provider.
+//
+// https://developer.okta.com/docs/reference/api/idps/#identity-provider-csr-object
+type CSR struct {
+	ID      string    `json:"id"`
+	Created Timestamp `json:"created"`
+	// CSR is the base64 encoded DER of the Certificate Signing Request.
+	CSR   string      `json:"csr"`
+	Kty   string      `json:"kty"`
+	Links interface{} `json:"_links,omitempty"`
+}
+
+// CSRMetadata is the subject information used to generate a Certificate Signing Request.
+//
+// https://developer.okta.com/docs/reference/api/idps/#csr-metadata-object
+type CSRMetadata struct {
+	Subject struct {
+		CountryName            string `json:"countryName,omitempty"`
+		StateOrProvinceName    string `json:"stateOrProvinceName,omitempty"`
+		LocalityName           string `json:"localityName,omitempty"`
+		OrganizationName       string `json:"organizationName,omitempty"`
+		OrganizationalUnitName string `json:"organizationalUnitName,omitempty"`
+		CommonName             string `json:"commonName,omitempty"`
+	} `json:"subject"`
+	SubjectAltNames struct {
+		DNSNames []string `json:"dnsNames,omitempty"`
+	} `json:"subjectAltNames"`
+}
+
+// IdentityProviderUser represents an Okta user linked to an Identity Provider. Profile contains the
+// attributes received from the Identity Provider.
+//
+// https://developer.okta.com/docs/reference/api/idps/#identity-provider-user-object
+type IdentityProviderUser struct {
+	RawJSON
+	ID          string                 `json:"id"`
+	ExternalID  string                 `json:"externalId"`
+	Created     Timestamp              `json:"created"`
+	LastUpdated Timestamp              `json:"lastUpdated"`
+	Profile     map[string]interface{} `json:"profile"`
+	Links       interface{}            `json:"_links,omitempty"`
+}
+
+// SocialAuthToken represents a token minted by a social Identity Provider.
+//
+// https://developer.okta.com/docs/reference/api/idps/#identity-provider-social-authentication-token-object
+type SocialAuthToken struct {
+	ID              string    `json:"id"`
+	Token           string    `json:"token"`
+	TokenType       string    `json:"tokenType"`
+	TokenAuthScheme string    `json:"tokenAuthScheme"`
+	Scopes          []string  `json:"scopes"`
+	ExpiresAt       Timestamp `json:"expiresAt"`
+}
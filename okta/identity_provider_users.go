@@ -0,0 +1,94 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListUsers fetches the first page of the users linked to an Identity Provider.
+//
+// https://developer.okta.com/docs/reference/api/idps/#find-users
+func (s *IdentityProvidersService) ListUsers(ctx context.Context, idpID string) (*Collection[*IdentityProviderUser], *Response, error) {
+	path := fmt.Sprintf("idps/%s/users?limit=%d", idpID, 20)
+	return listPage[*IdentityProviderUser](ctx, s.client, RateLimitCategoryCore, path)
+}
+
+// GetUser fetches a linked user, including the profile received from the Identity Provider.
+//
+// https://developer.okta.com/docs/reference/api/idps/#get-a-linked-identity-provider-user
+func (s *IdentityProvidersService) GetUser(ctx context.Context, idpID string, userID string) (*IdentityProviderUser, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("idps/%s/users/%s", idpID, userID)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	idpUserOut := new(IdentityProviderUser)
+	resp, err := s.client.Do(ctx, req, idpUserOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return idpUserOut, resp, nil
+}
+
+// LinkUser links an existing Okta user to the subject externalID of an Identity Provider.
+//
+// https://developer.okta.com/docs/reference/api/idps/#link-a-user-to-a-social-provider-without-a-transaction
+func (s *IdentityProvidersService) LinkUser(ctx context.Context, idpID string, userID string, externalID string) (*IdentityProviderUser, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("idps/%s/users/%s", idpID, userID)
+
+	body := map[string]interface{}{"externalId": externalID}
+
+	req, err := s.client.NewRequest("POST", path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	idpUserOut := new(IdentityProviderUser)
+	resp, err := s.client.Do(ctx, req, idpUserOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return idpUserOut, resp, nil
+}
+
+// UnlinkUser removes the link between an Okta user and an Identity Provider.
+//
+// https://developer.okta.com/docs/reference/api/idps/#unlink-user-from-idp
+func (s *IdentityProvidersService) UnlinkUser(ctx context.Context, idpID string, userID string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("idps/%s/users/%s", idpID, userID)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListSocialAuthTokens fetches the tokens minted by a social Identity Provider when the user authenticated.
+//
+// https://developer.okta.com/docs/reference/api/idps/#social-authentication-token-operation
+func (s *IdentityProvidersService) ListSocialAuthTokens(ctx context.Context, idpID string, userID string) ([]*SocialAuthToken, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("idps/%s/users/%s/credentials/tokens", idpID, userID)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tokens []*SocialAuthToken
+	resp, err := s.client.Do(ctx, req, &tokens)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tokens, resp, nil
+}
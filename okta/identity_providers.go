@@ -0,0 +1,142 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// IdentityProvidersService is the service providing access to the Identity Providers Resource in the Okta API
+type IdentityProvidersService service
+
+// GetByID fetches an Identity Provider by ID.
+//
+// https://developer.okta.com/docs/reference/api/idps/#get-identity-provider
+func (s *IdentityProvidersService) GetByID(ctx context.Context, id string) (*IdentityProvider, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("idps/%s", id)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	idpOut := new(IdentityProvider)
+	resp, err := s.client.Do(ctx, req, idpOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return idpOut, resp, nil
+}
+
+// List fetches the first page of Identity Providers, optionally filtered by params.
+//
+// https://developer.okta.com/docs/reference/api/idps/#list-identity-providers
+func (s *IdentityProvidersService) List(ctx context.Context, params *IdentityProviderListParams) (*Collection[*IdentityProvider], *Response, error) {
+	query := url.Values{}
+	query.Set("limit", "20")
+	if params != nil {
+		if params.Q != "" {
+			query.Set("q", params.Q)
+		}
+		if params.Type != "" {
+			query.Set("type", string(params.Type))
+		}
+	}
+	path := fmt.Sprintf("idps?%s", query.Encode())
+
+	return listPage[*IdentityProvider](ctx, s.client, RateLimitCategoryCore, path)
+}
+
+// Add creates a new Identity Provider.
+//
+// https://developer.okta.com/docs/reference/api/idps/#add-identity-provider
+func (s *IdentityProvidersService) Add(ctx context.Context, idpIn *IdentityProvider) (*IdentityProvider, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "idps"
+
+	req, err := s.client.NewRequest("POST", path, idpIn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	idpOut := new(IdentityProvider)
+	resp, err := s.client.Do(ctx, req, idpOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return idpOut, resp, nil
+}
+
+// Update modifies an Identity Provider.
+//
+// Note that delta updates are not supported. You must pass a full IdentityProvider object.
+//
+// https://developer.okta.com/docs/reference/api/idps/#update-identity-provider
+func (s *IdentityProvidersService) Update(ctx context.Context, id string, idpIn *IdentityProvider) (*IdentityProvider, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("idps/%s", id)
+
+	req, err := s.client.NewRequest("PUT", path, idpIn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	idpOut := new(IdentityProvider)
+	resp, err := s.client.Do(ctx, req, idpOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return idpOut, resp, nil
+}
+
+// Remove deletes an Identity Provider.
+//
+// https://developer.okta.com/docs/reference/api/idps/#delete-identity-provider
+func (s *IdentityProvidersService) Remove(ctx context.Context, id string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("idps/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Activate activates an inactive Identity Provider.
+//
+// https://developer.okta.com/docs/reference/api/idps/#activate-identity-provider
+func (s *IdentityProvidersService) Activate(ctx context.Context, id string) (*IdentityProvider, *Response, error) {
+	return s.lifecycle(ctx, id, "activate")
+}
+
+// Deactivate deactivates an active Identity Provider.
+//
+// https://developer.okta.com/docs/reference/api/idps/#deactivate-identity-provider
+func (s *IdentityProvidersService) Deactivate(ctx context.Context, id string) (*IdentityProvider, *Response, error) {
+	return s.lifecycle(ctx, id, "deactivate")
+}
+
+// lifecycle is a helper function for the lifecycle operations.
+func (s *IdentityProvidersService) lifecycle(ctx context.Context, id string, operation string) (*IdentityProvider, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("idps/%s/lifecycle/%s", id, operation)
+
+	req, err := s.client.NewRequest("POST", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	idpOut := new(IdentityProvider)
+	resp, err := s.client.Do(ctx, req, idpOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return idpOut, resp, nil
+}
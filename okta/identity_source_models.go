@@ -0,0 +1,48 @@
+package okta
+
+// IdentitySourceSession represents an import session of a custom identity source. Data is
+// loaded into a session with BulkUpsert() and BulkDelete(), and then imported with StartImport().
+//
+// https://developer.okta.com/docs/reference/api/xaas/#identity-source-session-object
+type IdentitySourceSession struct {
+	RawJSON
+	ID               string                      `json:"id"`
+	IdentitySourceID string                      `json:"identitySourceId"`
+	Status           IdentitySourceSessionStatus `json:"status"`
+	ImportType       string                      `json:"importType"`
+	Created          Timestamp                   `json:"created"`
+	LastUpdated      Timestamp                   `json:"lastUpdated"`
+}
+
+// IdentitySourceSessionStatus is a type for the IdentitySourceSessionStatus enum.
+//
+// https://developer.okta.com/docs/reference/api/xaas/#identity-source-session-object
+type IdentitySourceSessionStatus string
+
+// IdentitySourceSessionStatus Constants
+//
+// https://developer.okta.com/docs/reference/api/xaas/#identity-source-session-object
+const (
+	IdentitySourceSessionStatusCreated    IdentitySourceSessionStatus = "CREATED"
+	IdentitySourceSessionStatusTriggered                              = "TRIGGERED"
+	IdentitySourceSessionStatusInProgress                             = "IN_PROGRESS"
+	IdentitySourceSessionStatusCompleted                              = "COMPLETED"
+	IdentitySourceSessionStatusClosed                                 = "CLOSED"
+	IdentitySourceSessionStatusError                                  = "ERROR"
+	IdentitySourceSessionStatusExpired                                = "EXPIRED"
+)
+
+// IdentitySourceUser represents a user record loaded into an identity source session.
+// Profile is only sent for upserts.
+//
+// https://developer.okta.com/docs/reference/api/xaas/#bulk-upsert-request-object
+type IdentitySourceUser struct {
+	ExternalID string                 `json:"externalId"`
+	Profile    map[string]interface{} `json:"profile,omitempty"`
+}
+
+// identitySourceBulkRequest is the body of the bulk upsert and bulk delete requests.
+type identitySourceBulkRequest struct {
+	EntityType string                `json:"entityType"`
+	Profiles   []*IdentitySourceUser `json:"profiles"`
+}
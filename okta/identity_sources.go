@@ -0,0 +1,136 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// IdentitySourcesService is the service providing access to the Identity Sources Resource in the Okta API
+type IdentitySourcesService service
+
+// identitySourceBulkLimit is the maximum number of users in a single bulk request.
+const identitySourceBulkLimit = 200
+
+// CreateSession creates an import session for a custom identity source. Only one session per
+// identity source can be open at a time.
+//
+// https://developer.okta.com/docs/reference/api/xaas/#create-an-identity-source-session
+func (s *IdentitySourcesService) CreateSession(ctx context.Context, identitySourceID string) (*IdentitySourceSession, *Response, error) {
+	return s.doSession(ctx, "POST", fmt.Sprintf("identity-sources/%s/sessions", identitySourceID))
+}
+
+// ListSessions fetches the open import sessions of a custom identity source.
+//
+// https://developer.okta.com/docs/reference/api/xaas/#retrieve-active-identity-source-sessions
+func (s *IdentitySourcesService) ListSessions(ctx context.Context, identitySourceID string) ([]*IdentitySourceSession, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("identity-sources/%s/sessions", identitySourceID)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sessions []*IdentitySourceSession
+	resp, err := s.client.Do(ctx, req, &sessions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sessions, resp, nil
+}
+
+// GetSession fetches an import session of a custom identity source.
+//
+// https://developer.okta.com/docs/reference/api/xaas/#retrieve-an-identity-source-session
+func (s *IdentitySourcesService) GetSession(ctx context.Context, identitySourceID, sessionID string) (*IdentitySourceSession, *Response, error) {
+	return s.doSession(ctx, "GET", fmt.Sprintf("identity-sources/%s/sessions/%s", identitySourceID, sessionID))
+}
+
+// RemoveSession deletes an import session that hasn't been imported yet.
+//
+// https://developer.okta.com/docs/reference/api/xaas/#delete-an-identity-source-session
+func (s *IdentitySourcesService) RemoveSession(ctx context.Context, identitySourceID, sessionID string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("identity-sources/%s/sessions/%s", identitySourceID, sessionID)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// BulkUpsert loads users to be created or updated into an import session. Users are sent in
+// batches of at most 200, the limit of the API.
+//
+// https://developer.okta.com/docs/reference/api/xaas/#upload-the-data-to-be-inserted-or-updated-in-okta
+func (s *IdentitySourcesService) BulkUpsert(ctx context.Context, identitySourceID, sessionID string, users []*IdentitySourceUser) (*Response, error) {
+	return s.bulk(ctx, identitySourceID, sessionID, "bulk-upsert", users)
+}
+
+// BulkDelete loads users to be deactivated into an import session. Only the ExternalID of
+// the users is sent. Users are sent in batches of at most 200, the limit of the API.
+//
+// https://developer.okta.com/docs/reference/api/xaas/#upload-the-data-to-be-deleted-in-okta
+func (s *IdentitySourcesService) BulkDelete(ctx context.Context, identitySourceID, sessionID string, users []*IdentitySourceUser) (*Response, error) {
+	ids := make([]*IdentitySourceUser, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, &IdentitySourceUser{ExternalID: u.ExternalID})
+	}
+	return s.bulk(ctx, identitySourceID, sessionID, "bulk-delete", ids)
+}
+
+// StartImport triggers the import of the data loaded into an import session. The session can't
+// be modified afterwards. Poll GetSession() to follow the import.
+//
+// https://developer.okta.com/docs/reference/api/xaas/#start-the-import-from-the-identity-source
+func (s *IdentitySourcesService) StartImport(ctx context.Context, identitySourceID, sessionID string) (*IdentitySourceSession, *Response, error) {
+	return s.doSession(ctx, "POST", fmt.Sprintf("identity-sources/%s/sessions/%s/start-import", identitySourceID, sessionID))
+}
+
+// doSession is a helper function for the requests returning an IdentitySourceSession.
+func (s *IdentitySourcesService) doSession(ctx context.Context, method, path string) (*IdentitySourceSession, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	req, err := s.client.NewRequest(method, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sessionOut := new(IdentitySourceSession)
+	resp, err := s.client.Do(ctx, req, sessionOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sessionOut, resp, nil
+}
+
+// bulk is a helper function for BulkUpsert and BulkDelete that sends users in batches.
+func (s *IdentitySourcesService) bulk(ctx context.Context, identitySourceID, sessionID, operation string, users []*IdentitySourceUser) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("identity-sources/%s/sessions/%s/%s", identitySourceID, sessionID, operation)
+
+	var resp *Response
+	for start := 0; start < len(users); start += identitySourceBulkLimit {
+		end := start + identitySourceBulkLimit
+		if end > len(users) {
+			end = len(users)
+		}
+
+		body := &identitySourceBulkRequest{EntityType: "USERS", Profiles: users[start:end]}
+		req, err := s.client.NewRequest("POST", path, body)
+		if err != nil {
+			return resp, err
+		}
+
+		resp, err = s.client.Do(ctx, req, nil)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,411 @@
+package okta
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// idxContentType is the media type of the requests and responses of the IDX API.
+const idxContentType = "application/ion+json; okta-version=1.0.0"
+
+// IDXRemediationName is a type for the names of the remediations of the IDX API, the next steps
+// of an IDXTransaction.
+type IDXRemediationName string
+
+// IDXRemediationName Constants
+const (
+	IDXRemediationIdentify                        IDXRemediationName = "identify"
+	IDXRemediationSelectAuthenticatorAuthenticate                    = "select-authenticator-authenticate"
+	IDXRemediationChallengeAuthenticator                             = "challenge-authenticator"
+	IDXRemediationAuthenticatorVerificationData                      = "authenticator-verification-data"
+	IDXRemediationSelectAuthenticatorEnroll                          = "select-authenticator-enroll"
+	IDXRemediationEnrollAuthenticator                                = "enroll-authenticator"
+	IDXRemediationSelectEnrollProfile                                = "select-enroll-profile"
+	IDXRemediationEnrollProfile                                      = "enroll-profile"
+	IDXRemediationRedirectIDP                                        = "redirect-idp"
+	IDXRemediationSkip                                               = "skip"
+)
+
+// IDXField represents a field of the form of an IDX remediation.
+type IDXField struct {
+	Name     string      `json:"name"`
+	Label    string      `json:"label,omitempty"`
+	Type     string      `json:"type,omitempty"`
+	Required bool        `json:"required,omitempty"`
+	Secret   bool        `json:"secret,omitempty"`
+	Value    interface{} `json:"value,omitempty"`
+	// Options are the values to choose from, e.g. the authenticators to select.
+	Options []*IDXOption `json:"options,omitempty"`
+	// Form holds the nested fields of an object field, e.g. the credentials.
+	Form *struct {
+		Value []*IDXField `json:"value"`
+	} `json:"form,omitempty"`
+}
+
+// IDXOption represents an option of an IDXField.
+type IDXOption struct {
+	Label string          `json:"label"`
+	Value json.RawMessage `json:"value"`
+}
+
+// IDXRemediation represents a remediation of the IDX API, a form to submit to proceed.
+type IDXRemediation struct {
+	Name   IDXRemediationName `json:"name"`
+	Href   string             `json:"href"`
+	Method string             `json:"method"`
+	Value  []*IDXField        `json:"value"`
+}
+
+// Field returns the field name of the form of the remediation, or nil.
+func (r *IDXRemediation) Field(name string) *IDXField {
+	for _, f := range r.Value {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// IDXMessage represents a message of the IDX API, e.g. an invalid passcode.
+type IDXMessage struct {
+	Message string `json:"message"`
+	Class   string `json:"class"`
+	I18n    struct {
+		Key string `json:"key"`
+	} `json:"i18n"`
+}
+
+// IDXAuthenticator represents an authenticator offered by the IDX API.
+type IDXAuthenticator struct {
+	ID          string `json:"id"`
+	Key         string `json:"key"`
+	Type        string `json:"type"`
+	DisplayName string `json:"displayName"`
+	Methods     []struct {
+		Type string `json:"type"`
+	} `json:"methods"`
+}
+
+// IDXResponse represents a response of the IDX API, the state of an IDXTransaction.
+//
+// https://developer.okta.com/docs/guides/oie-embedded-common-org-setup/go/main/
+type IDXResponse struct {
+	Version     string
+	StateHandle string
+	ExpiresAt   string
+	Intent      string
+	// Remediations are the next steps offered.
+	Remediations []*IDXRemediation
+	Messages     []*IDXMessage
+	// Authenticators are the authenticators offered to authenticate, or to enroll.
+	Authenticators []*IDXAuthenticator
+	// AuthenticatorEnrollments are the authenticators the user enrolled.
+	AuthenticatorEnrollments []*IDXAuthenticator
+	// SuccessWithInteractionCode is set once the user is authenticated; it holds the interaction
+	// code exchanged for tokens.
+	SuccessWithInteractionCode *IDXRemediation
+	Cancel                     *IDXRemediation
+	// Raw holds all the properties, e.g. currentAuthenticator.
+	Raw map[string]json.RawMessage
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, flattening the collections of the
+// response, e.g. {"remediation": {"type": "array", "value": [...]}}.
+func (r *IDXResponse) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		Version     string `json:"version"`
+		StateHandle string `json:"stateHandle"`
+		ExpiresAt   string `json:"expiresAt"`
+		Intent      string `json:"intent"`
+		Remediation struct {
+			Value []*IDXRemediation `json:"value"`
+		} `json:"remediation"`
+		Messages struct {
+			Value []*IDXMessage `json:"value"`
+		} `json:"messages"`
+		Authenticators struct {
+			Value []*IDXAuthenticator `json:"value"`
+		} `json:"authenticators"`
+		AuthenticatorEnrollments struct {
+			Value []*IDXAuthenticator `json:"value"`
+		} `json:"authenticatorEnrollments"`
+		SuccessWithInteractionCode *IDXRemediation `json:"successWithInteractionCode"`
+		Cancel                     *IDXRemediation `json:"cancel"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*r = IDXResponse{
+		Version:                    aux.Version,
+		StateHandle:                aux.StateHandle,
+		ExpiresAt:                  aux.ExpiresAt,
+		Intent:                     aux.Intent,
+		Remediations:               aux.Remediation.Value,
+		Messages:                   aux.Messages.Value,
+		Authenticators:             aux.Authenticators.Value,
+		AuthenticatorEnrollments:   aux.AuthenticatorEnrollments.Value,
+		SuccessWithInteractionCode: aux.SuccessWithInteractionCode,
+		Cancel:                     aux.Cancel,
+	}
+	return json.Unmarshal(data, &r.Raw)
+}
+
+// Remediation returns the remediation name offered by the response, or nil.
+func (r *IDXResponse) Remediation(name IDXRemediationName) *IDXRemediation {
+	for _, rem := range r.Remediations {
+		if rem.Name == name {
+			return rem
+		}
+	}
+	return nil
+}
+
+// Succeeded reports whether the user is authenticated, and the tokens can be obtained.
+func (r *IDXResponse) Succeeded() bool {
+	return r.SuccessWithInteractionCode != nil
+}
+
+// IDXError represents an error response of the IDX API, e.g. an invalid password. The
+// remediations of its response can still be followed, e.g. to retry.
+type IDXError struct {
+	StatusCode int
+	Messages   []*IDXMessage
+}
+
+func (e *IDXError) Error() string {
+	messages := make([]string, 0, len(e.Messages))
+	for _, m := range e.Messages {
+		messages = append(messages, m.Message)
+	}
+	return fmt.Sprintf("okta: (%d) %s", e.StatusCode, strings.Join(messages, "; "))
+}
+
+// IDXTransaction represents an authentication of the Identity Engine through the IDX API, for an
+// embedded login: the user is identified, then challenged, or enrolled, for the authenticators
+// the policies require, following the remediations of each response, until the transaction
+// succeeds and the interaction code is exchanged for tokens.
+//
+//	tx, err := flow.StartIDX(ctx)
+//	_, err = tx.Identify(ctx, "jdoe@example.com", "password")
+//	if !tx.Response.Succeeded() {
+//		// Select an authenticator of tx.Response.Authenticators, and challenge it.
+//	}
+//	token, err := tx.Token(ctx)
+//
+// InteractionHandle and CodeVerifier identify the transaction across the requests of the user;
+// ResumeIDX() resumes it.
+//
+// https://developer.okta.com/docs/concepts/interaction-code/
+type IDXTransaction struct {
+	InteractionHandle string
+	CodeVerifier      string
+	// Response is the latest response of the IDX API.
+	Response *IDXResponse
+
+	flow *AuthCodeFlow
+}
+
+// StartIDX starts a new IDX transaction with the interact endpoint of the authorization server,
+// for the scopes of the flow. The application must have the Interaction Code grant type enabled.
+//
+// https://developer.okta.com/docs/reference/api/oidc/#interact
+func (f *AuthCodeFlow) StartIDX(ctx context.Context) (*IDXTransaction, error) {
+	state, err := randomString(16)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := randomString(32)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"client_id":             {f.ClientID},
+		"scope":                 {strings.Join(f.scopes(), " ")},
+		"redirect_uri":          {f.RedirectURI},
+		"state":                 {state},
+		"code_challenge":        {codeChallenge(verifier)},
+		"code_challenge_method": {"S256"},
+	}
+	data, err := postOAuthForm(ctx, f.HTTPClient, oauthEndpoint(f.Issuer, "interact"), form, f.clientAuth(form))
+	if err != nil {
+		return nil, err
+	}
+	var interaction struct {
+		InteractionHandle string `json:"interaction_handle"`
+	}
+	if err := json.Unmarshal(data, &interaction); err != nil {
+		return nil, err
+	}
+	return f.ResumeIDX(ctx, interaction.InteractionHandle, verifier)
+}
+
+// ResumeIDX resumes the IDX transaction interactionHandle, started with the code verifier
+// codeVerifier, fetching its current state.
+func (f *AuthCodeFlow) ResumeIDX(ctx context.Context, interactionHandle, codeVerifier string) (*IDXTransaction, error) {
+	tx := &IDXTransaction{InteractionHandle: interactionHandle, CodeVerifier: codeVerifier, flow: f}
+	href, err := idxURL(f.Issuer, "introspect")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.post(ctx, href, map[string]interface{}{"interactionHandle": interactionHandle}); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Proceed submits the form of the remediation name of the current response with values, and
+// returns the new state of the transaction. The state handle is added to values.
+func (t *IDXTransaction) Proceed(ctx context.Context, name IDXRemediationName, values map[string]interface{}) (*IDXResponse, error) {
+	remediation := t.Response.Remediation(name)
+	if remediation == nil {
+		names := make([]string, 0, len(t.Response.Remediations))
+		for _, r := range t.Response.Remediations {
+			names = append(names, string(r.Name))
+		}
+		return nil, fmt.Errorf("okta: remediation %s not offered, the next steps are %v", name, names)
+	}
+	return t.submit(ctx, remediation, values)
+}
+
+// Identify identifies the user by identifier, their username, and checks their password too if
+// it's asked on the same form.
+func (t *IDXTransaction) Identify(ctx context.Context, identifier, password string) (*IDXResponse, error) {
+	values := map[string]interface{}{"identifier": identifier}
+	if password != "" {
+		values["credentials"] = map[string]interface{}{"passcode": password}
+	}
+	return t.Proceed(ctx, IDXRemediationIdentify, values)
+}
+
+// SelectAuthenticator selects the authenticator authenticatorID, to authenticate or enroll
+// depending on the remediation offered, with methodType, e.g. "sms", if the authenticator has
+// several methods.
+func (t *IDXTransaction) SelectAuthenticator(ctx context.Context, authenticatorID, methodType string) (*IDXResponse, error) {
+	authenticator := map[string]interface{}{"id": authenticatorID}
+	if methodType != "" {
+		authenticator["methodType"] = methodType
+	}
+	name := IDXRemediationName(IDXRemediationSelectAuthenticatorAuthenticate)
+	if t.Response.Remediation(name) == nil {
+		name = IDXRemediationSelectAuthenticatorEnroll
+	}
+	return t.Proceed(ctx, name, map[string]interface{}{"authenticator": authenticator})
+}
+
+// Challenge answers the challenge of the selected authenticator with passcode, e.g. the password
+// or the code sent by email.
+func (t *IDXTransaction) Challenge(ctx context.Context, passcode string) (*IDXResponse, error) {
+	return t.Proceed(ctx, IDXRemediationChallengeAuthenticator, map[string]interface{}{
+		"credentials": map[string]interface{}{"passcode": passcode},
+	})
+}
+
+// Enroll enrolls the selected authenticator with credentials, e.g. {"passcode": "..."} for a new
+// password or the code of a new phone.
+func (t *IDXTransaction) Enroll(ctx context.Context, credentials map[string]interface{}) (*IDXResponse, error) {
+	return t.Proceed(ctx, IDXRemediationEnrollAuthenticator, map[string]interface{}{"credentials": credentials})
+}
+
+// Skip skips the optional step of the current response, e.g. the enrollment of an optional
+// authenticator.
+func (t *IDXTransaction) Skip(ctx context.Context) (*IDXResponse, error) {
+	return t.Proceed(ctx, IDXRemediationSkip, nil)
+}
+
+// Cancel cancels the transaction.
+func (t *IDXTransaction) Cancel(ctx context.Context) error {
+	if t.Response.Cancel == nil {
+		return errors.New("okta: the transaction can't be cancelled")
+	}
+	_, err := t.submit(ctx, t.Response.Cancel, nil)
+	return err
+}
+
+// Token exchanges the interaction code of the succeeded transaction for tokens.
+//
+// https://developer.okta.com/docs/reference/api/oidc/#token
+func (t *IDXTransaction) Token(ctx context.Context) (*OAuthToken, error) {
+	if !t.Response.Succeeded() {
+		return nil, errors.New("okta: the transaction hasn't succeeded")
+	}
+	var code string
+	if field := t.Response.SuccessWithInteractionCode.Field("interaction_code"); field != nil {
+		code, _ = field.Value.(string)
+	}
+	if code == "" {
+		return nil, errors.New("okta: no interaction code in the response")
+	}
+	return t.flow.token(ctx, url.Values{
+		"grant_type":       {"interaction_code"},
+		"interaction_code": {code},
+		"code_verifier":    {t.CodeVerifier},
+	})
+}
+
+// submit submits the form of remediation with values and the state handle.
+func (t *IDXTransaction) submit(ctx context.Context, remediation *IDXRemediation, values map[string]interface{}) (*IDXResponse, error) {
+	body := map[string]interface{}{"stateHandle": t.Response.StateHandle}
+	for name, value := range values {
+		body[name] = value
+	}
+	return t.post(ctx, remediation.Href, body)
+}
+
+// post posts body to href, an endpoint of the IDX API, and makes the response the state of the
+// transaction. An error response still updates the state if it holds one, and is an *IDXError.
+func (t *IDXTransaction) post(ctx context.Context, href string, body interface{}) (*IDXResponse, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", href, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", idxContentType)
+
+	httpClient := t.flow.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	idxResp := new(IDXResponse)
+	if err := json.Unmarshal(data, idxResp); err != nil {
+		return nil, fmt.Errorf("okta: (%d) invalid IDX response: %v", resp.StatusCode, err)
+	}
+	if idxResp.StateHandle != "" || idxResp.Succeeded() {
+		t.Response = idxResp
+	}
+	if resp.StatusCode >= 400 {
+		return idxResp, &IDXError{StatusCode: resp.StatusCode, Messages: idxResp.Messages}
+	}
+	return idxResp, nil
+}
+
+// idxURL returns the URL of the endpoint of the IDX API of the org of the authorization server
+// whose issuer is issuer, e.g. "introspect".
+func idxURL(issuer, endpoint string) (string, error) {
+	u, err := url.Parse(issuer)
+	if err != nil {
+		return "", err
+	}
+	return u.Scheme + "://" + u.Host + "/idp/idx/" + endpoint, nil
+}
@@ -0,0 +1,199 @@
+package okta
+
+import "encoding/json"
+
+// InlineHookRequest represents the outer envelope of a request Okta sends to an inline hook.
+// The hook specific payload is left in Data and can be decoded with one of the typed
+// helpers such as TokenData() or RegistrationData().
+//
+// https://developer.okta.com/docs/concepts/inline-hooks/
+type InlineHookRequest struct {
+	Source            string          `json:"source"`
+	EventID           string          `json:"eventId"`
+	EventTime         Timestamp       `json:"eventTime"`
+	EventTypeVersion  string          `json:"eventTypeVersion"`
+	CloudEventVersion string          `json:"cloudEventVersion"`
+	ContentType       string          `json:"contentType"`
+	EventType         string          `json:"eventType"`
+	Data              json.RawMessage `json:"data"`
+}
+
+// InlineHookEventType Constants
+//
+// https://developer.okta.com/docs/concepts/inline-hooks/#currently-supported-types
+const (
+	InlineHookEventTypeToken          = "com.okta.oauth2.tokens.transform"
+	InlineHookEventTypeRegistration   = "com.okta.user.pre-registration"
+	InlineHookEventTypeSAML           = "com.okta.saml.tokens.transform"
+	InlineHookEventTypePasswordImport = "com.okta.user.credential.password.import"
+)
+
+// InlineHookRequestContext represents the request object embedded in the data.context of inline hook calls.
+type InlineHookRequestContext struct {
+	ID     string `json:"id"`
+	Method string `json:"method"`
+	URL    struct {
+		Value string `json:"value"`
+	} `json:"url"`
+	IPAddress string `json:"ipAddress"`
+}
+
+// InlineHookUserContext represents the user object embedded in the data.context of inline hook calls.
+type InlineHookUserContext struct {
+	ID              string            `json:"id"`
+	PasswordChanged Timestamp         `json:"passwordChanged"`
+	Profile         map[string]string `json:"profile"`
+	Links           interface{}       `json:"_links,omitempty"`
+}
+
+// InlineHookSessionContext represents the session object embedded in the data.context of inline hook calls.
+type InlineHookSessionContext struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"userId"`
+	Login     string    `json:"login"`
+	CreatedAt Timestamp `json:"createdAt"`
+	ExpiresAt Timestamp `json:"expiresAt"`
+	Status    string    `json:"status"`
+	AMR       []string  `json:"amr"`
+	IDP       struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	} `json:"idp"`
+	MFAActive bool `json:"mfaActive"`
+}
+
+// TokenInlineHookData represents the data object sent to a token inline hook.
+//
+// https://developer.okta.com/docs/reference/token-hook/#objects-in-the-request-from-okta
+type TokenInlineHookData struct {
+	Context struct {
+		Request  InlineHookRequestContext `json:"request"`
+		Protocol struct {
+			Type    string                 `json:"type"`
+			Request map[string]interface{} `json:"request"`
+			Issuer  struct {
+				URI string `json:"uri"`
+			} `json:"issuer"`
+			Client struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+				Type string `json:"type"`
+			} `json:"client"`
+		} `json:"protocol"`
+		Session InlineHookSessionContext `json:"session"`
+		User    InlineHookUserContext    `json:"user"`
+		Policy  struct {
+			ID   string `json:"id"`
+			Rule struct {
+				ID string `json:"id"`
+			} `json:"rule"`
+		} `json:"policy"`
+	} `json:"context"`
+	Identity *InlineHookToken `json:"identity,omitempty"`
+	Access   *InlineHookToken `json:"access,omitempty"`
+}
+
+// InlineHookToken represents an ID or access token as presented to a token inline hook.
+type InlineHookToken struct {
+	Claims map[string]interface{} `json:"claims"`
+	Token  struct {
+		Lifetime struct {
+			Expiration int `json:"expiration"`
+		} `json:"lifetime"`
+	} `json:"token"`
+	Scopes map[string]interface{} `json:"scopes,omitempty"`
+}
+
+// RegistrationInlineHookData represents the data object sent to a registration inline hook.
+//
+// https://developer.okta.com/docs/reference/registration-hook/#objects-in-the-request-from-okta
+type RegistrationInlineHookData struct {
+	Context struct {
+		Request InlineHookRequestContext `json:"request"`
+	} `json:"context"`
+	UserProfile map[string]interface{} `json:"userProfile"`
+	Action      string                 `json:"action"`
+}
+
+// SAMLInlineHookData represents the data object sent to a SAML assertion inline hook.
+//
+// https://developer.okta.com/docs/reference/saml-hook/#objects-in-the-request-from-okta
+type SAMLInlineHookData struct {
+	Context struct {
+		Request  InlineHookRequestContext `json:"request"`
+		Protocol struct {
+			Type   string `json:"type"`
+			Issuer struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+				URI  string `json:"uri"`
+			} `json:"issuer"`
+		} `json:"protocol"`
+		Session InlineHookSessionContext `json:"session"`
+		User    InlineHookUserContext    `json:"user"`
+	} `json:"context"`
+	Assertion struct {
+		Subject        map[string]interface{}            `json:"subject"`
+		Authentication map[string]interface{}            `json:"authentication"`
+		Conditions     map[string]interface{}            `json:"conditions"`
+		Claims         map[string]map[string]interface{} `json:"claims"`
+		Lifetime       struct {
+			Expiration int `json:"expiration"`
+		} `json:"lifetime"`
+	} `json:"assertion"`
+}
+
+// PasswordImportInlineHookData represents the data object sent to a password import inline hook.
+//
+// https://developer.okta.com/docs/reference/password-hook/#objects-in-the-request-from-okta
+type PasswordImportInlineHookData struct {
+	Context struct {
+		Request    InlineHookRequestContext `json:"request"`
+		Credential struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+		} `json:"credential"`
+	} `json:"context"`
+	Action struct {
+		Credential string `json:"credential"`
+	} `json:"action"`
+}
+
+// InlineHookResponse represents the response an inline hook returns to Okta.
+// Use NewInlineHookResponse() and the helper methods to build one.
+//
+// https://developer.okta.com/docs/concepts/inline-hooks/#inline-hook-response
+type InlineHookResponse struct {
+	Commands     []InlineHookCommand    `json:"commands,omitempty"`
+	Error        *InlineHookError       `json:"error,omitempty"`
+	DebugContext map[string]interface{} `json:"debugContext,omitempty"`
+}
+
+// InlineHookCommand represents a single command in an inline hook response. Value is either a
+// list of InlineHookPatch objects or, for the update style commands, an object.
+type InlineHookCommand struct {
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// InlineHookCommandType Constants
+const (
+	InlineHookCommandIdentityPatch     = "com.okta.identity.patch"
+	InlineHookCommandAccessPatch       = "com.okta.access.patch"
+	InlineHookCommandUserProfileUpdate = "com.okta.user.profile.update"
+	InlineHookCommandActionUpdate      = "com.okta.action.update"
+	InlineHookCommandAssertionPatch    = "com.okta.assertion.patch"
+)
+
+// InlineHookPatch represents a JSON Patch style operation used by the *.patch commands.
+type InlineHookPatch struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// InlineHookError represents the error object an inline hook can return to Okta.
+type InlineHookError struct {
+	Summary string       `json:"errorSummary"`
+	Causes  []ErrorCause `json:"errorCauses,omitempty"`
+}
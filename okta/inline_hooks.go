@@ -0,0 +1,199 @@
+package okta
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// TokenData decodes the data of a token inline hook request.
+func (r *InlineHookRequest) TokenData() (*TokenInlineHookData, error) {
+	data := new(TokenInlineHookData)
+	return data, r.decodeData(InlineHookEventTypeToken, data)
+}
+
+// RegistrationData decodes the data of a registration inline hook request.
+func (r *InlineHookRequest) RegistrationData() (*RegistrationInlineHookData, error) {
+	data := new(RegistrationInlineHookData)
+	return data, r.decodeData(InlineHookEventTypeRegistration, data)
+}
+
+// SAMLData decodes the data of a SAML assertion inline hook request.
+func (r *InlineHookRequest) SAMLData() (*SAMLInlineHookData, error) {
+	data := new(SAMLInlineHookData)
+	return data, r.decodeData(InlineHookEventTypeSAML, data)
+}
+
+// PasswordImportData decodes the data of a password import inline hook request.
+func (r *InlineHookRequest) PasswordImportData() (*PasswordImportInlineHookData, error) {
+	data := new(PasswordImportInlineHookData)
+	return data, r.decodeData(InlineHookEventTypePasswordImport, data)
+}
+
+func (r *InlineHookRequest) decodeData(eventType string, v interface{}) error {
+	if r.EventType != "" && r.EventType != eventType {
+		return fmt.Errorf("inline hook event type is %q, not %q", r.EventType, eventType)
+	}
+	return json.Unmarshal(r.Data, v)
+}
+
+// NewInlineHookResponse is a helper method to create a new, empty InlineHookResponse.
+func NewInlineHookResponse() *InlineHookResponse {
+	return &InlineHookResponse{}
+}
+
+// AddCommand appends a command to the response.
+func (r *InlineHookResponse) AddCommand(commandType string, value interface{}) *InlineHookResponse {
+	r.Commands = append(r.Commands, InlineHookCommand{Type: commandType, Value: value})
+	return r
+}
+
+// AddIDTokenClaim adds a claim to the ID token issued by a token inline hook.
+func (r *InlineHookResponse) AddIDTokenClaim(name string, value interface{}) *InlineHookResponse {
+	return r.AddCommand(InlineHookCommandIdentityPatch, []InlineHookPatch{
+		{Op: "add", Path: "/claims/" + name, Value: value},
+	})
+}
+
+// AddAccessTokenClaim adds a claim to the access token issued by a token inline hook.
+func (r *InlineHookResponse) AddAccessTokenClaim(name string, value interface{}) *InlineHookResponse {
+	return r.AddCommand(InlineHookCommandAccessPatch, []InlineHookPatch{
+		{Op: "add", Path: "/claims/" + name, Value: value},
+	})
+}
+
+// SetAccessTokenLifetime replaces the lifetime, in seconds, of the access token issued by a token inline hook.
+func (r *InlineHookResponse) SetAccessTokenLifetime(seconds int) *InlineHookResponse {
+	return r.AddCommand(InlineHookCommandAccessPatch, []InlineHookPatch{
+		{Op: "replace", Path: "/token/lifetime/expiration", Value: seconds},
+	})
+}
+
+// AllowRegistration sets whether a registration inline hook allows or denies the registration.
+func (r *InlineHookResponse) AllowRegistration(allow bool) *InlineHookResponse {
+	action := "DENY"
+	if allow {
+		action = "ALLOW"
+	}
+	return r.AddCommand(InlineHookCommandActionUpdate, map[string]string{"registration": action})
+}
+
+// UpdateUserProfile sets profile attributes of the user being registered by a registration inline hook.
+func (r *InlineHookResponse) UpdateUserProfile(profile map[string]interface{}) *InlineHookResponse {
+	return r.AddCommand(InlineHookCommandUserProfileUpdate, profile)
+}
+
+// AddAssertionAttribute adds an attribute statement to the assertion issued by a SAML assertion inline hook.
+func (r *InlineHookResponse) AddAssertionAttribute(name, nameFormat string, values ...string) *InlineHookResponse {
+	attributeValues := make([]map[string]interface{}, 0, len(values))
+	for _, v := range values {
+		attributeValues = append(attributeValues, map[string]interface{}{
+			"attributes": map[string]string{"xsi:type": "xs:string"},
+			"value":      v,
+		})
+	}
+
+	return r.AddCommand(InlineHookCommandAssertionPatch, []InlineHookPatch{
+		{
+			Op:   "add",
+			Path: "/claims/" + name,
+			Value: map[string]interface{}{
+				"attributes":      map[string]string{"NameFormat": nameFormat},
+				"attributeValues": attributeValues,
+			},
+		},
+	})
+}
+
+// SetPasswordVerified tells Okta whether the credentials sent to a password import inline hook are valid.
+func (r *InlineHookResponse) SetPasswordVerified(verified bool) *InlineHookResponse {
+	credential := "UNVERIFIED"
+	if verified {
+		credential = "VERIFIED"
+	}
+	return r.AddCommand(InlineHookCommandActionUpdate, map[string]string{"credential": credential})
+}
+
+// SetError sets the error object of the response.
+func (r *InlineHookResponse) SetError(summary string, causes ...ErrorCause) *InlineHookResponse {
+	r.Error = &InlineHookError{Summary: summary, Causes: causes}
+	return r
+}
+
+// SetDebugContext adds a value to the debugContext object, which Okta writes to the System Log.
+func (r *InlineHookResponse) SetDebugContext(key string, value interface{}) *InlineHookResponse {
+	if r.DebugContext == nil {
+		r.DebugContext = make(map[string]interface{})
+	}
+	r.DebugContext[key] = value
+	return r
+}
+
+// inlineHookErrorSummary is the summary of the error object sent to Okta when Handle fails.
+const inlineHookErrorSummary = "The inline hook failed to process the request"
+
+// InlineHookHandlerFunc processes a single inline hook request.
+type InlineHookHandlerFunc func(ctx context.Context, req *InlineHookRequest) (*InlineHookResponse, error)
+
+// InlineHookHandler is an http.Handler scaffold for services implementing Okta inline hooks.
+// It decodes the request, checks the configured Authorization header, calls Handle, and
+// encodes the returned response.
+//
+// An error returned from Handle is passed to OnError, and Okta is sent a generic error object, so
+// that the details of the error don't end up in the System Log or in front of the end user. Set
+// the error object of the response with SetError() to tell Okta why a request is rejected.
+type InlineHookHandler struct {
+	// Authorization is the expected value of the Authorization header, as configured on
+	// the inline hook in Okta. If empty the header is not checked.
+	Authorization string
+	// Secret, if set, is checked instead of Authorization, and can be rotated while serving.
+	Secret *HookSecret
+	Handle InlineHookHandlerFunc
+	// OnError, if set, is called with the errors returned by Handle, e.g. to log them.
+	OnError func(r *http.Request, err error)
+}
+
+// NewInlineHookHandler is a helper method to create a new InlineHookHandler.
+func NewInlineHookHandler(authorization string, handle InlineHookHandlerFunc) *InlineHookHandler {
+	return &InlineHookHandler{
+		Authorization: authorization,
+		Handle:        handle,
+	}
+}
+
+func (h *InlineHookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if h.Secret != nil && !h.Secret.ValidateRequest(r) ||
+		h.Secret == nil && h.Authorization != "" &&
+			subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(h.Authorization)) != 1 {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	hookReq := new(InlineHookRequest)
+	if err := json.NewDecoder(r.Body).Decode(hookReq); err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	hookResp, err := h.Handle(r.Context(), hookReq)
+	if err != nil {
+		if h.OnError != nil {
+			h.OnError(r, err)
+		}
+		hookResp = NewInlineHookResponse().SetError(inlineHookErrorSummary)
+	}
+	if hookResp == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(hookResp)
+}
@@ -0,0 +1,213 @@
+package okta
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Link represents a HAL link of a resource, an entry of its _links object.
+//
+// https://developer.okta.com/docs/reference/core-okta-api/#links-in-collections
+type Link struct {
+	Href  string     `json:"href"`
+	Name  string     `json:"name,omitempty"`
+	Type  string     `json:"type,omitempty"`
+	Hints *LinkHints `json:"hints,omitempty"`
+}
+
+// LinkHints represents the hints of a Link, the HTTP methods the link accepts.
+type LinkHints struct {
+	Allow []string `json:"allow"`
+}
+
+// Method returns the HTTP method to follow the link with: GET if the link allows it or has no
+// hints, otherwise the first method it allows, e.g. POST for the lifecycle links of a user.
+func (l *Link) Method() string {
+	if l.Hints == nil || len(l.Hints.Allow) == 0 {
+		return http.MethodGet
+	}
+	for _, method := range l.Hints.Allow {
+		if method == http.MethodGet {
+			return http.MethodGet
+		}
+	}
+	return l.Hints.Allow[0]
+}
+
+// Links represents the _links object of a resource, the links by relation. A relation usually
+// has a single link, but some, e.g. the groups of an app, have several.
+type Links map[string][]Link
+
+// UnmarshalJSON decodes a _links object whose relations are either a link or an array of links.
+func (l *Links) UnmarshalJSON(data []byte) error {
+	var rels map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rels); err != nil {
+		return err
+	}
+
+	links := make(Links, len(rels))
+	for rel, raw := range rels {
+		raw = bytes.TrimSpace(raw)
+		if len(raw) > 0 && raw[0] == '[' {
+			var many []Link
+			if err := json.Unmarshal(raw, &many); err != nil {
+				return err
+			}
+			links[rel] = many
+			continue
+		}
+		var one Link
+		if err := json.Unmarshal(raw, &one); err != nil {
+			return err
+		}
+		links[rel] = []Link{one}
+	}
+	*l = links
+	return nil
+}
+
+// Get returns the first link of the relation rel, and whether there is one.
+func (l Links) Get(rel string) (*Link, bool) {
+	if len(l[rel]) == 0 || l[rel][0].Href == "" {
+		return nil, false
+	}
+	return &l[rel][0], true
+}
+
+// parseLinks returns the _links of the JSON object in data, or nil if data isn't an object with
+// links, e.g. an array of resources.
+func parseLinks(data []byte) Links {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '{' {
+		return nil
+	}
+	var resource struct {
+		Links Links `json:"_links"`
+	}
+	if err := json.Unmarshal(data, &resource); err != nil {
+		return nil
+	}
+	return resource.Links
+}
+
+// Link returns the link of the relation rel of the response: the next, prev and self links of the
+// Link headers, or the HAL links of the resource in the body.
+func (r *Response) Link(rel string) (*Link, bool) {
+	if l, ok := r.Links.Get(rel); ok {
+		return l, true
+	}
+
+	var href string
+	switch rel {
+	case "next":
+		href = r.Pagination.Next
+	case "prev":
+		href = r.Pagination.Prev
+	case "self":
+		href = r.Pagination.Self
+	}
+	if href == "" {
+		return nil, false
+	}
+	return &Link{Href: href}, true
+}
+
+// Follow fetches the link of the relation rel of the response, e.g. "next" or the "deactivate"
+// lifecycle link of a user, and decodes the response body into v. The link is requested with the
+// method of its hints, see Link.Method().
+func (r *Response) Follow(ctx context.Context, rel string, v interface{}) (*Response, error) {
+	if r.client == nil {
+		return nil, fmt.Errorf("okta: response wasn't returned by a client, can't follow %q", rel)
+	}
+	l, ok := r.Link(rel)
+	if !ok {
+		return nil, fmt.Errorf("okta: response has no %q link", rel)
+	}
+	return r.client.FollowLink(ctx, l.Method(), l.Href, v)
+}
+
+// FollowLink requests href, a link returned by the API, with method and decodes the response body
+// into v. The request is authenticated and rate limited like the methods of the services, in the
+// rate limit category of the endpoint of href.
+//
+// href must be under the BaseURL of the client, so that the API token isn't sent elsewhere.
+func (c *Client) FollowLink(ctx context.Context, method string, href string, v interface{}) (*Response, error) {
+	path, err := c.linkPath(href)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, rateLimitCategoryOf(method, path))
+	req, err := c.NewRequest(method, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(ctx, req, v)
+}
+
+// linkPath returns href relative to the BaseURL of the client, or an error if href is elsewhere.
+func (c *Client) linkPath(href string) (string, error) {
+	u, err := c.BaseURL.Parse(href)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != c.BaseURL.Scheme || u.Host != c.BaseURL.Host || !strings.HasPrefix(u.Path, c.BaseURL.Path) {
+		return "", fmt.Errorf("okta: link %q isn't under the base URL %q", href, c.BaseURL)
+	}
+
+	path := (&url.URL{Path: strings.TrimPrefix(u.Path, c.BaseURL.Path), RawQuery: u.RawQuery}).String()
+	return strings.TrimPrefix(path, "./"), nil
+}
+
+// rateLimitCategoryOf returns the rate limit category of a request with method of path, relative
+// to the BaseURL.
+//
+// https://developer.okta.com/docs/reference/rl-global-mgmt/
+func rateLimitCategoryOf(method string, path string) RateLimitCategory {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	read := method == http.MethodGet
+
+	switch segments[0] {
+	case "authn":
+		return RateLimitCategoryAuthn
+	case "logs":
+		return RateLimitCategoryLogs
+	case "sessions":
+		return RateLimitCategorySessions
+	case "apps":
+		switch {
+		case len(segments) == 1:
+			return RateLimitCategoryAppsCreateList
+		case len(segments) == 2:
+			return RateLimitCategoryAppsGetUpdateDelete
+		}
+	case "groups":
+		switch {
+		case len(segments) == 1:
+			return RateLimitCategoryGroupsCreateList
+		case len(segments) == 2 && segments[1] != "rules":
+			return RateLimitCategoryGroupsGetUpdateDelete
+		}
+	case "users":
+		switch {
+		case len(segments) == 1:
+			return RateLimitCategoryUsersCreateList
+		case len(segments) == 2 && read && strings.Contains(segments[1], "@"):
+			return RateLimitCategoryUsersGetByLoginName
+		case len(segments) == 2 && read:
+			return RateLimitCategoryUsersGetByID
+		case !read:
+			return RateLimitCategoryUsersCreateUpdateDeleteByID
+		}
+	}
+	return RateLimitCategoryCore
+}
@@ -0,0 +1,153 @@
+package okta
+
+import "time"
+
+// LogEvent represents an event in the System Log.
+//
+// https://developer.okta.com/docs/reference/api/system-log/#logevent-object
+type LogEvent struct {
+	RawJSON
+	UUID            string    `json:"uuid"`
+	Published       Timestamp `json:"published"`
+	EventType       string    `json:"eventType"`
+	Version         string    `json:"version"`
+	LegacyEventType string    `json:"legacyEventType,omitempty"`
+	// Severity has possible values of: "DEBUG", "INFO", "WARN", "ERROR"
+	Severity              string                    `json:"severity"`
+	DisplayMessage        string                    `json:"displayMessage"`
+	Actor                 LogActor                  `json:"actor"`
+	Client                *LogClient                `json:"client,omitempty"`
+	Outcome               *LogOutcome               `json:"outcome,omitempty"`
+	Target                []*LogTarget              `json:"target,omitempty"`
+	Transaction           *LogTransaction           `json:"transaction,omitempty"`
+	DebugContext          *LogDebugContext          `json:"debugContext,omitempty"`
+	AuthenticationContext *LogAuthenticationContext `json:"authenticationContext,omitempty"`
+	SecurityContext       *LogSecurityContext       `json:"securityContext,omitempty"`
+	Request               *LogRequest               `json:"request,omitempty"`
+}
+
+// LogActor represents the entity, usually a user or an app, that performed the action of a LogEvent.
+//
+// https://developer.okta.com/docs/reference/api/system-log/#actor-object
+type LogActor struct {
+	ID          string                 `json:"id"`
+	Type        string                 `json:"type"`
+	AlternateID string                 `json:"alternateId,omitempty"`
+	DisplayName string                 `json:"displayName,omitempty"`
+	DetailEntry map[string]interface{} `json:"detailEntry,omitempty"`
+}
+
+// LogTarget represents an entity affected by the action of a LogEvent.
+//
+// https://developer.okta.com/docs/reference/api/system-log/#target-object
+type LogTarget struct {
+	ID          string                 `json:"id"`
+	Type        string                 `json:"type"`
+	AlternateID string                 `json:"alternateId,omitempty"`
+	DisplayName string                 `json:"displayName,omitempty"`
+	DetailEntry map[string]interface{} `json:"detailEntry,omitempty"`
+}
+
+// LogClient represents the client that requested the action of a LogEvent.
+//
+// https://developer.okta.com/docs/reference/api/system-log/#client-object
+type LogClient struct {
+	UserAgent *struct {
+		RawUserAgent string `json:"rawUserAgent,omitempty"`
+		OS           string `json:"os,omitempty"`
+		Browser      string `json:"browser,omitempty"`
+	} `json:"userAgent,omitempty"`
+	Zone                string                  `json:"zone,omitempty"`
+	Device              string                  `json:"device,omitempty"`
+	ID                  string                  `json:"id,omitempty"`
+	IPAddress           string                  `json:"ipAddress,omitempty"`
+	GeographicalContext *LogGeographicalContext `json:"geographicalContext,omitempty"`
+}
+
+// LogGeographicalContext is a helper struct.
+type LogGeographicalContext struct {
+	City        string `json:"city,omitempty"`
+	State       string `json:"state,omitempty"`
+	Country     string `json:"country,omitempty"`
+	PostalCode  string `json:"postalCode,omitempty"`
+	Geolocation *struct {
+		Lat float64 `json:"lat"`
+		Lon float64 `json:"lon"`
+	} `json:"geolocation,omitempty"`
+}
+
+// LogOutcome represents the outcome of the action of a LogEvent.
+//
+// https://developer.okta.com/docs/reference/api/system-log/#outcome-object
+type LogOutcome struct {
+	// Result has possible values of: "SUCCESS", "FAILURE", "SKIPPED", "ALLOW", "DENY", "CHALLENGE", "UNKNOWN"
+	Result string `json:"result"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// LogTransaction represents the request that triggered a LogEvent.
+//
+// https://developer.okta.com/docs/reference/api/system-log/#transaction-object
+type LogTransaction struct {
+	// Type has possible values of: "WEB", "JOB"
+	Type   string                 `json:"type"`
+	ID     string                 `json:"id"`
+	Detail map[string]interface{} `json:"detail,omitempty"`
+}
+
+// LogDebugContext is a helper struct.
+type LogDebugContext struct {
+	DebugData map[string]interface{} `json:"debugData,omitempty"`
+}
+
+// LogAuthenticationContext represents the context of the authentication of a LogEvent.
+//
+// https://developer.okta.com/docs/reference/api/system-log/#authenticationcontext-object
+type LogAuthenticationContext struct {
+	AuthenticationProvider string `json:"authenticationProvider,omitempty"`
+	CredentialProvider     string `json:"credentialProvider,omitempty"`
+	CredentialType         string `json:"credentialType,omitempty"`
+	Issuer                 *struct {
+		ID   string `json:"id,omitempty"`
+		Type string `json:"type,omitempty"`
+	} `json:"issuer,omitempty"`
+	AuthenticationStep int    `json:"authenticationStep"`
+	ExternalSessionID  string `json:"externalSessionId,omitempty"`
+	Interface          string `json:"interface,omitempty"`
+}
+
+// LogSecurityContext represents the security information of the client IP of a LogEvent.
+//
+// https://developer.okta.com/docs/reference/api/system-log/#securitycontext-object
+type LogSecurityContext struct {
+	ASNumber int    `json:"asNumber,omitempty"`
+	ASOrg    string `json:"asOrg,omitempty"`
+	ISP      string `json:"isp,omitempty"`
+	Domain   string `json:"domain,omitempty"`
+	IsProxy  bool   `json:"isProxy,omitempty"`
+}
+
+// LogRequest represents the IP chain of the request of a LogEvent.
+//
+// https://developer.okta.com/docs/reference/api/system-log/#request-object
+type LogRequest struct {
+	IPChain []struct {
+		IP                  string                  `json:"ip"`
+		GeographicalContext *LogGeographicalContext `json:"geographicalContext,omitempty"`
+		Version             string                  `json:"version,omitempty"`
+		Source              string                  `json:"source,omitempty"`
+	} `json:"ipChain,omitempty"`
+}
+
+// LogListParams is a helper struct for calling List().
+//
+// https://developer.okta.com/docs/reference/api/system-log/#request-parameters
+type LogListParams struct {
+	Since  time.Time
+	Until  time.Time
+	Filter string
+	Q      string
+	// SortOrder has possible values of: "ASCENDING", "DESCENDING"
+	SortOrder string
+	Limit     int
+}
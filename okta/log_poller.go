@@ -0,0 +1,98 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// LogPoller follows the System Log, returning the events in the order they were published.
+// Its position is a cursor that can be persisted with Cursor() and restored with Resume(), so
+// that a process can pick up where it left off.
+type LogPoller struct {
+	client *Client
+	params *LogListParams
+	cursor string
+
+	// Interval is how long Run waits for new events once it has caught up.
+	Interval time.Duration
+}
+
+// NewLogPoller is a helper method to create a new LogPoller starting at the events matching params.
+// params.SortOrder is forced to ASCENDING and params.Until must not be set.
+func NewLogPoller(client *Client, params *LogListParams) *LogPoller {
+	p := &LogPoller{client: client, Interval: 10 * time.Second}
+	if params != nil {
+		paramsCopy := *params
+		p.params = &paramsCopy
+	} else {
+		p.params = new(LogListParams)
+	}
+	p.params.SortOrder = "ASCENDING"
+	p.params.Until = time.Time{}
+	if p.params.Limit == 0 {
+		p.params.Limit = 1000
+	}
+	return p
+}
+
+// Cursor returns the position of the poller, or an empty string before the first page.
+func (p *LogPoller) Cursor() string {
+	return p.cursor
+}
+
+// Resume sets the position of the poller to a cursor returned by Cursor().
+func (p *LogPoller) Resume(cursor string) {
+	p.cursor = cursor
+}
+
+// Next fetches the next page of events and advances the poller. An empty page means the poller
+// has caught up, and that new events should be polled for later.
+func (p *LogPoller) Next(ctx context.Context) ([]*LogEvent, error) {
+	var events []*LogEvent
+	var resp *Response
+	var err error
+	if p.cursor == "" {
+		events, resp, err = p.client.Logs.List(ctx, p.params)
+	} else {
+		events, resp, err = p.client.Logs.ListNext(ctx, p.cursor)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Pagination.Next) > 0 {
+		p.cursor = resp.Pagination.Next
+	}
+	return events, nil
+}
+
+// Run calls fn with every page of events, waiting Interval whenever the poller has caught up,
+// until ctx is done or fn returns an error. Pages rejected by the rate limit are retried once
+// the rate limit resets.
+func (p *LogPoller) Run(ctx context.Context, fn func(events []*LogEvent) error) error {
+	for {
+		events, err := p.Next(ctx)
+		var rateErr *RateLimitError
+		if errors.As(err, &rateErr) {
+			if err := p.client.sleepUntil(ctx, rateErr.Rate.Reset.Time); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(events) > 0 {
+			if err := fn(events); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := p.client.sleepUntil(ctx, p.client.now().Add(p.Interval)); err != nil {
+			return err
+		}
+	}
+}
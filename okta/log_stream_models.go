@@ -0,0 +1,47 @@
+package okta
+
+// LogStream represents a stream delivering System Log events to an external service.
+//
+// https://developer.okta.com/docs/reference/api/log-streaming/#log-stream-object
+type LogStream struct {
+	RawJSON
+	ID          string            `json:"id,omitempty"`
+	Name        string            `json:"name"`
+	Type        LogStreamType     `json:"type"`
+	Status      string            `json:"status,omitempty"`
+	Created     Timestamp         `json:"created,omitempty"`
+	LastUpdated Timestamp         `json:"lastUpdated,omitempty"`
+	Settings    LogStreamSettings `json:"settings"`
+	Links       interface{}       `json:"_links,omitempty"`
+}
+
+// LogStreamType is a type for the LogStreamType enum.
+//
+// https://developer.okta.com/docs/reference/api/log-streaming/#log-stream-type
+type LogStreamType string
+
+// LogStreamType Constants
+//
+// https://developer.okta.com/docs/reference/api/log-streaming/#log-stream-type
+const (
+	LogStreamTypeAWSEventBridge LogStreamType = "aws_eventbridge"
+	LogStreamTypeSplunkCloud                  = "splunk_cloud_logstreaming"
+)
+
+// LogStreamSettings represents the settings of a log stream. The AWS attributes are used with
+// LogStreamTypeAWSEventBridge and the Splunk attributes with LogStreamTypeSplunkCloud.
+//
+// https://developer.okta.com/docs/reference/api/log-streaming/#settings-object
+type LogStreamSettings struct {
+	// AWS EventBridge
+	AccountID       string `json:"accountId,omitempty"`
+	EventSourceName string `json:"eventSourceName,omitempty"`
+	Region          string `json:"region,omitempty"`
+
+	// Splunk Cloud
+	// Edition has possible values of: "aws", "gcp", "aws_govcloud"
+	Edition string `json:"edition,omitempty"`
+	Host    string `json:"host,omitempty"`
+	// Token is a write only property.
+	Token string `json:"token,omitempty"`
+}
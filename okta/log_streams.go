@@ -0,0 +1,99 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// LogStreamsService is the service providing access to the Log Streams Resource in the Okta API
+type LogStreamsService service
+
+// List fetches the first page of log streams, optionally filtered by type.
+//
+// https://developer.okta.com/docs/reference/api/log-streaming/#list-log-streams
+func (s *LogStreamsService) List(ctx context.Context, streamType LogStreamType) (*Collection[*LogStream], *Response, error) {
+	query := url.Values{}
+	query.Set("limit", "20")
+	if streamType != "" {
+		query.Set("filter", fmt.Sprintf("type eq %q", streamType))
+	}
+	path := fmt.Sprintf("logStreams?%s", query.Encode())
+
+	return listPage[*LogStream](ctx, s.client, RateLimitCategoryCore, path)
+}
+
+// GetByID fetches a log stream by ID.
+//
+// https://developer.okta.com/docs/reference/api/log-streaming/#get-log-stream
+func (s *LogStreamsService) GetByID(ctx context.Context, id string) (*LogStream, *Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("logStreams/%s", id), nil)
+}
+
+// Add creates a new log stream.
+//
+// https://developer.okta.com/docs/reference/api/log-streaming/#create-log-stream
+func (s *LogStreamsService) Add(ctx context.Context, streamIn *LogStream) (*LogStream, *Response, error) {
+	return s.do(ctx, "POST", "logStreams", streamIn)
+}
+
+// Update modifies a log stream.
+//
+// Note that delta updates are not supported. You must pass a full LogStream object.
+//
+// https://developer.okta.com/docs/reference/api/log-streaming/#update-log-stream
+func (s *LogStreamsService) Update(ctx context.Context, id string, streamIn *LogStream) (*LogStream, *Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("logStreams/%s", id), streamIn)
+}
+
+// Remove deletes a log stream.
+//
+// https://developer.okta.com/docs/reference/api/log-streaming/#delete-log-stream
+func (s *LogStreamsService) Remove(ctx context.Context, id string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("logStreams/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Activate activates a log stream.
+//
+// https://developer.okta.com/docs/reference/api/log-streaming/#activate-log-stream
+func (s *LogStreamsService) Activate(ctx context.Context, id string) (*LogStream, *Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("logStreams/%s/lifecycle/activate", id), nil)
+}
+
+// Deactivate deactivates a log stream.
+//
+// https://developer.okta.com/docs/reference/api/log-streaming/#deactivate-log-stream
+func (s *LogStreamsService) Deactivate(ctx context.Context, id string) (*LogStream, *Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("logStreams/%s/lifecycle/deactivate", id), nil)
+}
+
+// do is a helper function for the single log stream operations.
+func (s *LogStreamsService) do(ctx context.Context, method string, path string, streamIn *LogStream) (*LogStream, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if streamIn != nil {
+		body = streamIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	streamOut := new(LogStream)
+	resp, err := s.client.Do(ctx, req, streamOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return streamOut, resp, nil
+}
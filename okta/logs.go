@@ -0,0 +1,65 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// LogsService is the service providing access to the System Log Resource in the Okta API
+type LogsService service
+
+// List fetches a page of System Log events matching params.
+//
+// The System Log is a stream: when Until isn't set the response always links to a next page,
+// which returns the events published since. Pass resp.Pagination.Next to ListNext() to follow it,
+// e.g. to poll for new events.
+//
+// https://developer.okta.com/docs/reference/api/system-log/#list-events
+func (s *LogsService) List(ctx context.Context, params *LogListParams) ([]*LogEvent, *Response, error) {
+	query := url.Values{}
+	if params != nil {
+		if !params.Since.IsZero() {
+			query.Set("since", params.Since.UTC().Format(time.RFC3339))
+		}
+		if !params.Until.IsZero() {
+			query.Set("until", params.Until.UTC().Format(time.RFC3339))
+		}
+		if params.Filter != "" {
+			query.Set("filter", params.Filter)
+		}
+		if params.Q != "" {
+			query.Set("q", params.Q)
+		}
+		if params.SortOrder != "" {
+			query.Set("sortOrder", params.SortOrder)
+		}
+		if params.Limit > 0 {
+			query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	path := fmt.Sprintf("logs?%s", query.Encode())
+
+	return s.ListNext(ctx, path)
+}
+
+// ListNext fetches the page of System Log events at next, the resp.Pagination.Next of a previous call.
+//
+// https://developer.okta.com/docs/reference/api/system-log/#list-events
+func (s *LogsService) ListNext(ctx context.Context, next string) ([]*LogEvent, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryLogs)
+	req, err := s.client.NewRequest("GET", next, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var events []*LogEvent
+	resp, err := s.client.Do(ctx, req, &events)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return events, resp, nil
+}
@@ -0,0 +1,56 @@
+package okta
+
+// NetworkZone represents a network zone, a set of IP addresses or locations that policies
+// reference by ID.
+//
+// https://developer.okta.com/docs/reference/api/zones/#zone-object
+type NetworkZone struct {
+	RawJSON
+	ID   string          `json:"id,omitempty"`
+	Type NetworkZoneType `json:"type"`
+	Name string          `json:"name"`
+	// Status has possible values of: "ACTIVE", "INACTIVE"
+	Status string `json:"status,omitempty"`
+	// Usage has possible values of: "POLICY", "BLOCKLIST"
+	Usage     string                 `json:"usage,omitempty"`
+	System    bool                   `json:"system,omitempty"`
+	Gateways  []*NetworkZoneAddress  `json:"gateways,omitempty"`
+	Proxies   []*NetworkZoneAddress  `json:"proxies,omitempty"`
+	Locations []*NetworkZoneLocation `json:"locations,omitempty"`
+	ASNs      []string               `json:"asns,omitempty"`
+	// ProxyType has possible values of: "", "Any", "Tor", "NotTorAnonymizer"
+	ProxyType   string      `json:"proxyType,omitempty"`
+	Created     Timestamp   `json:"created,omitempty"`
+	LastUpdated Timestamp   `json:"lastUpdated,omitempty"`
+	Links       interface{} `json:"_links,omitempty"`
+}
+
+// NetworkZoneType is a type for the NetworkZoneType enum.
+//
+// https://developer.okta.com/docs/reference/api/zones/#zone-object
+type NetworkZoneType string
+
+// NetworkZoneType Constants
+//
+// https://developer.okta.com/docs/reference/api/zones/#zone-object
+const (
+	NetworkZoneTypeIP      NetworkZoneType = "IP"
+	NetworkZoneTypeDynamic                 = "DYNAMIC"
+)
+
+// NetworkZoneAddress represents an IP address, range or CIDR block of an IP NetworkZone.
+//
+// https://developer.okta.com/docs/reference/api/zones/#address-object
+type NetworkZoneAddress struct {
+	// Type has possible values of: "CIDR", "RANGE"
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// NetworkZoneLocation represents a location of a DYNAMIC NetworkZone.
+//
+// https://developer.okta.com/docs/reference/api/zones/#location-object
+type NetworkZoneLocation struct {
+	Country string `json:"country"`
+	Region  string `json:"region,omitempty"`
+}
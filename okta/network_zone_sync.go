@@ -0,0 +1,177 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// NetworkZoneSyncParams is a helper struct for calling SyncAddresses(). The addresses are CIDR
+// blocks, e.g. "203.0.113.0/24", single IP addresses, or ranges, e.g. "203.0.113.10-203.0.113.20",
+// in IPv4 or IPv6.
+type NetworkZoneSyncParams struct {
+	// Gateways are the desired gateway addresses of the zone. Nil leaves the gateways unchanged,
+	// an empty slice removes them.
+	Gateways []string
+	// Proxies are the desired proxy addresses of the zone. Nil leaves the proxies unchanged, an
+	// empty slice removes them.
+	Proxies []string
+	// DryRun makes SyncAddresses return the changes without making them.
+	DryRun bool
+}
+
+// NetworkZoneDiff represents the changes of the addresses of an IP zone, see
+// NetworkZonesService.SyncAddresses().
+type NetworkZoneDiff struct {
+	ZoneID          string
+	AddedGateways   []*NetworkZoneAddress
+	RemovedGateways []*NetworkZoneAddress
+	AddedProxies    []*NetworkZoneAddress
+	RemovedProxies  []*NetworkZoneAddress
+}
+
+// Empty reports whether the diff has no change.
+func (d *NetworkZoneDiff) Empty() bool {
+	return len(d.AddedGateways) == 0 && len(d.RemovedGateways) == 0 &&
+		len(d.AddedProxies) == 0 && len(d.RemovedProxies) == 0
+}
+
+// SyncAddresses converges the gateways, and the proxies, of an IP zone to the addresses of params,
+// e.g. the egress IP addresses published by a cloud provider, and returns the changes. Those of
+// params left nil are unchanged; an error is returned if both are. The addresses are validated
+// first, and compared with those of the zone once normalized, so that "198.51.100.7" matches
+// "198.51.100.7/32"; the addresses kept are left untouched and in their order, the new ones
+// appended. The zone isn't updated if nothing changed, or if params.DryRun is set.
+//
+// https://developer.okta.com/docs/reference/api/zones/#update-an-ip-zone
+func (s *NetworkZonesService) SyncAddresses(ctx context.Context, id string, params *NetworkZoneSyncParams) (*NetworkZoneDiff, *Response, error) {
+	if params.Gateways == nil && params.Proxies == nil {
+		return nil, nil, errors.New("okta: no gateways nor proxies to sync")
+	}
+	var gateways, proxies []*NetworkZoneAddress
+	var err error
+	if params.Gateways != nil {
+		if gateways, err = parseNetworkZoneAddresses(params.Gateways); err != nil {
+			return nil, nil, err
+		}
+	}
+	if params.Proxies != nil {
+		if proxies, err = parseNetworkZoneAddresses(params.Proxies); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	zone, resp, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+	if zone.Type != NetworkZoneTypeIP {
+		return nil, resp, fmt.Errorf("okta: zone %s is a %s zone, not an IP zone", id, zone.Type)
+	}
+
+	diff := &NetworkZoneDiff{ZoneID: id}
+	if params.Gateways != nil {
+		zone.Gateways, diff.AddedGateways, diff.RemovedGateways = diffNetworkZoneAddresses(zone.Gateways, gateways)
+	}
+	if params.Proxies != nil {
+		zone.Proxies, diff.AddedProxies, diff.RemovedProxies = diffNetworkZoneAddresses(zone.Proxies, proxies)
+	}
+	if diff.Empty() || params.DryRun {
+		return diff, resp, nil
+	}
+
+	_, resp, err = s.Update(ctx, id, zone)
+	if err != nil {
+		return nil, resp, err
+	}
+	return diff, resp, nil
+}
+
+// parseNetworkZoneAddresses validates and normalizes addresses, and returns them without
+// duplicates, in their order.
+func parseNetworkZoneAddresses(addresses []string) ([]*NetworkZoneAddress, error) {
+	var parsed []*NetworkZoneAddress
+	var invalid []string
+	seen := make(map[string]bool)
+	for _, address := range addresses {
+		zoneAddress, err := parseNetworkZoneAddress(address)
+		if err != nil {
+			invalid = append(invalid, err.Error())
+			continue
+		}
+		if seen[zoneAddress.Value] {
+			continue
+		}
+		seen[zoneAddress.Value] = true
+		parsed = append(parsed, zoneAddress)
+	}
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("okta: invalid network zone addresses: %s", strings.Join(invalid, "; "))
+	}
+	return parsed, nil
+}
+
+// parseNetworkZoneAddress validates and normalizes a CIDR block, an IP address, or a range of IP
+// addresses. A single IP address is a CIDR block of one address, as Okta stores it.
+func parseNetworkZoneAddress(address string) (*NetworkZoneAddress, error) {
+	address = strings.TrimSpace(address)
+	if from, to, ok := strings.Cut(address, "-"); ok {
+		first, err := netip.ParseAddr(strings.TrimSpace(from))
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", address, err)
+		}
+		last, err := netip.ParseAddr(strings.TrimSpace(to))
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", address, err)
+		}
+		if first.Is4() != last.Is4() || last.Less(first) {
+			return nil, fmt.Errorf("%q: not a range of IP addresses", address)
+		}
+		return &NetworkZoneAddress{Type: "RANGE", Value: first.String() + "-" + last.String()}, nil
+	}
+
+	if !strings.Contains(address, "/") {
+		addr, err := netip.ParseAddr(address)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", address, err)
+		}
+		return &NetworkZoneAddress{Type: "CIDR", Value: netip.PrefixFrom(addr, addr.BitLen()).String()}, nil
+	}
+	prefix, err := netip.ParsePrefix(address)
+	if err != nil {
+		return nil, fmt.Errorf("%q: %w", address, err)
+	}
+	if prefix.Masked() != prefix {
+		return nil, fmt.Errorf("%q: host bits set, the block is %s", address, prefix.Masked())
+	}
+	return &NetworkZoneAddress{Type: "CIDR", Value: prefix.String()}, nil
+}
+
+// diffNetworkZoneAddresses returns the addresses of a zone converged from current to desired,
+// along with those added and removed. The current addresses which can't be parsed are removed.
+func diffNetworkZoneAddresses(current, desired []*NetworkZoneAddress) (result, added, removed []*NetworkZoneAddress) {
+	wanted := make(map[string]bool)
+	for _, address := range desired {
+		wanted[address.Value] = true
+	}
+
+	kept := make(map[string]bool)
+	for _, address := range current {
+		normalized, err := parseNetworkZoneAddress(address.Value)
+		if err != nil || !wanted[normalized.Value] || kept[normalized.Value] {
+			removed = append(removed, address)
+			continue
+		}
+		kept[normalized.Value] = true
+		result = append(result, address)
+	}
+	for _, address := range desired {
+		if !kept[address.Value] {
+			added = append(added, address)
+			result = append(result, address)
+		}
+	}
+	return result, added, removed
+}
@@ -0,0 +1,92 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// NetworkZonesService is the service providing access to the Zones Resource in the Okta API
+type NetworkZonesService service
+
+// List fetches the first page of network zones.
+//
+// https://developer.okta.com/docs/reference/api/zones/#list-network-zones
+func (s *NetworkZonesService) List(ctx context.Context) (*Collection[*NetworkZone], *Response, error) {
+	path := fmt.Sprintf("zones?limit=%d", 100)
+	return listPage[*NetworkZone](ctx, s.client, RateLimitCategoryCore, path)
+}
+
+// GetByID fetches a network zone by ID.
+//
+// https://developer.okta.com/docs/reference/api/zones/#get-network-zone
+func (s *NetworkZonesService) GetByID(ctx context.Context, id string) (*NetworkZone, *Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("zones/%s", id), nil)
+}
+
+// Add creates a new network zone.
+//
+// https://developer.okta.com/docs/reference/api/zones/#create-an-ip-zone
+func (s *NetworkZonesService) Add(ctx context.Context, zoneIn *NetworkZone) (*NetworkZone, *Response, error) {
+	return s.do(ctx, "POST", "zones", zoneIn)
+}
+
+// Update modifies a network zone.
+//
+// Note that delta updates are not supported. You must pass a full NetworkZone object.
+//
+// https://developer.okta.com/docs/reference/api/zones/#update-an-ip-zone
+func (s *NetworkZonesService) Update(ctx context.Context, id string, zoneIn *NetworkZone) (*NetworkZone, *Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("zones/%s", id), zoneIn)
+}
+
+// Remove deletes an inactive network zone.
+//
+// https://developer.okta.com/docs/reference/api/zones/#delete-network-zone
+func (s *NetworkZonesService) Remove(ctx context.Context, id string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("zones/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Activate activates a network zone.
+//
+// https://developer.okta.com/docs/reference/api/zones/#activate-network-zone
+func (s *NetworkZonesService) Activate(ctx context.Context, id string) (*NetworkZone, *Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("zones/%s/lifecycle/activate", id), nil)
+}
+
+// Deactivate deactivates a network zone.
+//
+// https://developer.okta.com/docs/reference/api/zones/#deactivate-network-zone
+func (s *NetworkZonesService) Deactivate(ctx context.Context, id string) (*NetworkZone, *Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("zones/%s/lifecycle/deactivate", id), nil)
+}
+
+// do is a helper function for the requests returning a NetworkZone.
+func (s *NetworkZonesService) do(ctx context.Context, method, path string, zoneIn *NetworkZone) (*NetworkZone, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if zoneIn != nil {
+		body = zoneIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	zoneOut := new(NetworkZone)
+	resp, err := s.client.Do(ctx, req, zoneOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return zoneOut, resp, nil
+}
@@ -0,0 +1,36 @@
+// Package oauth2adapter adapts the access tokens of the okta package to golang.org/x/oauth2, so
+// that the okta package itself doesn't depend on it.
+//
+//	credentials := okta.NewClientCredentials(issuer, clientID, clientSecret, "okta.users.read")
+//	httpClient := oauth2.NewClient(ctx, oauth2adapter.TokenSource(credentials))
+package oauth2adapter
+
+import (
+	"golang.org/x/oauth2"
+
+	"github.com/austinylin/go-okta/okta"
+)
+
+// TokenSource returns an oauth2.TokenSource of the access tokens of credentials. The tokens are
+// cached, and renewed, by credentials.
+func TokenSource(credentials *okta.ClientCredentials) oauth2.TokenSource {
+	return tokenSource{credentials: credentials}
+}
+
+type tokenSource struct {
+	credentials *okta.ClientCredentials
+}
+
+// Token returns the access token of the credentials, as an oauth2.Token.
+func (s tokenSource) Token() (*oauth2.Token, error) {
+	token, err := s.credentials.Token()
+	if err != nil {
+		return nil, err
+	}
+	return &oauth2.Token{
+		AccessToken:  token.AccessToken,
+		TokenType:    token.TokenType,
+		RefreshToken: token.RefreshToken,
+		Expiry:       token.Expiry,
+	}, nil
+}
@@ -0,0 +1,245 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// OffboardingStep is a type for the OffboardingStep enum, a step of an OffboardingPlan.
+type OffboardingStep string
+
+// OffboardingStep Constants
+const (
+	// OffboardingStepSuspend suspends the user, so that it can't sign in while the other steps run,
+	// or until it's unsuspended when the plan only suspends.
+	OffboardingStepSuspend OffboardingStep = "SUSPEND"
+	// OffboardingStepCloseSessions closes the sessions of the user and revokes its OAuth tokens.
+	OffboardingStepCloseSessions = "CLOSE_SESSIONS"
+	// OffboardingStepRevokeGrants revokes the grants the user gave to OAuth clients.
+	OffboardingStepRevokeGrants = "REVOKE_GRANTS"
+	// OffboardingStepResetFactors resets the factors the user enrolled.
+	OffboardingStepResetFactors = "RESET_FACTORS"
+	// OffboardingStepRemoveAppAssignments removes the direct assignments of the user to
+	// applications.
+	OffboardingStepRemoveAppAssignments = "REMOVE_APP_ASSIGNMENTS"
+	// OffboardingStepRemoveGroupMemberships removes the user from the groups mastered in Okta,
+	// which also removes its assignments through groups.
+	OffboardingStepRemoveGroupMemberships = "REMOVE_GROUP_MEMBERSHIPS"
+	// OffboardingStepDeactivate deactivates the user.
+	OffboardingStepDeactivate = "DEACTIVATE"
+)
+
+// OffboardingSteps is the list of the steps of an offboarding, in the order they run.
+var OffboardingSteps = []OffboardingStep{
+	OffboardingStepCloseSessions,
+	OffboardingStepRevokeGrants,
+	OffboardingStepResetFactors,
+	OffboardingStepRemoveAppAssignments,
+	OffboardingStepRemoveGroupMemberships,
+	OffboardingStepDeactivate,
+}
+
+// OffboardingPlan represents the ordered steps of the offboarding of a user, and their results.
+// A plan is JSON encodable, so that it can be saved between runs: UsersService.Offboard() skips the
+// steps done, and resumes at the one that failed.
+type OffboardingPlan struct {
+	UserID string `json:"userId"`
+	// NotBefore, if set, is the time the offboarding is scheduled at, e.g. the end of the last
+	// day of an employee; Offboard() waits for it.
+	NotBefore *time.Time               `json:"notBefore,omitempty"`
+	Steps     []*OffboardingStepResult `json:"steps"`
+}
+
+// OffboardingStepResult represents the result of a step of an OffboardingPlan.
+type OffboardingStepResult struct {
+	Step OffboardingStep `json:"step"`
+	// Done reports whether the step completed.
+	Done bool `json:"done"`
+	// Items are the IDs of the resources the step removed the user from, e.g. the applications
+	// or the groups.
+	Items []string `json:"items,omitempty"`
+	// Completed is the time the step completed.
+	Completed *time.Time `json:"completed,omitempty"`
+	// Error is the error of the last run of the step, if it failed.
+	Error string `json:"error,omitempty"`
+}
+
+// NewOffboardingPlan is a helper method to create a new OffboardingPlan of the user userID, with
+// steps run in the order of OffboardingSteps, or with all of them if none are given. An error is
+// returned for an unknown step. A plan suspending a user for an investigation, without
+// deactivating it, is e.g.:
+//
+//	plan, err := okta.NewOffboardingPlan(userID, okta.OffboardingStepSuspend, okta.OffboardingStepCloseSessions)
+func NewOffboardingPlan(userID string, steps ...OffboardingStep) (*OffboardingPlan, error) {
+	if len(steps) == 0 {
+		steps = OffboardingSteps
+	}
+	for _, step := range steps {
+		if !step.valid() {
+			return nil, fmt.Errorf("okta: unknown offboarding step %q", step)
+		}
+	}
+
+	plan := &OffboardingPlan{UserID: userID}
+	for _, step := range append([]OffboardingStep{OffboardingStepSuspend}, OffboardingSteps...) {
+		for _, s := range steps {
+			if s == step {
+				plan.Steps = append(plan.Steps, &OffboardingStepResult{Step: step})
+				break
+			}
+		}
+	}
+	return plan, nil
+}
+
+// valid reports whether s is a known step.
+func (s OffboardingStep) valid() bool {
+	if s == OffboardingStepSuspend {
+		return true
+	}
+	for _, step := range OffboardingSteps {
+		if s == step {
+			return true
+		}
+	}
+	return false
+}
+
+// Done reports whether all the steps of the plan completed.
+func (p *OffboardingPlan) Done() bool {
+	for _, result := range p.Steps {
+		if !result.Done {
+			return false
+		}
+	}
+	return true
+}
+
+// Offboard runs the steps of plan which aren't done, in order, recording their results in plan. It
+// stops at the first step failing, and returns its error; running the plan again resumes at that
+// step. It waits until plan.NotBefore first, unless ctx is done. A plan with an unknown step, e.g.
+// one saved by a newer version of this package, is rejected before any step runs.
+//
+//	plan, _ := okta.NewOffboardingPlan(userID)
+//	plan.NotBefore = &lastDay
+//	if err := client.Users.Offboard(ctx, plan); err != nil {
+//		// save the plan, and run it again later
+//	}
+func (s *UsersService) Offboard(ctx context.Context, plan *OffboardingPlan) error {
+	for _, result := range plan.Steps {
+		if !result.Step.valid() {
+			return fmt.Errorf("okta: unknown offboarding step %q of user %s", result.Step, plan.UserID)
+		}
+	}
+	if plan.NotBefore != nil {
+		if err := s.client.sleepUntil(ctx, *plan.NotBefore); err != nil {
+			return err
+		}
+	}
+
+	for _, result := range plan.Steps {
+		if result.Done {
+			continue
+		}
+		items, err := s.offboardingStep(ctx, plan.UserID, result.Step)
+		result.Items = append(result.Items, items...)
+		if err != nil {
+			result.Error = err.Error()
+			return fmt.Errorf("okta: offboarding step %s of user %s: %w", result.Step, plan.UserID, err)
+		}
+		completed := s.client.now()
+		result.Done, result.Completed, result.Error = true, &completed, ""
+	}
+	return nil
+}
+
+// offboardingStep runs step for the user userID, and returns the IDs of the resources it removed
+// the user from.
+func (s *UsersService) offboardingStep(ctx context.Context, userID string, step OffboardingStep) ([]string, error) {
+	var err error
+	switch step {
+	case OffboardingStepSuspend:
+		_, err = s.Suspend(ctx, userID)
+		var errResp *ErrorResponse
+		if errors.As(err, &errResp) {
+			// Only active users can be suspended: the step is done if the user was suspended, or
+			// deactivated, already.
+			user, _, getErr := s.GetByID(ctx, userID)
+			if getErr == nil && (user.Status == "SUSPENDED" || user.Status == "DEPROVISIONED") {
+				err = nil
+			}
+		}
+	case OffboardingStepCloseSessions:
+		_, err = s.client.Sessions.CloseUserSessions(ctx, userID, true)
+	case OffboardingStepRevokeGrants:
+		_, err = s.RevokeGrants(ctx, userID)
+	case OffboardingStepResetFactors:
+		_, err = s.ResetFactors(ctx, userID)
+	case OffboardingStepRemoveAppAssignments:
+		return s.removeAppAssignments(ctx, userID)
+	case OffboardingStepRemoveGroupMemberships:
+		return s.removeGroupMemberships(ctx, userID)
+	case OffboardingStepDeactivate:
+		_, err = s.Deactivate(ctx, userID)
+	default:
+		err = fmt.Errorf("unknown step %q", step)
+	}
+	return nil, err
+}
+
+// removeAppAssignments removes the direct assignments of the user userID to applications, and
+// returns the IDs of the applications.
+func (s *UsersService) removeAppAssignments(ctx context.Context, userID string) ([]string, error) {
+	page, _, err := s.client.Apps.List(ctx, &AppListParams{Filter: fmt.Sprintf("user.id eq %q", userID)})
+	if err != nil {
+		return nil, err
+	}
+	apps, _, err := page.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []string
+	for _, app := range apps {
+		appUser, _, err := s.client.Apps.GetAssignedUser(ctx, app.ID, userID)
+		if err != nil {
+			return removed, err
+		}
+		if appUser.Scope != "USER" {
+			continue
+		}
+		if _, err := s.client.Apps.UnassignUser(ctx, app.ID, userID); err != nil {
+			return removed, err
+		}
+		removed = append(removed, app.ID)
+	}
+	return removed, nil
+}
+
+// removeGroupMemberships removes the user userID from the groups mastered in Okta, and returns
+// the IDs of the groups. The memberships of the groups of applications, e.g. Active Directory,
+// and of the Everyone group can't be removed in Okta.
+func (s *UsersService) removeGroupMemberships(ctx context.Context, userID string) ([]string, error) {
+	page, _, err := s.ListGroups(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	groups, _, err := page.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []string
+	for _, group := range groups {
+		if group.Type != GroupTypeOkta {
+			continue
+		}
+		if _, err := s.client.Groups.RemoveUser(ctx, group.ID, userID); err != nil {
+			return removed, err
+		}
+		removed = append(removed, group.ID)
+	}
+	return removed, nil
+}
@@ -39,15 +39,78 @@ type service struct {
 type Client struct {
 	httpClient *http.Client
 	apiToken   string
+	tokenType  TokenType
 	UserAgent  string
 	BaseURL    *url.URL
+	// Cache optionally caches the resources GetByID() fetches, see Cache.
+	Cache *Cache
+	// Timeout is the time limit of the requests made with a context without a deadline, so
+	// that a hung endpoint can't stall the caller forever. Zero means no limit. It can be
+	// overridden per call with WithTimeout().
+	Timeout time.Duration
+	// Audit, if set, receives a record of every mutating call, e.g. for change management.
+	Audit AuditSink
+	// CoalesceGets makes identical GET requests in flight at the same time, e.g. from many
+	// goroutines resolving the same group, result in a single request to the API. Requests are
+	// identical if they have the same URL and the same headers once sent. The response
+	// is shared, so are the errors: if the context of the first request is canceled, so are the
+	// others.
+	CoalesceGets bool
+	// BeforeSend, if set, is called with every request right before it is sent, see
+	// BeforeSendFunc.
+	BeforeSend BeforeSendFunc
+	// OnUnknownEnum, if set, is called with the values of the enums of the responses which aren't
+	// known, e.g. a new sign on mode, see UnknownEnumFunc.
+	OnUnknownEnum UnknownEnumFunc
+	// Clock, if set, replaces the clock of the system for everything time dependent in the client,
+	// e.g. with a fake one in tests, see Clock.
+	Clock Clock
+	// Retry, if set, retries the requests that fail with a transient error or are rejected by the
+	// rate limit, see RetryPolicy. The Timeout of a request includes its retries.
+	Retry *RetryPolicy
+	// Guard, if set, makes the destructive methods refuse the calls that look like mistakes, see
+	// Guard.
+	Guard *Guard
+	// Defaults, if set, are the RequestDefaults of the services, keyed by the resource of the
+	// path of their requests, e.g. "apps" for Apps, or "groups" for Groups. They apply to the
+	// requests made with WithBasePath() too, by the resource of their path under BaseURL.
+	Defaults   map[string]*RequestDefaults
+	coalescer  coalescer
 	rateMu     sync.Mutex
-	rateLimits [categories]Rate // Rate limits for the client as determined by the most recent API calls.
-	common     service          // Reuse a single struct instead of allocating one for each service on the heap.
-
-	Apps   *AppsService
-	Groups *GroupsService
-	Users  *UsersService
+	rateLimits [categories]Rate        // Rate limits for the client as determined by the most recent API calls.
+	budgets    [categories]budgetState // Calls made by the client in the current rate limit windows, see Budget.
+	common     service                 // Reuse a single struct instead of allocating one for each service on the heap.
+
+	AgentPools           *AgentPoolsService
+	Apps                 *AppsService
+	AttackProtection     *AttackProtectionService
+	Authenticators       *AuthenticatorsService
+	AuthorizationServers *AuthorizationServersService
+	Behaviors            *BehaviorsService
+	CAPTCHAs             *CAPTCHAsService
+	Domains              *DomainsService
+	EmailDomains         *EmailDomainsService
+	EmailTemplates       *EmailTemplatesService
+	EventHooks           *EventHooksService
+	Factors              *FactorsService
+	Features             *FeaturesService
+	Groups               *GroupsService
+	IdentityProviders    *IdentityProvidersService
+	IdentitySources      *IdentitySourcesService
+	LogStreams           *LogStreamsService
+	Logs                 *LogsService
+	NetworkZones         *NetworkZonesService
+	Org                  *OrgService
+	Policies             *PoliciesService
+	PrincipalRateLimits  *PrincipalRateLimitsService
+	PushProviders        *PushProvidersService
+	RateLimitSettings    *RateLimitSettingsService
+	ResourceSets         *ResourceSetsService
+	Roles                *RolesService
+	Sessions             *SessionsService
+	Subscriptions        *SubscriptionsService
+	UISchemas            *UISchemasService
+	Users                *UsersService
 }
 
 // Response represents a response from the Okta API.
@@ -56,6 +119,10 @@ type Response struct {
 	Pagination
 	Rate
 	OktaRequestID string
+	// Links are the HAL links of the resource in the response body, see Follow().
+	Links Links
+
+	client *Client
 }
 
 // Pagination represents the pagination primiatives of the Okta API.
@@ -65,7 +132,7 @@ type Pagination struct {
 	Self string `json:"self"`
 }
 
-// NewClient creates a new Okta API client.
+// NewClient creates a new Okta API client authenticating with an API token.
 func NewClient(apiToken string, paramBaseURL string, httpClient *http.Client) (*Client, error) {
 	if len(apiToken) == 0 {
 		return nil, errors.New("API Token is not present")
@@ -91,13 +158,51 @@ func NewClient(apiToken string, paramBaseURL string, httpClient *http.Client) (*
 	}
 
 	c.common.client = c
+	c.AgentPools = (*AgentPoolsService)(&c.common)
 	c.Apps = (*AppsService)(&c.common)
+	c.AttackProtection = (*AttackProtectionService)(&c.common)
+	c.Authenticators = (*AuthenticatorsService)(&c.common)
+	c.AuthorizationServers = (*AuthorizationServersService)(&c.common)
+	c.Behaviors = (*BehaviorsService)(&c.common)
+	c.CAPTCHAs = (*CAPTCHAsService)(&c.common)
+	c.Domains = (*DomainsService)(&c.common)
+	c.EmailDomains = (*EmailDomainsService)(&c.common)
+	c.EmailTemplates = (*EmailTemplatesService)(&c.common)
+	c.EventHooks = (*EventHooksService)(&c.common)
+	c.Factors = (*FactorsService)(&c.common)
+	c.Features = (*FeaturesService)(&c.common)
 	c.Groups = (*GroupsService)(&c.common)
+	c.IdentityProviders = (*IdentityProvidersService)(&c.common)
+	c.IdentitySources = (*IdentitySourcesService)(&c.common)
+	c.LogStreams = (*LogStreamsService)(&c.common)
+	c.Logs = (*LogsService)(&c.common)
+	c.NetworkZones = (*NetworkZonesService)(&c.common)
+	c.Org = (*OrgService)(&c.common)
+	c.Policies = (*PoliciesService)(&c.common)
+	c.PrincipalRateLimits = (*PrincipalRateLimitsService)(&c.common)
+	c.PushProviders = (*PushProvidersService)(&c.common)
+	c.RateLimitSettings = (*RateLimitSettingsService)(&c.common)
+	c.ResourceSets = (*ResourceSetsService)(&c.common)
+	c.Roles = (*RolesService)(&c.common)
+	c.Sessions = (*SessionsService)(&c.common)
+	c.Subscriptions = (*SubscriptionsService)(&c.common)
+	c.UISchemas = (*UISchemasService)(&c.common)
 	c.Users = (*UsersService)(&c.common)
 
 	return c, nil
 }
 
+// NewClientWithAccessToken creates a new Okta API client authenticating with an OAuth 2.0 access
+// token for Okta, sent as a Bearer token, e.g. one of ClientCredentials.
+func NewClientWithAccessToken(accessToken string, paramBaseURL string, httpClient *http.Client) (*Client, error) {
+	c, err := NewClient(accessToken, paramBaseURL, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	c.tokenType = TokenTypeBearer
+	return c, nil
+}
+
 // NewRequest creates a new *http.Request that can be used to query the Okta API.
 func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
 
@@ -132,9 +237,48 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 	return req, nil
 }
 
+// NewUploadRequest creates a new *http.Request with a non JSON body, such as a certificate or an image,
+// that can be used to query the Okta API.
+func (c *Client) NewUploadRequest(urlStr string, reader io.Reader, size int64, mediaType string) (*http.Request, error) {
+	u, err := c.BaseURL.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", u.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = size
+
+	req.Header.Set("Content-Type", mediaType)
+	req.Header.Set("Accept", "application/json")
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	return req, nil
+}
+
 // Do executes an http.Request with context, and returns the result, optionally decoding the body into the
 // provided interface.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	// The defaults are keyed by the path under BaseURL, before WithBasePath() changes it.
+	req = c.withDefaults(req)
+	req = c.withRequestOptions(ctx, req)
+
+	if c.Audit == nil || !isMutation(req.Method) {
+		return c.doWithRetries(ctx, req, v)
+	}
+	record := c.newAuditRecord(req)
+	resp, err := c.doWithRetries(ctx, req, v)
+	c.audit(ctx, record, resp, v, err)
+	return resp, err
+}
+
+// do is a helper function for Do(), which makes the request.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
 	req = req.WithContext(ctx)
 
 	// If we are in debug mode, log the request prior to adding the auth header.
@@ -144,19 +288,27 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	}
 
 	// Auth
-	req.Header.Set("Authorization", fmt.Sprintf("SSWS %s", c.apiToken))
+	if c.tokenType == TokenTypeBearer {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiToken))
+	} else {
+		req.Header.Set("Authorization", fmt.Sprintf("SSWS %s", c.apiToken))
+	}
 
 	// Check rate limits before we actually make the request
-	rateLimitCategory := ctx.Value(rateLimitCategoryCtxKey).(rateLimitCategory)
-	if err := c.checkRateLimitBeforeDo(req, rateLimitCategory); err != nil {
+	category := ctx.Value(rateLimitCategoryCtxKey).(RateLimitCategory)
+	if err := c.checkRateLimitBeforeDo(req, category); err != nil {
 		return &Response{
 			Response: err.Response,
 			Rate:     err.Rate,
 		}, err
 	}
 
+	if err := c.beforeSend(req); err != nil {
+		return nil, err
+	}
+
 	// actually send the request
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req)
 
 	// If we are in debug mode, log the response.
 	if os.Getenv(envDebug) != "" {
@@ -184,12 +336,12 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	}
 	defer resp.Body.Close()
 
-	rateLimit := parseRate(resp)
+	rateLimit := parseRate(resp, c.clock())
 	c.rateMu.Lock()
-	c.rateLimits[rateLimitCategory] = rateLimit
+	c.rateLimits[category] = rateLimit
 	c.rateMu.Unlock()
 
-	response := &Response{Response: resp}
+	response := &Response{Response: resp, client: c}
 
 	response.Pagination = Pagination{}
 	response.populatePageValues()
@@ -197,7 +349,7 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	response.Rate = rateLimit
 	response.OktaRequestID = resp.Header.Get(headerRequestID)
 
-	err = checkResponseForErrors(resp)
+	err = c.checkResponseForErrors(resp)
 	if err != nil {
 		return response, err
 	}
@@ -206,9 +358,17 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 		if w, ok := v.(io.Writer); ok {
 			io.Copy(w, resp.Body)
 		} else {
-			err = json.NewDecoder(resp.Body).Decode(v)
-			if err == io.EOF {
-				err = nil // ignore EOF errors caused by empty response body
+			var data []byte
+			data, err = ioutil.ReadAll(resp.Body)
+			if err == nil {
+				err = json.NewDecoder(bytes.NewReader(data)).Decode(v)
+				if err == io.EOF {
+					err = nil // ignore EOF errors caused by empty response body
+				} else if err == nil {
+					setRaw(v, data)
+					c.checkEnums(req, v)
+				}
+				response.Links = parseLinks(data)
 			}
 		}
 	}
@@ -254,7 +414,9 @@ func (r *Response) populatePageValues() {
 	}
 }
 
-func parseRate(r *http.Response) Rate {
+// parseRate returns the rate limit of r, its reset corrected by the skew of the clock of Okta from
+// clock.
+func parseRate(r *http.Response, clock Clock) Rate {
 	var rate Rate
 	if limit := r.Header.Get(headerRateLimit); limit != "" {
 		rate.Limit, _ = strconv.Atoi(limit)
@@ -262,23 +424,44 @@ func parseRate(r *http.Response) Rate {
 	if remaining := r.Header.Get(headerRateRemaining); remaining != "" {
 		rate.Remaining, _ = strconv.Atoi(remaining)
 	}
+	rate.ClockSkew = clockSkew(r, clock.Now())
 	if reset := r.Header.Get(headerRateReset); reset != "" {
 		if v, _ := strconv.ParseInt(reset, 10, 64); v != 0 {
-			rate.Reset = Timestamp{Time: time.Unix(v, 0)}
+			rate.Reset = Timestamp{Time: time.Unix(v, 0).Add(-rate.ClockSkew)}
 		}
 	}
 	return rate
 }
 
+// ClockSkew returns how far the clock of the server of r, as told by its Date header, is ahead of
+// the local clock, or zero without a Date header. The Date header has a resolution of a second, so
+// skews of a second or less are reported as zero.
+func ClockSkew(r *http.Response) time.Duration {
+	return clockSkew(r, time.Now())
+}
+
+// clockSkew returns how far the clock of the server of r is ahead of now, see ClockSkew().
+func clockSkew(r *http.Response, now time.Time) time.Duration {
+	date, err := http.ParseTime(r.Header.Get("Date"))
+	if err != nil {
+		return 0
+	}
+	skew := date.Sub(now)
+	if -time.Second <= skew && skew <= time.Second {
+		return 0
+	}
+	return skew
+}
+
 // checkRateLimitBeforeDo does not make any network calls, but uses existing knowledge from
 // current client state in order to quickly check if *RateLimitError can be immediately returned
 // from Client.Do, and if so, returns it so that Client.Do can skip making a network API call unnecessarily.
 // Otherwise it returns nil, and Client.Do should proceed normally.
-func (c *Client) checkRateLimitBeforeDo(req *http.Request, rateLimitCategory rateLimitCategory) *RateLimitError {
+func (c *Client) checkRateLimitBeforeDo(req *http.Request, category RateLimitCategory) *RateLimitError {
 	c.rateMu.Lock()
-	rate := c.rateLimits[rateLimitCategory]
+	rate := c.rateLimits[category]
 	c.rateMu.Unlock()
-	if rate.Remaining == 0 && time.Now().Before(rate.Reset.Time) {
+	if rate.Remaining == 0 && c.now().Before(rate.Reset.Time) {
 		// Create a fake response.
 		resp := &http.Response{
 			Status:     http.StatusText(http.StatusForbidden),
@@ -291,6 +474,8 @@ func (c *Client) checkRateLimitBeforeDo(req *http.Request, rateLimitCategory rat
 			Rate:     rate,
 			Response: resp,
 			Message:  fmt.Sprintf("API rate limit of %v still exceeded until %v, not making remote request.", rate.Limit, rate.Reset),
+			Category: category,
+			clock:    c.clock(),
 		}
 	}
 
@@ -304,11 +489,12 @@ func (c *Client) checkRateLimitBeforeDo(req *http.Request, rateLimitCategory rat
 // body, or a JSON response body that maps to ErrorResponse. Any other
 // response body will be silently ignored.
 //
-// The error type will be *RateLimitError for rate limit exceeded errors,
+// The error type will be *RateLimitError for rate limit exceeded errors, i.e. 429 Too Many
+// Requests or a 403 Forbidden with no remaining calls,
 // *AcceptedError for 202 Accepted status codes,
 // and *TwoFactorAuthError for two-factor authentication errors.
-func checkResponseForErrors(r *http.Response) error {
-	if c := r.StatusCode; 200 <= c && c <= 299 {
+func (c *Client) checkResponseForErrors(r *http.Response) error {
+	if code := r.StatusCode; 200 <= code && code <= 299 {
 		return nil
 	}
 	errorResponse := &ErrorResponse{Response: r}
@@ -317,10 +503,14 @@ func checkResponseForErrors(r *http.Response) error {
 		json.Unmarshal(data, errorResponse)
 	}
 	switch {
-	case r.StatusCode == http.StatusForbidden && r.Header.Get(headerRateRemaining) == "0":
+	case r.StatusCode == http.StatusTooManyRequests,
+		r.StatusCode == http.StatusForbidden && r.Header.Get(headerRateRemaining) == "0":
+		category, _ := RequestCategory(r.Request)
 		return &RateLimitError{
-			Rate:     parseRate(r),
+			Rate:     parseRate(r, c.clock()),
 			Response: errorResponse.Response,
+			Category: category,
+			clock:    c.clock(),
 		}
 	default:
 		return errorResponse
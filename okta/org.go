@@ -0,0 +1,291 @@
+package okta
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+)
+
+// orgLogoMaxSize is the maximum size of a logo accepted by Okta.
+const orgLogoMaxSize = 1 << 20
+
+// orgLogoMediaTypes are the image types accepted by Okta for logos.
+var orgLogoMediaTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+}
+
+// OrgService is the service providing access to the Org Resource in the Okta API
+type OrgService service
+
+// GetSettings fetches the settings of the org.
+//
+// https://developer.okta.com/docs/reference/api/org/#get-org-settings
+func (s *OrgService) GetSettings(ctx context.Context) (*OrgSetting, *Response, error) {
+	return s.doSettings(ctx, "GET", nil)
+}
+
+// UpdateSettings replaces the settings of the org.
+//
+// Note that delta updates are not supported. You must pass a full OrgSetting object, use
+// PartialUpdateSettings() to only modify some of the settings.
+//
+// https://developer.okta.com/docs/reference/api/org/#update-org-settings
+func (s *OrgService) UpdateSettings(ctx context.Context, settings *OrgSetting) (*OrgSetting, *Response, error) {
+	return s.doSettings(ctx, "PUT", settings)
+}
+
+// PartialUpdateSettings modifies the settings of the org. Only the non empty attributes of settings are changed.
+//
+// https://developer.okta.com/docs/reference/api/org/#partial-update-org-setting
+func (s *OrgService) PartialUpdateSettings(ctx context.Context, settings *OrgSetting) (*OrgSetting, *Response, error) {
+	return s.doSettings(ctx, "POST", settings)
+}
+
+// doSettings is a helper function for the org settings operations.
+func (s *OrgService) doSettings(ctx context.Context, method string, settings *OrgSetting) (*OrgSetting, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "org"
+
+	var body interface{}
+	if settings != nil {
+		body = settings
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settingsOut := new(OrgSetting)
+	resp, err := s.client.Do(ctx, req, settingsOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settingsOut, resp, nil
+}
+
+// ListContacts fetches the contact types of the org.
+//
+// https://developer.okta.com/docs/reference/api/org/#get-org-contact-types
+func (s *OrgService) ListContacts(ctx context.Context) ([]*OrgContact, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "org/contacts"
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var contacts []*OrgContact
+	resp, err := s.client.Do(ctx, req, &contacts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return contacts, resp, nil
+}
+
+// GetContact fetches the user assigned to a contact type.
+//
+// https://developer.okta.com/docs/reference/api/org/#get-user-of-contact-type
+func (s *OrgService) GetContact(ctx context.Context, contactType OrgContactType) (*OrgContact, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("org/contacts/%s", contactType)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contactOut := new(OrgContact)
+	resp, err := s.client.Do(ctx, req, contactOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return contactOut, resp, nil
+}
+
+// SetContact assigns a user to a contact type.
+//
+// https://developer.okta.com/docs/reference/api/org/#update-user-of-contact-type
+func (s *OrgService) SetContact(ctx context.Context, contactType OrgContactType, userID string) (*OrgContact, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("org/contacts/%s", contactType)
+
+	body := map[string]interface{}{"userId": userID}
+
+	req, err := s.client.NewRequest("PUT", path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contactOut := new(OrgContact)
+	resp, err := s.client.Do(ctx, req, contactOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return contactOut, resp, nil
+}
+
+// GetPreferences fetches the preferences of the org.
+//
+// https://developer.okta.com/docs/reference/api/org/#get-org-preferences
+func (s *OrgService) GetPreferences(ctx context.Context) (*OrgPreferences, *Response, error) {
+	return s.doPreferences(ctx, "GET", "org/preferences")
+}
+
+// SetEndUserFooterVisibility shows or hides the Okta UI footer for all end users of the org.
+//
+// https://developer.okta.com/docs/reference/api/org/#hide-end-user-footer
+func (s *OrgService) SetEndUserFooterVisibility(ctx context.Context, show bool) (*OrgPreferences, *Response, error) {
+	path := "org/preferences/hideEndUserFooter"
+	if show {
+		path = "org/preferences/showEndUserFooter"
+	}
+	return s.doPreferences(ctx, "POST", path)
+}
+
+// doPreferences is a helper function for the org preferences operations.
+func (s *OrgService) doPreferences(ctx context.Context, method string, path string) (*OrgPreferences, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	req, err := s.client.NewRequest(method, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	preferencesOut := new(OrgPreferences)
+	resp, err := s.client.Do(ctx, req, preferencesOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return preferencesOut, resp, nil
+}
+
+// GetOktaCommunicationSettings fetches whether users of the org receive communication emails from Okta.
+//
+// https://developer.okta.com/docs/reference/api/org/#get-okta-communication-settings
+func (s *OrgService) GetOktaCommunicationSettings(ctx context.Context) (*OrgOktaCommunicationSetting, *Response, error) {
+	return s.doOktaCommunication(ctx, "GET", "org/privacy/oktaCommunication")
+}
+
+// SetOktaCommunicationOptIn opts users of the org in to, or out of, communication emails from Okta.
+//
+// https://developer.okta.com/docs/reference/api/org/#opt-in-users-to-okta-communication-emails
+func (s *OrgService) SetOktaCommunicationOptIn(ctx context.Context, optIn bool) (*OrgOktaCommunicationSetting, *Response, error) {
+	path := "org/privacy/oktaCommunication/optOut"
+	if optIn {
+		path = "org/privacy/oktaCommunication/optIn"
+	}
+	return s.doOktaCommunication(ctx, "POST", path)
+}
+
+// doOktaCommunication is a helper function for the Okta communication operations.
+func (s *OrgService) doOktaCommunication(ctx context.Context, method string, path string) (*OrgOktaCommunicationSetting, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	req, err := s.client.NewRequest(method, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settingOut := new(OrgOktaCommunicationSetting)
+	resp, err := s.client.Do(ctx, req, settingOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settingOut, resp, nil
+}
+
+// GetOktaSupportSettings fetches whether Okta Support can access the org.
+//
+// https://developer.okta.com/docs/reference/api/org/#get-okta-support-settings
+func (s *OrgService) GetOktaSupportSettings(ctx context.Context) (*OrgOktaSupportSetting, *Response, error) {
+	return s.doOktaSupport(ctx, "GET", "org/privacy/oktaSupport")
+}
+
+// GrantOktaSupport grants Okta Support temporary access to the org. Access expires after 8 hours.
+//
+// https://developer.okta.com/docs/reference/api/org/#grant-okta-support-access
+func (s *OrgService) GrantOktaSupport(ctx context.Context) (*OrgOktaSupportSetting, *Response, error) {
+	return s.doOktaSupport(ctx, "POST", "org/privacy/oktaSupport/grant")
+}
+
+// ExtendOktaSupport extends the access of Okta Support by 24 hours.
+//
+// https://developer.okta.com/docs/reference/api/org/#extend-okta-support-access
+func (s *OrgService) ExtendOktaSupport(ctx context.Context) (*OrgOktaSupportSetting, *Response, error) {
+	return s.doOktaSupport(ctx, "POST", "org/privacy/oktaSupport/extend")
+}
+
+// RevokeOktaSupport revokes the access of Okta Support to the org.
+//
+// https://developer.okta.com/docs/reference/api/org/#revoke-okta-support-access
+func (s *OrgService) RevokeOktaSupport(ctx context.Context) (*OrgOktaSupportSetting, *Response, error) {
+	return s.doOktaSupport(ctx, "POST", "org/privacy/oktaSupport/revoke")
+}
+
+// doOktaSupport is a helper function for the Okta Support operations.
+func (s *OrgService) doOktaSupport(ctx context.Context, method string, path string) (*OrgOktaSupportSetting, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	req, err := s.client.NewRequest(method, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settingOut := new(OrgOktaSupportSetting)
+	resp, err := s.client.Do(ctx, req, settingOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settingOut, resp, nil
+}
+
+// UploadLogo uploads and replaces the logo of the org. The image must be a PNG, JPG or GIF
+// of at most 1 MB, Okta recommends a transparent image of 420px by 120px.
+//
+// https://developer.okta.com/docs/reference/api/org/#org-logo-operations
+func (s *OrgService) UploadLogo(ctx context.Context, filename string, image []byte) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "org/logo"
+
+	if len(image) == 0 || len(image) > orgLogoMaxSize {
+		return nil, fmt.Errorf("Invalid logo, size must be between 1 byte and %d bytes but is %d bytes", orgLogoMaxSize, len(image))
+	}
+	mediaType := http.DetectContentType(image)
+	if !orgLogoMediaTypes[mediaType] {
+		return nil, fmt.Errorf("Invalid logo, %q is not a PNG, JPG or GIF image", mediaType)
+	}
+
+	buf := new(bytes.Buffer)
+	mw := multipart.NewWriter(buf)
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename=%q`, filename))
+	header.Set("Content-Type", mediaType)
+	part, err := mw.CreatePart(header)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(image); err != nil {
+		return nil, err
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewUploadRequest(path, buf, int64(buf.Len()), mw.FormDataContentType())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,148 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Org2OrgParams is a helper struct for calling NewOrg2Org().
+type Org2OrgParams struct {
+	// Label of the Org2Org app created in the hub org.
+	Label string
+	// SpokeAPIToken is an API token of the spoke org, used by the hub org to provision users.
+	SpokeAPIToken string
+	// GroupIDs are the hub org groups whose members are assigned to, and pushed to, the spoke org.
+	GroupIDs []string
+	// IdentityProviderName is the name of the inbound SAML Identity Provider created in the spoke org.
+	// Defaults to the Label.
+	IdentityProviderName string
+}
+
+// Org2Org represents the resources NewOrg2Org() created in the hub and spoke orgs.
+type Org2Org struct {
+	// App is the Org2Org app in the hub org.
+	App *App
+	// IdentityProvider is the inbound SAML Identity Provider, trusting the hub org, in the spoke org.
+	IdentityProvider *IdentityProvider
+}
+
+// NewOrg2Org connects a spoke org to a hub org, the common hub-and-spoke multi-org pattern. It:
+//
+//   - creates an Org2Org app in the hub org,
+//   - trusts the signing certificate of that app in the spoke org and creates an inbound SAML Identity Provider for it,
+//   - points the Org2Org app at the Identity Provider,
+//   - configures the provisioning connection of the app with the spoke API token and enables user provisioning,
+//   - assigns the scoping groups to the app.
+//
+// The steps aren't transactional. If a step fails the resources created so far are returned alongside
+// the error, so that callers can remove them.
+func NewOrg2Org(ctx context.Context, hub *Client, spoke *Client, params *Org2OrgParams) (*Org2Org, error) {
+	if params.Label == "" || params.SpokeAPIToken == "" {
+		return nil, errors.New("Invalid parameters, `Label` and `SpokeAPIToken` must be set")
+	}
+	paramsCopy := *params
+	params = &paramsCopy
+	if params.IdentityProviderName == "" {
+		params.IdentityProviderName = params.Label
+	}
+
+	spokeURL := fmt.Sprintf("%s://%s", spoke.BaseURL.Scheme, spoke.BaseURL.Host)
+	o := new(Org2Org)
+
+	appIn := new(App)
+	appIn.Name = AppNameOrg2Org
+	appIn.Label = params.Label
+	appIn.SignOnMode = AppSignOnModeSAML2
+	appIn.Visibility = NewAppVisability()
+	appIn.Settings = map[string]map[string]interface{}{
+		"app": {
+			"baseUrl": spokeURL,
+		},
+	}
+	app, _, err := hub.Apps.Add(ctx, appIn, true)
+	if err != nil {
+		return o, err
+	}
+	o.App = app
+
+	rawMetadata, _, err := hub.Apps.GetSAMLMetadata(ctx, app.ID)
+	if err != nil {
+		return o, err
+	}
+	metadata, err := ParseSAMLIdPMetadata(rawMetadata)
+	if err != nil {
+		return o, err
+	}
+
+	key, _, err := spoke.IdentityProviders.AddKey(ctx, metadata.SigningCertificates[0])
+	if err != nil {
+		return o, err
+	}
+
+	audience := fmt.Sprintf("%s/org2org/%s", spokeURL, app.ID)
+	idpIn := metadata.IdentityProvider(params.IdentityProviderName, key.KID, audience)
+
+	idp, _, err := spoke.IdentityProviders.Add(ctx, idpIn)
+	if err != nil {
+		return o, err
+	}
+	o.IdentityProvider = idp
+
+	acsURL := linkHref(idp.Links, "acs")
+	if acsURL == "" {
+		return o, fmt.Errorf("Identity Provider %s has no ACS link", idp.ID)
+	}
+
+	app.Settings = map[string]map[string]interface{}{
+		"app": {
+			"baseUrl":        spokeURL,
+			"acsUrl":         acsURL,
+			"audRestriction": audience,
+		},
+	}
+	app, _, err = hub.Apps.Update(ctx, app.ID, app)
+	if err != nil {
+		return o, err
+	}
+	o.App = app
+
+	if _, err := hub.Apps.SetProvisioningConnection(ctx, app.ID, params.SpokeAPIToken, true); err != nil {
+		return o, err
+	}
+
+	capabilities := new(AppFeatureCapabilities)
+	capabilities.Create.LifecycleCreate.Status = "ENABLED"
+	capabilities.Update.Profile.Status = "ENABLED"
+	capabilities.Update.LifecycleDeactivate.Status = "ENABLED"
+	capabilities.Update.Password.Status = "DISABLED"
+	capabilities.Update.Password.Seed = "RANDOM"
+	capabilities.Update.Password.Change = "KEEP_EXISTING"
+	if _, _, err := hub.Apps.UpdateFeature(ctx, app.ID, "USER_PROVISIONING", capabilities); err != nil {
+		return o, err
+	}
+
+	result, err := hub.Apps.AssignGroups(ctx, app.ID, params.GroupIDs)
+	if err == nil {
+		err = result.Err()
+	}
+	if err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// linkHref returns the href of the named link from a decoded _links object, or an empty string.
+func linkHref(links interface{}, name string) string {
+	linksMap, ok := links.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	link, ok := linksMap[name].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	href, _ := link["href"].(string)
+	return href
+}
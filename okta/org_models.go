@@ -0,0 +1,75 @@
+package okta
+
+// OrgSetting represents the settings of the Okta org.
+//
+// https://developer.okta.com/docs/reference/api/org/#org-setting-object
+type OrgSetting struct {
+	RawJSON
+	ID                    string      `json:"id,omitempty"`
+	Subdomain             string      `json:"subdomain,omitempty"`
+	CompanyName           string      `json:"companyName,omitempty"`
+	Status                string      `json:"status,omitempty"`
+	ExpiresAt             Timestamp   `json:"expiresAt,omitempty"`
+	Created               Timestamp   `json:"created,omitempty"`
+	LastUpdated           Timestamp   `json:"lastUpdated,omitempty"`
+	Website               string      `json:"website,omitempty"`
+	PhoneNumber           string      `json:"phoneNumber,omitempty"`
+	EndUserSupportHelpURL string      `json:"endUserSupportHelpURL,omitempty"`
+	SupportPhoneNumber    string      `json:"supportPhoneNumber,omitempty"`
+	Address1              string      `json:"address1,omitempty"`
+	Address2              string      `json:"address2,omitempty"`
+	City                  string      `json:"city,omitempty"`
+	State                 string      `json:"state,omitempty"`
+	Country               string      `json:"country,omitempty"`
+	PostalCode            string      `json:"postalCode,omitempty"`
+	Links                 interface{} `json:"_links,omitempty"`
+}
+
+// OrgContactType is a type for the OrgContactType enum.
+//
+// https://developer.okta.com/docs/reference/api/org/#org-contact-type-object
+type OrgContactType string
+
+// OrgContactType Constants
+//
+// https://developer.okta.com/docs/reference/api/org/#org-contact-type-object
+const (
+	OrgContactTypeBilling   OrgContactType = "BILLING"
+	OrgContactTypeTechnical                = "TECHNICAL"
+)
+
+// OrgContact represents the assignment of a user to one of the org contact types.
+//
+// https://developer.okta.com/docs/reference/api/org/#org-contact-user-object
+type OrgContact struct {
+	ContactType OrgContactType `json:"contactType,omitempty"`
+	UserID      string         `json:"userId"`
+	Links       interface{}    `json:"_links,omitempty"`
+}
+
+// OrgPreferences represents the preferences of the Okta org.
+//
+// https://developer.okta.com/docs/reference/api/org/#org-preferences-object
+type OrgPreferences struct {
+	ShowEndUserFooter bool        `json:"showEndUserFooter"`
+	Links             interface{} `json:"_links,omitempty"`
+}
+
+// OrgOktaCommunicationSetting represents whether users of the org receive communication emails from Okta.
+//
+// https://developer.okta.com/docs/reference/api/org/#org-okta-communication-setting-object
+type OrgOktaCommunicationSetting struct {
+	OptOutEmailUsers bool        `json:"optOutEmailUsers"`
+	Links            interface{} `json:"_links,omitempty"`
+}
+
+// OrgOktaSupportSetting represents whether Okta Support can access the org, and until when.
+//
+// https://developer.okta.com/docs/reference/api/org/#org-okta-support-settings-object
+type OrgOktaSupportSetting struct {
+	// Support has possible values of: "ENABLED", "DISABLED"
+	Support string `json:"support"`
+	// Expiration is when the access of Okta Support ends, nil when it's disabled.
+	Expiration *Timestamp  `json:"expiration,omitempty"`
+	Links      interface{} `json:"_links,omitempty"`
+}
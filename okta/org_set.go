@@ -0,0 +1,178 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// orgSetConcurrency is the default number of orgs an OrgSet runs an operation against at once.
+const orgSetConcurrency = 8
+
+// OrgSet holds the clients of several orgs, keyed by a name, e.g. the customer of a managed
+// service provider, to run the same operation against all of them. Each client keeps the rate
+// limits of its own org.
+type OrgSet struct {
+	// Concurrency is the number of orgs an operation runs against at once. Defaults to 8.
+	Concurrency int
+
+	mu      sync.Mutex
+	clients map[string]*Client
+}
+
+// NewOrgSet is a helper method to create a new OrgSet of clients, keyed by org name.
+func NewOrgSet(clients map[string]*Client) *OrgSet {
+	s := &OrgSet{clients: make(map[string]*Client, len(clients))}
+	for org, client := range clients {
+		s.clients[org] = client
+	}
+	return s
+}
+
+// Add adds the client of org, replacing the one it had.
+func (s *OrgSet) Add(org string, client *Client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.clients == nil {
+		s.clients = make(map[string]*Client)
+	}
+	s.clients[org] = client
+}
+
+// Remove removes the client of org.
+func (s *OrgSet) Remove(org string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.clients, org)
+}
+
+// Client returns the client of org, and whether it has one.
+func (s *OrgSet) Client(org string) (*Client, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	client, ok := s.clients[org]
+	return client, ok
+}
+
+// Orgs returns the names of the orgs, sorted.
+func (s *OrgSet) Orgs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	orgs := make([]string, 0, len(s.clients))
+	for org := range s.clients {
+		orgs = append(orgs, org)
+	}
+	sort.Strings(orgs)
+	return orgs
+}
+
+// OrgResult represents the outcome of an operation run against an org of an OrgSet.
+type OrgResult[T any] struct {
+	Org string
+	// Value is the value returned by the operation, set even if it failed, e.g. to a partial
+	// result.
+	Value T
+	// Err is the error of the operation, nil on success.
+	Err     error
+	Elapsed time.Duration
+}
+
+// OrgResults are the outcomes of an operation run against the orgs of an OrgSet, in the order of
+// OrgSet.Orgs().
+type OrgResults[T any] []*OrgResult[T]
+
+// Values returns the values of the orgs the operation succeeded for, by org.
+func (r OrgResults[T]) Values() map[string]T {
+	values := make(map[string]T, len(r))
+	for _, result := range r {
+		if result.Err == nil {
+			values[result.Org] = result.Value
+		}
+	}
+	return values
+}
+
+// Failed returns the results of the orgs the operation failed for.
+func (r OrgResults[T]) Failed() OrgResults[T] {
+	var failed OrgResults[T]
+	for _, result := range r {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Err returns an error listing the orgs the operation failed for, or nil if it succeeded for
+// all of them.
+func (r OrgResults[T]) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	messages := make([]string, 0, len(failed))
+	for _, result := range failed {
+		messages = append(messages, fmt.Sprintf("%s: %v", result.Org, result.Err))
+	}
+	return fmt.Errorf("okta: failed for %d of %d orgs: %s", len(failed), len(r), strings.Join(messages, "; "))
+}
+
+// Run runs fn against every org of s concurrently, and returns the outcome for each org. An org
+// failing doesn't stop the others; ctx does.
+//
+//	results := set.Run(ctx, func(ctx context.Context, org string, client *okta.Client) error {
+//		_, err := client.Users.Deactivate(ctx, ids[org])
+//		return err
+//	})
+//	for _, failed := range results.Failed() {
+//		log.Printf("%s: %v", failed.Org, failed.Err)
+//	}
+func (s *OrgSet) Run(ctx context.Context, fn func(ctx context.Context, org string, client *Client) error) OrgResults[struct{}] {
+	return RunOrgs(ctx, s, func(ctx context.Context, org string, client *Client) (struct{}, error) {
+		return struct{}{}, fn(ctx, org, client)
+	})
+}
+
+// RunOrgs runs fn against every org of s concurrently, like OrgSet.Run(), and returns the value
+// and the outcome for each org, e.g. a report of every org:
+//
+//	results := okta.RunOrgs(ctx, set, func(ctx context.Context, org string, client *okta.Client) ([]*okta.Group, error) {
+//		page, _, err := client.Groups.List(ctx, nil)
+//		if err != nil {
+//			return nil, err
+//		}
+//		groups, _, err := page.All(ctx)
+//		return groups, err
+//	})
+func RunOrgs[T any](ctx context.Context, s *OrgSet, fn func(ctx context.Context, org string, client *Client) (T, error)) OrgResults[T] {
+	orgs := s.Orgs()
+	results := make(OrgResults[T], len(orgs))
+	tasks := make([]func(ctx context.Context) error, 0, len(orgs))
+	for i, org := range orgs {
+		i, org := i, org
+		client, _ := s.Client(org)
+		tasks = append(tasks, func(ctx context.Context) error {
+			result := &OrgResult[T]{Org: org}
+			started := client.now()
+			if err := ctx.Err(); err != nil {
+				result.Err = err
+			} else {
+				result.Value, result.Err = fn(ctx, org, client)
+			}
+			result.Elapsed = client.now().Sub(started)
+			results[i] = result
+			// The error is the org's own: the other orgs carry on.
+			return nil
+		})
+	}
+
+	concurrency := s.Concurrency
+	if concurrency <= 0 {
+		concurrency = orgSetConcurrency
+	}
+	runConcurrently(ctx, concurrency, tasks)
+	return results
+}
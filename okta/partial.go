@@ -0,0 +1,63 @@
+package okta
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrPartialResult is matched by errors.Is on the errors of the helpers making several requests,
+// e.g. Collection.All(), when a request fails or the context is done after some results were
+// collected. The error is a *PartialResultError carrying them.
+var ErrPartialResult = errors.New("okta: partial result")
+
+// PartialResultError represents the failure of a helper making several requests, e.g. listing the
+// pages of a collection, after some of the requests succeeded. The helpers check the context
+// between requests, so canceling it, or its deadline, stops them early with the results collected
+// until then:
+//
+//	users, _, err := page.All(ctx)
+//	var partial *okta.PartialResultError[*okta.User]
+//	if errors.As(err, &partial) {
+//		users = partial.Items
+//	}
+//
+// errors.Is and errors.As also match Err, e.g. errors.Is(err, context.DeadlineExceeded).
+type PartialResultError[T any] struct {
+	// Items are the results collected before the failure.
+	Items []T
+	// Err is the error that stopped the helper.
+	Err error
+}
+
+func (e *PartialResultError[T]) Error() string {
+	return fmt.Sprintf("okta: partial result of %d items: %v", len(e.Items), e.Err)
+}
+
+// Unwrap returns Err.
+func (e *PartialResultError[T]) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrPartialResult.
+func (e *PartialResultError[T]) Is(target error) bool {
+	return target == ErrPartialResult
+}
+
+// partialResult returns err with the items collected before it, as a *PartialResultError. An err
+// that is a *PartialResultError already, e.g. of a nested helper, is replaced.
+func partialResult[T any](items []T, err error) error {
+	var partial *PartialResultError[T]
+	if errors.As(err, &partial) {
+		err = partial.Err
+	}
+	return &PartialResultError[T]{Items: items, Err: err}
+}
+
+// partialItems returns the items carried by err if it is a *PartialResultError.
+func partialItems[T any](err error) []T {
+	var partial *PartialResultError[T]
+	if errors.As(err, &partial) {
+		return partial.Items
+	}
+	return nil
+}
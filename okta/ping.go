@@ -0,0 +1,42 @@
+package okta
+
+import (
+	"context"
+	"time"
+)
+
+// Ping represents the result of Client.Ping().
+type Ping struct {
+	// OrgID and Subdomain identify the org the client is talking to.
+	OrgID     string
+	Subdomain string
+	// Latency is the round trip time of the request.
+	Latency time.Duration
+	// Rate is the rate limit of the org settings endpoint after the request, which is in the
+	// core rate limit category.
+	Rate          Rate
+	OktaRequestID string
+}
+
+// Ping makes a cheap authenticated request, fetching the org settings, and returns the org the
+// client is talking to, the latency and the rate limit. It's meant for the readiness probes of
+// services that depend on Okta: an error means the org is unreachable, the token is invalid or
+// lacks the okta.orgs.read scope, or the rate limit is exceeded.
+//
+// Bound the probe with a context deadline or WithTimeout(), as the caller of a probe usually
+// expects an answer faster than Client.Timeout.
+func (c *Client) Ping(ctx context.Context) (*Ping, error) {
+	start := c.now()
+	org, resp, err := c.Org.GetSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ping{
+		OrgID:         org.ID,
+		Subdomain:     org.Subdomain,
+		Latency:       c.now().Sub(start),
+		Rate:          resp.Rate,
+		OktaRequestID: resp.OktaRequestID,
+	}, nil
+}
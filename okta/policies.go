@@ -0,0 +1,195 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// PoliciesService is the service providing access to the Policies Resource in the Okta API
+type PoliciesService service
+
+// List fetches all policies of a type.
+//
+// https://developer.okta.com/docs/reference/api/policy/#get-all-policies-by-type
+func (s *PoliciesService) List(ctx context.Context, policyType PolicyType) ([]*Policy, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("policies?type=%s", policyType)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var policies []*Policy
+	resp, err := s.client.Do(ctx, req, &policies)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return policies, resp, nil
+}
+
+// GetByID fetches a policy by ID.
+//
+// https://developer.okta.com/docs/reference/api/policy/#get-a-policy
+func (s *PoliciesService) GetByID(ctx context.Context, id string) (*Policy, *Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("policies/%s", id), nil)
+}
+
+// Add creates a new policy.
+//
+// https://developer.okta.com/docs/reference/api/policy/#create-a-policy
+func (s *PoliciesService) Add(ctx context.Context, policyIn *Policy) (*Policy, *Response, error) {
+	return s.do(ctx, "POST", "policies", policyIn)
+}
+
+// Update modifies a policy.
+//
+// Note that delta updates are not supported. You must pass a full Policy object.
+//
+// https://developer.okta.com/docs/reference/api/policy/#update-a-policy
+func (s *PoliciesService) Update(ctx context.Context, id string, policyIn *Policy) (*Policy, *Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("policies/%s", id), policyIn)
+}
+
+// Remove deletes a policy.
+//
+// https://developer.okta.com/docs/reference/api/policy/#delete-a-policy
+func (s *PoliciesService) Remove(ctx context.Context, id string) (*Response, error) {
+	return s.delete(ctx, fmt.Sprintf("policies/%s", id))
+}
+
+// Activate activates a policy.
+//
+// https://developer.okta.com/docs/reference/api/policy/#activate-a-policy
+func (s *PoliciesService) Activate(ctx context.Context, id string) (*Response, error) {
+	return s.lifecycle(ctx, fmt.Sprintf("policies/%s/lifecycle/activate", id))
+}
+
+// Deactivate deactivates a policy.
+//
+// https://developer.okta.com/docs/reference/api/policy/#deactivate-a-policy
+func (s *PoliciesService) Deactivate(ctx context.Context, id string) (*Response, error) {
+	return s.lifecycle(ctx, fmt.Sprintf("policies/%s/lifecycle/deactivate", id))
+}
+
+// ListRules fetches the rules of a policy.
+//
+// https://developer.okta.com/docs/reference/api/policy/#get-policy-rules
+func (s *PoliciesService) ListRules(ctx context.Context, id string) ([]*PolicyRule, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("policies/%s/rules", id)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rules []*PolicyRule
+	resp, err := s.client.Do(ctx, req, &rules)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rules, resp, nil
+}
+
+// GetRule fetches a rule of a policy.
+//
+// https://developer.okta.com/docs/reference/api/policy/#get-a-policy-rule
+func (s *PoliciesService) GetRule(ctx context.Context, id, ruleID string) (*PolicyRule, *Response, error) {
+	return s.doRule(ctx, "GET", fmt.Sprintf("policies/%s/rules/%s", id, ruleID), nil)
+}
+
+// AddRule creates a new rule in a policy.
+//
+// https://developer.okta.com/docs/reference/api/policy/#create-a-policy-rule
+func (s *PoliciesService) AddRule(ctx context.Context, id string, ruleIn *PolicyRule) (*PolicyRule, *Response, error) {
+	return s.doRule(ctx, "POST", fmt.Sprintf("policies/%s/rules", id), ruleIn)
+}
+
+// UpdateRule modifies a rule of a policy.
+//
+// Note that delta updates are not supported. You must pass a full PolicyRule object.
+//
+// https://developer.okta.com/docs/reference/api/policy/#update-a-policy-rule
+func (s *PoliciesService) UpdateRule(ctx context.Context, id, ruleID string, ruleIn *PolicyRule) (*PolicyRule, *Response, error) {
+	return s.doRule(ctx, "PUT", fmt.Sprintf("policies/%s/rules/%s", id, ruleID), ruleIn)
+}
+
+// RemoveRule deletes a rule of a policy.
+//
+// https://developer.okta.com/docs/reference/api/policy/#delete-a-policy-rule
+func (s *PoliciesService) RemoveRule(ctx context.Context, id, ruleID string) (*Response, error) {
+	return s.delete(ctx, fmt.Sprintf("policies/%s/rules/%s", id, ruleID))
+}
+
+// do is a helper function for the requests returning a Policy.
+func (s *PoliciesService) do(ctx context.Context, method, path string, policyIn *Policy) (*Policy, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if policyIn != nil {
+		body = policyIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policyOut := new(Policy)
+	resp, err := s.client.Do(ctx, req, policyOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return policyOut, resp, nil
+}
+
+// doRule is a helper function for the requests returning a PolicyRule.
+func (s *PoliciesService) doRule(ctx context.Context, method, path string, ruleIn *PolicyRule) (*PolicyRule, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if ruleIn != nil {
+		body = ruleIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleOut := new(PolicyRule)
+	resp, err := s.client.Do(ctx, req, ruleOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ruleOut, resp, nil
+}
+
+// lifecycle is a helper function for the lifecycle operations.
+func (s *PoliciesService) lifecycle(ctx context.Context, path string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	req, err := s.client.NewRequest("POST", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// delete is a helper function for the delete operations.
+func (s *PoliciesService) delete(ctx context.Context, path string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
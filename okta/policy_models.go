@@ -0,0 +1,75 @@
+package okta
+
+// Policy represents a policy. The shape of Conditions and Settings depends on the policy type,
+// they are left undecoded.
+//
+// https://developer.okta.com/docs/reference/api/policy/#policy-object
+type Policy struct {
+	RawJSON
+	ID          string     `json:"id,omitempty"`
+	Type        PolicyType `json:"type"`
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	Priority    int        `json:"priority,omitempty"`
+	// Status has possible values of: "ACTIVE", "INACTIVE"
+	Status      string      `json:"status,omitempty"`
+	System      bool        `json:"system,omitempty"`
+	Conditions  interface{} `json:"conditions,omitempty"`
+	Settings    interface{} `json:"settings,omitempty"`
+	Created     Timestamp   `json:"created,omitempty"`
+	LastUpdated Timestamp   `json:"lastUpdated,omitempty"`
+	Links       interface{} `json:"_links,omitempty"`
+}
+
+// PolicyType is a type for the PolicyType enum.
+//
+// https://developer.okta.com/docs/reference/api/policy/#policy-types
+type PolicyType string
+
+// PolicyType Constants
+//
+// https://developer.okta.com/docs/reference/api/policy/#policy-types
+const (
+	PolicyTypeOktaSignOn         PolicyType = "OKTA_SIGN_ON"
+	PolicyTypePassword                      = "PASSWORD"
+	PolicyTypeMFAEnroll                     = "MFA_ENROLL"
+	PolicyTypeOAuthAuthorization            = "OAUTH_AUTHORIZATION_POLICY"
+	PolicyTypeIDPDiscovery                  = "IDP_DISCOVERY"
+	PolicyTypeAccess                        = "ACCESS_POLICY"
+	PolicyTypeProfileEnrollment             = "PROFILE_ENROLLMENT"
+	PolicyTypePostAuthSession               = "POST_AUTH_SESSION"
+	PolicyTypeEntityRisk                    = "ENTITY_RISK"
+)
+
+// PolicyTypes is the list of the policy types that can be listed.
+var PolicyTypes = []PolicyType{
+	PolicyTypeOktaSignOn,
+	PolicyTypePassword,
+	PolicyTypeMFAEnroll,
+	PolicyTypeOAuthAuthorization,
+	PolicyTypeIDPDiscovery,
+	PolicyTypeAccess,
+	PolicyTypeProfileEnrollment,
+	PolicyTypePostAuthSession,
+	PolicyTypeEntityRisk,
+}
+
+// PolicyRule represents a rule of a Policy. The shape of Conditions and Actions depends on the
+// policy type, they are left undecoded.
+//
+// https://developer.okta.com/docs/reference/api/policy/#rules
+type PolicyRule struct {
+	RawJSON
+	ID       string `json:"id,omitempty"`
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	Priority int    `json:"priority,omitempty"`
+	// Status has possible values of: "ACTIVE", "INACTIVE"
+	Status      string      `json:"status,omitempty"`
+	System      bool        `json:"system,omitempty"`
+	Conditions  interface{} `json:"conditions,omitempty"`
+	Actions     interface{} `json:"actions,omitempty"`
+	Created     Timestamp   `json:"created,omitempty"`
+	LastUpdated Timestamp   `json:"lastUpdated,omitempty"`
+	Links       interface{} `json:"_links,omitempty"`
+}
@@ -0,0 +1,74 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+const requestOptionsCtxKey contextKey = "requestOptions"
+
+// requestOptions are the changes made by Do() to the requests made with a context, to target the
+// beta, preview or versioned endpoints of the API.
+type requestOptions struct {
+	basePath string
+	header   http.Header
+	// products are appended to the User-Agent, see WithProductInfo().
+	products []string
+}
+
+// WithBasePath returns a copy of ctx with which the requests of a Client go to basePath in place
+// of the path of BaseURL, e.g. "/api/v2/" or "/idp/myaccount/" for the endpoints outside of
+// /api/v1/. The rest of the path of the requests is kept.
+func WithBasePath(ctx context.Context, basePath string) context.Context {
+	opts := optionsFrom(ctx)
+	if !strings.HasSuffix(basePath, "/") {
+		basePath += "/"
+	}
+	opts.basePath = basePath
+	return context.WithValue(ctx, requestOptionsCtxKey, opts)
+}
+
+// WithHeader returns a copy of ctx with which the requests of a Client have the header key set to
+// value, e.g. an Accept header of "application/json; okta-version=1.0.0" for the versioned
+// endpoints, or the feature header of an early access API. Calling it again with the same key
+// replaces the value.
+func WithHeader(ctx context.Context, key string, value string) context.Context {
+	opts := optionsFrom(ctx)
+	opts.header.Set(key, value)
+	return context.WithValue(ctx, requestOptionsCtxKey, opts)
+}
+
+// optionsFrom returns a copy of the requestOptions of ctx, which can be modified without changing
+// those of ctx.
+func optionsFrom(ctx context.Context) requestOptions {
+	opts, _ := ctx.Value(requestOptionsCtxKey).(requestOptions)
+	if opts.header == nil {
+		opts.header = make(http.Header)
+	} else {
+		opts.header = opts.header.Clone()
+	}
+	return opts
+}
+
+// withRequestOptions returns req, or a copy of it changed by the options set with WithBasePath(),
+// WithHeader() and WithProductInfo() on ctx.
+func (c *Client) withRequestOptions(ctx context.Context, req *http.Request) *http.Request {
+	opts, ok := ctx.Value(requestOptionsCtxKey).(requestOptions)
+	if !ok {
+		return req
+	}
+
+	req = req.Clone(ctx)
+	if opts.basePath != "" && strings.HasPrefix(req.URL.Path, c.BaseURL.Path) {
+		req.URL.Path = opts.basePath + strings.TrimPrefix(req.URL.Path, c.BaseURL.Path)
+		req.URL.RawPath = ""
+	}
+	for key, values := range opts.header {
+		req.Header[key] = values
+	}
+	if len(opts.products) > 0 {
+		req.Header.Set("User-Agent", strings.TrimSpace(req.Header.Get("User-Agent")+" "+strings.Join(opts.products, " ")))
+	}
+	return req
+}
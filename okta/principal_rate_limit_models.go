@@ -0,0 +1,30 @@
+package okta
+
+// PrincipalRateLimit represents the share of the org wide rate limits that one API token or
+// OAuth 2.0 app may use.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/PrincipalRateLimit/
+type PrincipalRateLimit struct {
+	RawJSON
+	ID            string                 `json:"id,omitempty"`
+	PrincipalID   string                 `json:"principalId"`
+	PrincipalType PrincipalRateLimitType `json:"principalType"`
+	// DefaultPercentage is the percentage of the rate limit the principal may use, between 0 and 100.
+	DefaultPercentage int `json:"defaultPercentage"`
+	// DefaultConcurrencyPercentage is the percentage of the concurrency limit the principal may use, between 0 and 100.
+	DefaultConcurrencyPercentage int       `json:"defaultConcurrencyPercentage"`
+	OrgID                        string    `json:"orgId,omitempty"`
+	CreatedBy                    string    `json:"createdBy,omitempty"`
+	CreatedDate                  Timestamp `json:"createdDate,omitempty"`
+	LastUpdatedBy                string    `json:"lastUpdatedBy,omitempty"`
+	LastUpdate                   Timestamp `json:"lastUpdate,omitempty"`
+}
+
+// PrincipalRateLimitType is a type for the PrincipalRateLimitType enum.
+type PrincipalRateLimitType string
+
+// PrincipalRateLimitType Constants
+const (
+	PrincipalRateLimitTypeSSWSToken   PrincipalRateLimitType = "SSWS_TOKEN"
+	PrincipalRateLimitTypeOAuthClient                        = "OAUTH_CLIENT"
+)
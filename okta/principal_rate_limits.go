@@ -0,0 +1,84 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// PrincipalRateLimitsService is the service providing access to the Principal Rate Limits Resource in the Okta API
+type PrincipalRateLimitsService service
+
+// List fetches the first page of the rate limit entities of all principals of a type.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/PrincipalRateLimit/#tag/PrincipalRateLimit/operation/listPrincipalRateLimitEntities
+func (s *PrincipalRateLimitsService) List(ctx context.Context, principalType PrincipalRateLimitType) (*Collection[*PrincipalRateLimit], *Response, error) {
+	query := url.Values{}
+	query.Set("filter", fmt.Sprintf("principalType eq %q", principalType))
+	path := fmt.Sprintf("principal-rate-limits?%s", query.Encode())
+
+	return listPage[*PrincipalRateLimit](ctx, s.client, RateLimitCategoryCore, path)
+}
+
+// GetByID fetches a principal rate limit entity by ID.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/PrincipalRateLimit/#tag/PrincipalRateLimit/operation/getPrincipalRateLimitEntity
+func (s *PrincipalRateLimitsService) GetByID(ctx context.Context, id string) (*PrincipalRateLimit, *Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("principal-rate-limits/%s", id), nil)
+}
+
+// Add creates the rate limit entity of a principal.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/PrincipalRateLimit/#tag/PrincipalRateLimit/operation/createPrincipalRateLimitEntity
+func (s *PrincipalRateLimitsService) Add(ctx context.Context, limitIn *PrincipalRateLimit) (*PrincipalRateLimit, *Response, error) {
+	if err := validatePrincipalRateLimit(limitIn); err != nil {
+		return nil, nil, err
+	}
+	return s.do(ctx, "POST", "principal-rate-limits", limitIn)
+}
+
+// Update modifies the rate limit entity of a principal.
+//
+// Note that delta updates are not supported. You must pass a full PrincipalRateLimit object.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/PrincipalRateLimit/#tag/PrincipalRateLimit/operation/replacePrincipalRateLimitEntity
+func (s *PrincipalRateLimitsService) Update(ctx context.Context, id string, limitIn *PrincipalRateLimit) (*PrincipalRateLimit, *Response, error) {
+	if err := validatePrincipalRateLimit(limitIn); err != nil {
+		return nil, nil, err
+	}
+	return s.do(ctx, "PUT", fmt.Sprintf("principal-rate-limits/%s", id), limitIn)
+}
+
+// do is a helper function for the single principal rate limit operations.
+func (s *PrincipalRateLimitsService) do(ctx context.Context, method string, path string, limitIn *PrincipalRateLimit) (*PrincipalRateLimit, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if limitIn != nil {
+		body = limitIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	limitOut := new(PrincipalRateLimit)
+	resp, err := s.client.Do(ctx, req, limitOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return limitOut, resp, nil
+}
+
+// validatePrincipalRateLimit checks the percentages are within the range accepted by Okta.
+func validatePrincipalRateLimit(limit *PrincipalRateLimit) error {
+	if limit.DefaultPercentage < 0 || limit.DefaultPercentage > 100 {
+		return fmt.Errorf("Invalid parameters, `DefaultPercentage` must be between 0 and 100 but is %d", limit.DefaultPercentage)
+	}
+	if limit.DefaultConcurrencyPercentage < 0 || limit.DefaultConcurrencyPercentage > 100 {
+		return fmt.Errorf("Invalid parameters, `DefaultConcurrencyPercentage` must be between 0 and 100 but is %d", limit.DefaultConcurrencyPercentage)
+	}
+	return nil
+}
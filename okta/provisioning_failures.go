@@ -0,0 +1,112 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// provisioningEventPrefix is the prefix of the event types of the System Log recorded by the
+// provisioning of applications, e.g. application.provision.user.push.
+const provisioningEventPrefix = "application.provision."
+
+// ProvisioningFailure represents a failure of the provisioning of an application recorded in the
+// System Log, e.g. a user that couldn't be pushed to the application. Unlike ProvisioningError,
+// which is only the last error of an assignment, failures are kept for the retention of the log.
+//
+// https://developer.okta.com/docs/reference/api/event-types/#catalog
+type ProvisioningFailure struct {
+	// EventType is the type of the event, e.g. "application.provision.user.push" or
+	// "application.provision.user.deactivate".
+	EventType string
+	Published time.Time
+	AppID     string
+	AppName   string
+	// User is the Okta user the provisioning was for, nil if it was for a group.
+	User *LogTarget
+	// AppUser is the account of the user in the application, nil if the event doesn't name it.
+	AppUser *LogTarget
+	// ExternalID is the ID of the user, or group, in the application, empty if the event doesn't
+	// record it, e.g. when it failed creating the user there.
+	ExternalID string
+	// ErrorCode is the code of the error, as recorded by Okta or returned by the application.
+	ErrorCode string
+	// Reason is the reason of the failure, usually the error message of the application.
+	Reason string
+	// LogEvent is the event of the System Log.
+	LogEvent *LogEvent
+}
+
+// ProvisioningFailureFromEvent decodes a provisioning failure event of the System Log, and returns
+// false if e isn't one, e.g. a successful push or another event type.
+func ProvisioningFailureFromEvent(e *LogEvent) (*ProvisioningFailure, bool) {
+	if !strings.HasPrefix(e.EventType, provisioningEventPrefix) || e.Outcome == nil || e.Outcome.Result != "FAILURE" {
+		return nil, false
+	}
+
+	failure := &ProvisioningFailure{
+		EventType: e.EventType,
+		Published: e.Published.Time,
+		Reason:    e.Outcome.Reason,
+		LogEvent:  e,
+	}
+	for _, target := range e.Target {
+		switch target.Type {
+		case "AppInstance":
+			failure.AppID = target.ID
+			failure.AppName = target.DisplayName
+		case "User":
+			failure.User = target
+		case "AppUser":
+			failure.AppUser = target
+			failure.ExternalID = logString(target.DetailEntry, "externalId")
+		}
+	}
+	if e.DebugContext != nil {
+		if id := logString(e.DebugContext.DebugData, "externalId"); id != "" {
+			failure.ExternalID = id
+		}
+		failure.ErrorCode = logString(e.DebugContext.DebugData, "errorCode")
+	}
+	return failure, true
+}
+
+// logString returns the attribute key of the details of a LogEvent, if it's a string.
+func logString(details map[string]interface{}, key string) string {
+	s, _ := details[key].(string)
+	return s
+}
+
+// ProvisioningFailures returns the provisioning failures of an application recorded in the System
+// Log since since, most recent first, e.g. for a health check to alert on users that couldn't be
+// pushed. The System Log retains 90 days of events.
+func (s *AppsService) ProvisioningFailures(ctx context.Context, id string, since time.Time) ([]*ProvisioningFailure, error) {
+	params := &LogListParams{
+		Since:     since,
+		Until:     s.client.now(),
+		Filter:    fmt.Sprintf(`eventType sw %q and outcome.result eq "FAILURE" and target.id eq %q`, provisioningEventPrefix, id),
+		SortOrder: "DESCENDING",
+		Limit:     1000,
+	}
+
+	var failures []*ProvisioningFailure
+	events, resp, err := s.client.Logs.List(ctx, params)
+	for {
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range events {
+			if failure, ok := ProvisioningFailureFromEvent(event); ok {
+				failures = append(failures, failure)
+			}
+		}
+		if len(events) == 0 || resp.Pagination.Next == "" {
+			return failures, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		events, resp, err = s.client.Logs.ListNext(ctx, resp.Pagination.Next)
+	}
+}
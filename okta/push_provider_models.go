@@ -0,0 +1,48 @@
+package okta
+
+import "encoding/json"
+
+// PushProvider represents a push notification provider used by custom authenticators.
+//
+// https://developer.okta.com/docs/reference/api/push-providers/#push-provider-object
+type PushProvider struct {
+	RawJSON
+	ID              string                    `json:"id,omitempty"`
+	Name            string                    `json:"name"`
+	ProviderType    PushProviderType          `json:"providerType"`
+	LastUpdatedDate string                    `json:"lastUpdatedDate,omitempty"`
+	Configuration   PushProviderConfiguration `json:"configuration"`
+	Links           interface{}               `json:"_links,omitempty"`
+}
+
+// PushProviderType is a type for the PushProviderType enum.
+//
+// https://developer.okta.com/docs/reference/api/push-providers/#push-provider-object
+type PushProviderType string
+
+// PushProviderType Constants
+//
+// https://developer.okta.com/docs/reference/api/push-providers/#push-provider-object
+const (
+	PushProviderTypeAPNS PushProviderType = "APNS"
+	PushProviderTypeFCM                   = "FCM"
+)
+
+// PushProviderConfiguration represents the configuration of a push provider. The APNs attributes are
+// used with PushProviderTypeAPNS and the FCM attributes with PushProviderTypeFCM.
+//
+// The secrets, TokenSigningKey and ServiceAccountJSON, are write only and never returned by Okta.
+//
+// https://developer.okta.com/docs/reference/api/push-providers/#apns-configuration-object
+type PushProviderConfiguration struct {
+	// APNs
+	KeyID           string `json:"keyId,omitempty"`
+	TeamID          string `json:"teamId,omitempty"`
+	TokenSigningKey string `json:"tokenSigningKey,omitempty"`
+
+	// FCM
+	ProjectID          string          `json:"projectId,omitempty"`
+	ServiceAccountJSON json.RawMessage `json:"serviceAccountJson,omitempty"`
+
+	FileName string `json:"fileName,omitempty"`
+}
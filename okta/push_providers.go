@@ -0,0 +1,94 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// PushProvidersService is the service providing access to the Push Providers Resource in the Okta API
+type PushProvidersService service
+
+// List fetches all push providers, optionally filtered by type.
+//
+// https://developer.okta.com/docs/reference/api/push-providers/#list-push-providers
+func (s *PushProvidersService) List(ctx context.Context, providerType PushProviderType) ([]*PushProvider, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "push-providers"
+	if providerType != "" {
+		path = fmt.Sprintf("push-providers?type=%s", providerType)
+	}
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var providers []*PushProvider
+	resp, err := s.client.Do(ctx, req, &providers)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return providers, resp, nil
+}
+
+// GetByID fetches a push provider by ID.
+//
+// https://developer.okta.com/docs/reference/api/push-providers/#get-push-provider
+func (s *PushProvidersService) GetByID(ctx context.Context, id string) (*PushProvider, *Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("push-providers/%s", id), nil)
+}
+
+// Add creates a new push provider.
+//
+// https://developer.okta.com/docs/reference/api/push-providers/#create-push-provider
+func (s *PushProvidersService) Add(ctx context.Context, providerIn *PushProvider) (*PushProvider, *Response, error) {
+	return s.do(ctx, "POST", "push-providers", providerIn)
+}
+
+// Update modifies a push provider.
+//
+// Note that delta updates are not supported. You must pass a full PushProvider object, including its secrets.
+//
+// https://developer.okta.com/docs/reference/api/push-providers/#update-push-provider
+func (s *PushProvidersService) Update(ctx context.Context, id string, providerIn *PushProvider) (*PushProvider, *Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("push-providers/%s", id), providerIn)
+}
+
+// Remove deletes a push provider. Providers used by a custom authenticator can't be deleted.
+//
+// https://developer.okta.com/docs/reference/api/push-providers/#delete-push-provider
+func (s *PushProvidersService) Remove(ctx context.Context, id string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("push-providers/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// do is a helper function for the single push provider operations.
+func (s *PushProvidersService) do(ctx context.Context, method string, path string, providerIn *PushProvider) (*PushProvider, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if providerIn != nil {
+		body = providerIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	providerOut := new(PushProvider)
+	resp, err := s.client.Do(ctx, req, providerOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return providerOut, resp, nil
+}
@@ -0,0 +1,94 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// RateLimitSettingsService is the service providing access to the Rate Limit Settings Resource in the Okta API.
+// These are server side settings of the org, the client side tracking of rate limits is done by Client.
+type RateLimitSettingsService service
+
+// RateLimitAdminNotifications represents whether admins are notified when the org reaches its rate limits.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/RateLimitSettings/
+type RateLimitAdminNotifications struct {
+	NotificationsEnabled bool `json:"notificationsEnabled"`
+}
+
+// RateLimitWarningThreshold represents the percentage of a rate limit at which the org emits warnings.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/RateLimitSettings/
+type RateLimitWarningThreshold struct {
+	WarningThreshold int `json:"warningThreshold"`
+}
+
+// GetAdminNotifications fetches the rate limit admin notification setting.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/RateLimitSettings/#tag/RateLimitSettings/operation/getRateLimitSettingsAdminNotifications
+func (s *RateLimitSettingsService) GetAdminNotifications(ctx context.Context) (*RateLimitAdminNotifications, *Response, error) {
+	notificationsOut := new(RateLimitAdminNotifications)
+	resp, err := s.do(ctx, "GET", "rate-limit-settings/admin-notifications", nil, notificationsOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return notificationsOut, resp, nil
+}
+
+// UpdateAdminNotifications enables or disables the rate limit admin notifications.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/RateLimitSettings/#tag/RateLimitSettings/operation/replaceRateLimitSettingsAdminNotifications
+func (s *RateLimitSettingsService) UpdateAdminNotifications(ctx context.Context, enabled bool) (*RateLimitAdminNotifications, *Response, error) {
+	body := &RateLimitAdminNotifications{NotificationsEnabled: enabled}
+
+	notificationsOut := new(RateLimitAdminNotifications)
+	resp, err := s.do(ctx, "PUT", "rate-limit-settings/admin-notifications", body, notificationsOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return notificationsOut, resp, nil
+}
+
+// GetWarningThreshold fetches the rate limit warning threshold percentage.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/RateLimitSettings/#tag/RateLimitSettings/operation/getRateLimitSettingsWarningThreshold
+func (s *RateLimitSettingsService) GetWarningThreshold(ctx context.Context) (*RateLimitWarningThreshold, *Response, error) {
+	thresholdOut := new(RateLimitWarningThreshold)
+	resp, err := s.do(ctx, "GET", "rate-limit-settings/warning-threshold", nil, thresholdOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return thresholdOut, resp, nil
+}
+
+// UpdateWarningThreshold sets the rate limit warning threshold percentage, which must be between 10 and 90.
+//
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/RateLimitSettings/#tag/RateLimitSettings/operation/replaceRateLimitSettingsWarningThreshold
+func (s *RateLimitSettingsService) UpdateWarningThreshold(ctx context.Context, percentage int) (*RateLimitWarningThreshold, *Response, error) {
+	if percentage < 10 || percentage > 90 {
+		return nil, nil, fmt.Errorf("Invalid parameters, warning threshold must be between 10 and 90 but is %d", percentage)
+	}
+	body := &RateLimitWarningThreshold{WarningThreshold: percentage}
+
+	thresholdOut := new(RateLimitWarningThreshold)
+	resp, err := s.do(ctx, "PUT", "rate-limit-settings/warning-threshold", body, thresholdOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return thresholdOut, resp, nil
+}
+
+// do is a helper function for the rate limit settings operations.
+func (s *RateLimitSettingsService) do(ctx context.Context, method string, path string, body interface{}, v interface{}) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, v)
+}
@@ -1,31 +1,43 @@
-//go:generate stringer -type=rateLimitCategory
+//go:generate stringer -type=RateLimitCategory -linecomment
 
 package okta
 
+import "time"
+
 var rateLimitCategoryCtxKey contextKey
 
 // Rate represents an the status of an individual rate limit.
 type Rate struct {
 	Limit     int
 	Remaining int
-	Reset     Timestamp
+	// Reset is the time the rate limit resets, on the local clock: the time sent by Okta is
+	// corrected by ClockSkew.
+	Reset Timestamp
+	// ClockSkew is how far the clock of Okta was ahead of the local clock, see ClockSkew().
+	ClockSkew time.Duration
 }
 
-type rateLimitCategory int
+// RateLimitCategory is a type for the RateLimitCategory enum, the rate limit bucket of Okta an
+// endpoint counts against. Its String() is the name of the bucket, e.g. "users-get-by-id".
+//
+// https://developer.okta.com/docs/reference/rl-global-mgmt/
+type RateLimitCategory int
 
+// RateLimitCategory Constants
 const (
-	rateLimitCoreCategory rateLimitCategory = iota
-	rateLimitAppsCreateListCategory
-	rateLimitAppsGetUpdateDeleteCategory
-	rateLimitAuthnCategory
-	rateLimitGroupsCreateListCategory
-	rateLimitGroupsGetUpdateDeleteCategory
-	rateLimitLogsCategory
-	rateLimitSessionsCategory
-	rateLimitUsersCreateListCategory
-	rateLimitUsersGetByIDCategory
-	rateLimitUsersGetByLoginNameCategory
-	rateLimitUsersCreateUpdateDeleteByIDCategory
+	RateLimitCategoryCore                        RateLimitCategory = iota // core
+	RateLimitCategoryAppsCreateList                                       // apps-create-list
+	RateLimitCategoryAppsGetUpdateDelete                                  // apps-get-update-delete
+	RateLimitCategoryAuthn                                                // authn
+	RateLimitCategoryGroupsCreateList                                     // groups-create-list
+	RateLimitCategoryGroupsGetUpdateDelete                                // groups-get-update-delete
+	RateLimitCategoryLogs                                                 // logs
+	RateLimitCategorySessions                                             // sessions
+	RateLimitCategoryUsersCreateList                                      // users-create-list
+	RateLimitCategoryUsersGetByID                                         // users-get-by-id
+	RateLimitCategoryUsersGetByLoginName                                  // users-get-by-login-name
+	RateLimitCategoryUsersCreateUpdateDeleteByID                          // users-create-update-delete-by-id
 
-	categories // An array of this length will be able to contain all rate limit categories.
+	// An array of this length will be able to contain all rate limit categories.
+	categories
 )
@@ -0,0 +1,67 @@
+package okta
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// RawJSON holds the JSON a model was decoded from, so that the attributes its struct doesn't
+// cover yet remain available without a second fetch. It's embedded in the models of the
+// resources, e.g. App or User:
+//
+//	var extra struct {
+//		Orn string `json:"orn"`
+//	}
+//	err := json.Unmarshal(app.Raw(), &extra)
+type RawJSON struct {
+	raw json.RawMessage
+}
+
+// Raw returns the JSON the model was decoded from, or nil if it wasn't decoded from a response,
+// e.g. a model built by the caller.
+func (r *RawJSON) Raw() json.RawMessage {
+	return r.raw
+}
+
+func (r *RawJSON) setRaw(data []byte) {
+	r.raw = append(json.RawMessage(nil), data...)
+}
+
+// rawSetter is implemented by the models embedding RawJSON.
+type rawSetter interface {
+	setRaw(data []byte)
+}
+
+var rawSetterType = reflect.TypeOf((*rawSetter)(nil)).Elem()
+
+// setRaw sets the JSON of v, a model or a pointer to a slice of models, decoded from data. Other
+// values are left alone.
+func setRaw(v interface{}, data []byte) {
+	if r, ok := v.(rawSetter); ok {
+		r.setRaw(data)
+		return
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Slice {
+		return
+	}
+	slice := rv.Elem()
+	elemType := slice.Type().Elem()
+	if slice.Len() == 0 || !elemType.Implements(rawSetterType) && !reflect.PtrTo(elemType).Implements(rawSetterType) {
+		return
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil || len(items) != slice.Len() {
+		return
+	}
+	for i, item := range items {
+		elem := slice.Index(i)
+		if elem.Kind() != reflect.Ptr {
+			elem = elem.Addr()
+		} else if elem.IsNil() {
+			continue
+		}
+		elem.Interface().(rawSetter).setRaw(item)
+	}
+}
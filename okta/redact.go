@@ -0,0 +1,137 @@
+package okta
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// redactedValue replaces the secrets of the models printed, or marshaled with MarshalRedacted().
+const redactedValue = "[REDACTED]"
+
+// redactedAttributes are the JSON attributes of the models holding secrets, wherever they are.
+var redactedAttributes = map[string]bool{
+	"client_secret": true,
+	"secretKey":     true,
+	"sharedSecret":  true,
+	"access_token":  true,
+	"refresh_token": true,
+	"id_token":      true,
+	"answer":        true,
+}
+
+// redactedPasswordAttributes are the attributes holding secrets in a password object, or in the
+// hash of one.
+var redactedPasswordAttributes = map[string]bool{
+	"value": true,
+	"salt":  true,
+}
+
+// redact returns s redacted, or an empty string if s is, so that an unset secret still shows.
+func redact(s string) string {
+	if s == "" {
+		return ""
+	}
+	return redactedValue
+}
+
+// MarshalRedacted returns the JSON encoding of v, e.g. an App or a User, with its secrets
+// replaced by "[REDACTED]", to log a model without leaking passwords, client secrets or tokens.
+// The result isn't meant to be sent to Okta.
+func MarshalRedacted(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	return json.Marshal(redactJSON(value, ""))
+}
+
+// redactJSON redacts the secrets of value, decoded from JSON, the value of the attribute key.
+func redactJSON(value interface{}, key string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, attr := range v {
+			secret := redactedAttributes[k] || (key == "password" || key == "hash") && redactedPasswordAttributes[k]
+			if s, ok := attr.(string); ok && secret {
+				v[k] = redact(s)
+			} else {
+				v[k] = redactJSON(attr, k)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactJSON(item, key)
+		}
+	}
+	return value
+}
+
+// String returns the credential with its password and client secret redacted.
+func (c AppCredential) String() string {
+	c.Password.Value = redact(c.Password.Value)
+	c.OAuthClient.ClientSecret = redact(c.OAuthClient.ClientSecret)
+	type appCredential AppCredential
+	return fmt.Sprintf("%+v", appCredential(c))
+}
+
+// String returns the credential with its client secret redacted.
+func (c AppCredentialOAuthCredential) String() string {
+	c.ClientSecret = redact(c.ClientSecret)
+	type appCredentialOAuthCredential AppCredentialOAuthCredential
+	return fmt.Sprintf("%+v", appCredentialOAuthCredential(c))
+}
+
+// String returns the password redacted.
+func (p AppPassword) String() string {
+	return fmt.Sprintf("{Value:%s}", redact(p.Value))
+}
+
+// String returns the credentials with the password, its hash and the answer to the recovery
+// question redacted.
+func (c UserCredentials) String() string {
+	c.Password.Value = redact(c.Password.Value)
+	c.Password.Hash.Salt = redact(c.Password.Hash.Salt)
+	c.Password.Hash.Value = redact(c.Password.Hash.Value)
+	type userCredentials UserCredentials
+	return fmt.Sprintf("%+v", userCredentials(c))
+}
+
+// String returns the credential with its client secret redacted.
+func (c IdentityProviderClientCredential) String() string {
+	c.ClientSecret = redact(c.ClientSecret)
+	type identityProviderClientCredential IdentityProviderClientCredential
+	return fmt.Sprintf("%+v", identityProviderClientCredential(c))
+}
+
+// String returns the token with the access, refresh and ID tokens redacted.
+func (t OAuthToken) String() string {
+	t.AccessToken = redact(t.AccessToken)
+	t.RefreshToken = redact(t.RefreshToken)
+	t.IDToken = redact(t.IDToken)
+	type oauthToken OAuthToken
+	return fmt.Sprintf("%+v", oauthToken(t))
+}
+
+// String returns the client of the credentials, without its secret or private key.
+func (c *ClientCredentials) String() string {
+	privateKey := ""
+	if c.PrivateKey != nil {
+		privateKey = redactedValue
+	}
+	return fmt.Sprintf("{Issuer:%s ClientID:%s ClientSecret:%s PrivateKey:%s KeyID:%s Scopes:%v}",
+		c.Issuer, c.ClientID, redact(c.ClientSecret), privateKey, c.KeyID, c.Scopes)
+}
+
+// String returns the flow with its client secret redacted.
+func (f *AuthCodeFlow) String() string {
+	type authCodeFlow AuthCodeFlow
+	flow := authCodeFlow(*f)
+	flow.ClientSecret = redact(flow.ClientSecret)
+	return fmt.Sprintf("%+v", flow)
+}
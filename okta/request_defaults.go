@@ -0,0 +1,63 @@
+package okta
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// RequestDefaults are defaults of the requests of a service, set once on the client instead of at
+// every call site, see Client.Defaults.
+type RequestDefaults struct {
+	// Limit replaces the page size the list methods ask for, e.g. smaller pages of applications,
+	// whose objects are large.
+	Limit int
+	// Expand is the expand parameter of the GET requests that don't set one, e.g. "stats,app" for
+	// the groups.
+	Expand string
+	// Query holds other parameters of the GET requests, set unless the requests set them.
+	Query url.Values
+	// Header holds headers of the requests, set unless the requests, or WithHeader(), set them.
+	Header http.Header
+}
+
+// withDefaults returns req, or a copy of it changed by the Defaults of the resource it requests.
+func (c *Client) withDefaults(req *http.Request) *http.Request {
+	if len(c.Defaults) == 0 || !strings.HasPrefix(req.URL.Path, c.BaseURL.Path) {
+		return req
+	}
+	resource := strings.TrimPrefix(req.URL.Path, c.BaseURL.Path)
+	if i := strings.IndexByte(resource, '/'); i >= 0 {
+		resource = resource[:i]
+	}
+	defaults := c.Defaults[resource]
+	if defaults == nil {
+		return req
+	}
+
+	req = req.Clone(req.Context())
+	for key, values := range defaults.Header {
+		if _, ok := req.Header[key]; !ok {
+			req.Header[key] = values
+		}
+	}
+	if req.Method != http.MethodGet {
+		return req
+	}
+
+	query := req.URL.Query()
+	if defaults.Limit > 0 && query.Get("limit") != "" {
+		query.Set("limit", strconv.Itoa(defaults.Limit))
+	}
+	if defaults.Expand != "" && query.Get("expand") == "" {
+		query.Set("expand", defaults.Expand)
+	}
+	for key, values := range defaults.Query {
+		if _, ok := query[key]; !ok {
+			query[key] = values
+		}
+	}
+	req.URL.RawQuery = query.Encode()
+	return req
+}
@@ -0,0 +1,156 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultResolverTTL is the time a Resolver created by NewResolver() with a zero TTL remembers an ID.
+const DefaultResolverTTL = 5 * time.Minute
+
+// resolverResourceUsers is the resource of the users resolved by a Resolver, which Cache doesn't
+// cache.
+const resolverResourceUsers CacheResource = "users"
+
+// ErrNameNotFound is returned by the methods of Resolver when no resource has the name.
+var ErrNameNotFound = errors.New("okta: no resource with the name")
+
+// Resolver resolves the names of groups, the labels of applications and the logins of users to
+// their IDs, e.g. for declarative tools referencing resources by human-readable names. Resolved IDs
+// are remembered for a TTL, so that resolving the same names over and over doesn't query the API
+// every time; names that aren't found aren't remembered.
+//
+// A rename or a deletion made meanwhile is seen once the entry expires; call Invalidate*() or
+// Purge() to see it earlier, e.g. after renaming a group. A Resolver is safe for concurrent use.
+type Resolver struct {
+	client *Client
+	ttl    time.Duration
+
+	mu  sync.Mutex
+	ids map[resolverKey]resolvedID
+}
+
+type resolverKey struct {
+	resource CacheResource
+	name     string
+}
+
+type resolvedID struct {
+	id      string
+	expires time.Time
+}
+
+// NewResolver is a helper method to create a new Resolver remembering IDs for ttl, or
+// DefaultResolverTTL if ttl is zero. A negative ttl disables the memoization.
+func NewResolver(client *Client, ttl time.Duration) *Resolver {
+	if ttl == 0 {
+		ttl = DefaultResolverTTL
+	}
+	return &Resolver{client: client, ttl: ttl, ids: make(map[resolverKey]resolvedID)}
+}
+
+// GroupID returns the ID of the group named name.
+func (r *Resolver) GroupID(ctx context.Context, name string) (string, error) {
+	return r.resolve(ctx, CacheResourceGroups, name, func(ctx context.Context) (string, error) {
+		id, _, err := groupIDByName(ctx, r.client, name)
+		return id, err
+	})
+}
+
+// AppID returns the ID of the application labeled label. Labels needn't be unique; an error is
+// returned if several applications have label.
+func (r *Resolver) AppID(ctx context.Context, label string) (string, error) {
+	return r.resolve(ctx, CacheResourceApps, label, func(ctx context.Context) (string, error) {
+		apps, err := all(ctx, func(ctx context.Context) (*Collection[*App], *Response, error) {
+			return r.client.Apps.List(ctx, &AppListParams{Q: label})
+		})
+		if err != nil {
+			return "", err
+		}
+		var ids []string
+		for _, app := range apps {
+			if app.Label == label {
+				ids = append(ids, app.ID)
+			}
+		}
+		if len(ids) > 1 {
+			return "", fmt.Errorf("okta: %d applications are labeled %q: %v", len(ids), label, ids)
+		}
+		if len(ids) == 0 {
+			return "", nil
+		}
+		return ids[0], nil
+	})
+}
+
+// UserID returns the ID of the user whose login is login.
+func (r *Resolver) UserID(ctx context.Context, login string) (string, error) {
+	return r.resolve(ctx, resolverResourceUsers, login, func(ctx context.Context) (string, error) {
+		users, err := all(ctx, func(ctx context.Context) (*Collection[*User], *Response, error) {
+			return r.client.Users.List(ctx, &UserListParams{Filter: fmt.Sprintf("profile.login eq %q", login)})
+		})
+		if err != nil || len(users) == 0 {
+			return "", err
+		}
+		return users[0].ID, nil
+	})
+}
+
+// InvalidateGroup forgets the ID of the group named name.
+func (r *Resolver) InvalidateGroup(name string) {
+	r.invalidate(CacheResourceGroups, name)
+}
+
+// InvalidateApp forgets the ID of the application labeled label.
+func (r *Resolver) InvalidateApp(label string) {
+	r.invalidate(CacheResourceApps, label)
+}
+
+// InvalidateUser forgets the ID of the user whose login is login.
+func (r *Resolver) InvalidateUser(login string) {
+	r.invalidate(resolverResourceUsers, login)
+}
+
+// Purge forgets all IDs.
+func (r *Resolver) Purge() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ids = make(map[resolverKey]resolvedID)
+}
+
+// resolve returns the remembered ID of the resource named name, or looks it up with lookup, which
+// returns an empty ID if there is no such resource.
+func (r *Resolver) resolve(ctx context.Context, resource CacheResource, name string, lookup func(ctx context.Context) (string, error)) (string, error) {
+	key := resolverKey{resource, name}
+	r.mu.Lock()
+	resolved, ok := r.ids[key]
+	r.mu.Unlock()
+	if ok && r.client.now().Before(resolved.expires) {
+		return resolved.id, nil
+	}
+
+	id, err := lookup(ctx)
+	if err != nil {
+		return "", err
+	}
+	if id == "" {
+		return "", fmt.Errorf("%w: %s %q", ErrNameNotFound, resource, name)
+	}
+
+	if r.ttl > 0 {
+		r.mu.Lock()
+		r.ids[key] = resolvedID{id: id, expires: r.client.now().Add(r.ttl)}
+		r.mu.Unlock()
+	}
+	return id, nil
+}
+
+// invalidate forgets the ID of the resource named name.
+func (r *Resolver) invalidate(resource CacheResource, name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.ids, resolverKey{resource, name})
+}
@@ -0,0 +1,55 @@
+package okta
+
+// ResourceSet represents a set of resources that custom roles can be bound to.
+//
+// https://developer.okta.com/docs/reference/api/roles/#resource-set-object
+type ResourceSet struct {
+	RawJSON
+	ID          string `json:"id,omitempty"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	// Resources is only used when creating a resource set, it's a list of resource URLs
+	// such as "https://{yourOktaDomain}/api/v1/groups/{groupId}".
+	Resources   []string    `json:"resources,omitempty"`
+	Created     Timestamp   `json:"created,omitempty"`
+	LastUpdated Timestamp   `json:"lastUpdated,omitempty"`
+	Links       interface{} `json:"_links,omitempty"`
+}
+
+// ResourceSetResource represents a single resource within a resource set.
+//
+// https://developer.okta.com/docs/reference/api/roles/#resource-object
+type ResourceSetResource struct {
+	ID          string    `json:"id"`
+	ORN         string    `json:"orn,omitempty"`
+	Created     Timestamp `json:"created"`
+	LastUpdated Timestamp `json:"lastUpdated"`
+	Links       struct {
+		Self struct {
+			Link string `json:"href"`
+		} `json:"self"`
+	} `json:"_links"`
+}
+
+// ResourceSetBinding represents the binding of a role to a resource set.
+//
+// https://developer.okta.com/docs/reference/api/roles/#binding-object
+type ResourceSetBinding struct {
+	RawJSON
+	ID    string      `json:"id"`
+	Links interface{} `json:"_links,omitempty"`
+}
+
+// ResourceSetBindingMember represents a user or group that is a member of a binding.
+//
+// https://developer.okta.com/docs/reference/api/roles/#member-object
+type ResourceSetBindingMember struct {
+	ID          string    `json:"id"`
+	Created     Timestamp `json:"created"`
+	LastUpdated Timestamp `json:"lastUpdated"`
+	Links       struct {
+		Self struct {
+			Link string `json:"href"`
+		} `json:"self"`
+	} `json:"_links"`
+}
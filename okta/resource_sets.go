@@ -0,0 +1,175 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// ResourceSetsService is the service providing access to the Resource Sets (IAM) in the Okta API
+type ResourceSetsService service
+
+// List fetches the first page of resource sets.
+//
+// https://developer.okta.com/docs/reference/api/roles/#list-resource-sets
+func (s *ResourceSetsService) List(ctx context.Context) (*Collection[*ResourceSet], *Response, error) {
+	path := "iam/resource-sets"
+	return listIAMPage[*ResourceSet](ctx, s.client, "resource-sets", path)
+}
+
+// GetByID fetches a resource set by ID.
+//
+// https://developer.okta.com/docs/reference/api/roles/#get-a-resource-set
+func (s *ResourceSetsService) GetByID(ctx context.Context, id string) (*ResourceSet, *Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("iam/resource-sets/%s", id), nil)
+}
+
+// Add creates a new resource set. The initial resources are passed in resourceSetIn.Resources.
+//
+// https://developer.okta.com/docs/reference/api/roles/#create-resource-set
+func (s *ResourceSetsService) Add(ctx context.Context, resourceSetIn *ResourceSet) (*ResourceSet, *Response, error) {
+	return s.do(ctx, "POST", "iam/resource-sets", resourceSetIn)
+}
+
+// Update modifies the label and description of a resource set. Resources are managed
+// with AddResources() and RemoveResource().
+//
+// https://developer.okta.com/docs/reference/api/roles/#update-resource-set
+func (s *ResourceSetsService) Update(ctx context.Context, id string, resourceSetIn *ResourceSet) (*ResourceSet, *Response, error) {
+	body := &ResourceSet{Label: resourceSetIn.Label, Description: resourceSetIn.Description}
+	return s.do(ctx, "PUT", fmt.Sprintf("iam/resource-sets/%s", id), body)
+}
+
+// Remove deletes a resource set.
+//
+// https://developer.okta.com/docs/reference/api/roles/#delete-resource-set
+func (s *ResourceSetsService) Remove(ctx context.Context, id string) (*Response, error) {
+	return s.delete(ctx, fmt.Sprintf("iam/resource-sets/%s", id))
+}
+
+// do is a helper function for the single resource set operations.
+func (s *ResourceSetsService) do(ctx context.Context, method string, path string, resourceSetIn *ResourceSet) (*ResourceSet, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if resourceSetIn != nil {
+		body = resourceSetIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resourceSetOut := new(ResourceSet)
+	resp, err := s.client.Do(ctx, req, resourceSetOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resourceSetOut, resp, nil
+}
+
+// delete is a helper function for the delete operations.
+func (s *ResourceSetsService) delete(ctx context.Context, path string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// patchAdditions is a helper function for the operations adding resources or members.
+func (s *ResourceSetsService) patchAdditions(ctx context.Context, path string, additions []string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	body := map[string]interface{}{"additions": additions}
+
+	req, err := s.client.NewRequest("PATCH", path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListResources fetches the first page of the resources of a resource set.
+//
+// https://developer.okta.com/docs/reference/api/roles/#list-resources
+func (s *ResourceSetsService) ListResources(ctx context.Context, id string) (*Collection[*ResourceSetResource], *Response, error) {
+	path := fmt.Sprintf("iam/resource-sets/%s/resources", id)
+	return listIAMPage[*ResourceSetResource](ctx, s.client, "resources", path)
+}
+
+// AddResources adds resources, identified by their URLs, to a resource set.
+//
+// https://developer.okta.com/docs/reference/api/roles/#add-more-resources
+func (s *ResourceSetsService) AddResources(ctx context.Context, id string, resourceURLs []string) (*Response, error) {
+	return s.patchAdditions(ctx, fmt.Sprintf("iam/resource-sets/%s/resources", id), resourceURLs)
+}
+
+// RemoveResource removes a resource from a resource set.
+//
+// https://developer.okta.com/docs/reference/api/roles/#delete-a-resource
+func (s *ResourceSetsService) RemoveResource(ctx context.Context, id string, resourceID string) (*Response, error) {
+	return s.delete(ctx, fmt.Sprintf("iam/resource-sets/%s/resources/%s", id, resourceID))
+}
+
+// ListBindings fetches the first page of the role bindings of a resource set.
+//
+// https://developer.okta.com/docs/reference/api/roles/#list-bindings
+func (s *ResourceSetsService) ListBindings(ctx context.Context, id string) (*Collection[*ResourceSetBinding], *Response, error) {
+	path := fmt.Sprintf("iam/resource-sets/%s/bindings", id)
+	return listIAMPage[*ResourceSetBinding](ctx, s.client, "roles", path)
+}
+
+// AddBinding binds a custom role to a resource set, granting it to the members identified by their URLs
+// such as "https://{yourOktaDomain}/api/v1/users/{userId}".
+//
+// https://developer.okta.com/docs/reference/api/roles/#create-a-new-binding
+func (s *ResourceSetsService) AddBinding(ctx context.Context, id string, roleID string, memberURLs []string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("iam/resource-sets/%s/bindings", id)
+
+	body := map[string]interface{}{
+		"role":    roleID,
+		"members": memberURLs,
+	}
+
+	req, err := s.client.NewRequest("POST", path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RemoveBinding removes the binding of a role to a resource set.
+//
+// https://developer.okta.com/docs/reference/api/roles/#delete-a-binding
+func (s *ResourceSetsService) RemoveBinding(ctx context.Context, id string, roleID string) (*Response, error) {
+	return s.delete(ctx, fmt.Sprintf("iam/resource-sets/%s/bindings/%s", id, roleID))
+}
+
+// ListBindingMembers fetches the first page of the members of the binding of a role to a resource set.
+//
+// https://developer.okta.com/docs/reference/api/roles/#list-members-of-a-binding
+func (s *ResourceSetsService) ListBindingMembers(ctx context.Context, id string, roleID string) (*Collection[*ResourceSetBindingMember], *Response, error) {
+	path := fmt.Sprintf("iam/resource-sets/%s/bindings/%s/members", id, roleID)
+	return listIAMPage[*ResourceSetBindingMember](ctx, s.client, "members", path)
+}
+
+// AddBindingMembers adds members, identified by their URLs, to the binding of a role to a resource set.
+//
+// https://developer.okta.com/docs/reference/api/roles/#add-more-members-to-a-binding
+func (s *ResourceSetsService) AddBindingMembers(ctx context.Context, id string, roleID string, memberURLs []string) (*Response, error) {
+	return s.patchAdditions(ctx, fmt.Sprintf("iam/resource-sets/%s/bindings/%s/members", id, roleID), memberURLs)
+}
+
+// RemoveBindingMember removes a member from the binding of a role to a resource set.
+//
+// https://developer.okta.com/docs/reference/api/roles/#delete-a-member-from-a-binding
+func (s *ResourceSetsService) RemoveBindingMember(ctx context.Context, id string, roleID string, memberID string) (*Response, error) {
+	return s.delete(ctx, fmt.Sprintf("iam/resource-sets/%s/bindings/%s/members/%s", id, roleID, memberID))
+}
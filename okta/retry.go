@@ -0,0 +1,190 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+)
+
+// RetryClass is a type for the RetryClass enum, how a failed request is handled by the retries of
+// a Client.
+type RetryClass string
+
+// RetryClass Constants
+const (
+	// RetryClassFatal is an error that won't go away by retrying, e.g. a validation error.
+	RetryClassFatal RetryClass = "FATAL"
+	// RetryClassRetryable is a transient error, retried after a backoff, e.g. a 502 Bad Gateway.
+	RetryClassRetryable = "RETRYABLE"
+	// RetryClassRateLimited is a request rejected by the rate limit, retried once it resets.
+	RetryClassRateLimited = "RATE_LIMITED"
+)
+
+// RetryClassifier maps the errors of requests to a RetryClass, by the Okta error code of the
+// response, then by its status code. Errors of neither, e.g. network errors, are retryable
+// unless the context of the request is done. Errors matched by neither map are fatal.
+//
+// Start from DefaultRetryClassifier() to override some of the classes, e.g. to retry a 409
+// Conflict of a flaky custom integration:
+//
+//	classifier := okta.DefaultRetryClassifier()
+//	classifier.StatusCodes[http.StatusConflict] = okta.RetryClassRetryable
+type RetryClassifier struct {
+	// StatusCodes are the classes of the HTTP status codes of the responses.
+	StatusCodes map[int]RetryClass
+	// ErrorCodes are the classes of the Okta error codes of the responses, e.g. "E0000047". They
+	// take precedence over StatusCodes.
+	//
+	// https://developer.okta.com/docs/reference/error-codes/
+	ErrorCodes map[string]RetryClass
+}
+
+// DefaultRetryClassifier returns a new RetryClassifier, which retries the rate limited requests and
+// the 5xx errors of the gateways, and none of the errors of the requests themselves.
+func DefaultRetryClassifier() *RetryClassifier {
+	return &RetryClassifier{
+		StatusCodes: map[int]RetryClass{
+			http.StatusRequestTimeout:      RetryClassRetryable,
+			http.StatusTooManyRequests:     RetryClassRateLimited,
+			http.StatusInternalServerError: RetryClassRetryable,
+			http.StatusBadGateway:          RetryClassRetryable,
+			http.StatusServiceUnavailable:  RetryClassRetryable,
+			http.StatusGatewayTimeout:      RetryClassRetryable,
+		},
+		ErrorCodes: map[string]RetryClass{
+			// API validation failed.
+			"E0000001": RetryClassFatal,
+			// Internal server error.
+			"E0000009": RetryClassRetryable,
+			// API call exceeded rate limit due to too many requests.
+			"E0000047": RetryClassRateLimited,
+		},
+	}
+}
+
+// Classify returns the class of err, an error returned by Client.Do().
+func (c *RetryClassifier) Classify(err error) RetryClass {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return RetryClassFatal
+	}
+
+	var rateErr *RateLimitError
+	if errors.As(err, &rateErr) {
+		return RetryClassRateLimited
+	}
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) {
+		if class, ok := c.ErrorCodes[errResp.Code]; ok {
+			return class
+		}
+		if class, ok := c.StatusCodes[errResp.Response.StatusCode]; ok {
+			return class
+		}
+		return RetryClassFatal
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return RetryClassRetryable
+	}
+	return RetryClassFatal
+}
+
+// RetryPolicy configures the retries of the failed requests of a Client, see Client.Retry.
+type RetryPolicy struct {
+	// MaxRetries is the number of times a request is retried.
+	MaxRetries int
+	// MinBackoff is the wait before the first retry of a retryable error, doubled for every
+	// following retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the wait between retries of retryable errors.
+	MaxBackoff time.Duration
+	// Classifier classifies the errors; DefaultRetryClassifier() when nil.
+	Classifier *RetryClassifier
+}
+
+// NewRetryPolicy is a helper method to create a new RetryPolicy retrying 3 times, after 1, 2 and
+// 4 seconds, with the DefaultRetryClassifier().
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: time.Second,
+		MaxBackoff: 30 * time.Second,
+		Classifier: DefaultRetryClassifier(),
+	}
+}
+
+// backoff returns the wait before the retry number retry, from 0, of a request failed with err
+// of class.
+func (p *RetryPolicy) backoff(retry int, class RetryClass, err error, now time.Time) time.Duration {
+	var rateErr *RateLimitError
+	if class == RetryClassRateLimited && errors.As(err, &rateErr) {
+		if wait := rateErr.Rate.Reset.Sub(now); wait > 0 {
+			return wait
+		}
+	}
+
+	wait := p.MinBackoff << retry
+	if wait <= 0 || p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	return wait
+}
+
+// doWithRetries makes req with do(), retrying it according to the Retry policy of the client.
+// Retryable errors are only retried for the idempotent methods, as the request may have been
+// processed; rate limited requests weren't, and are retried for every method. Requests whose body
+// can't be read again aren't retried.
+func (c *Client) doWithRetries(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	policy := c.Retry
+	if policy == nil {
+		return c.do(ctx, req, v)
+	}
+	classifier := c.retryClassifier()
+
+	for retry := 0; ; retry++ {
+		attempt := req
+		if retry > 0 && req.Body != nil {
+			attempt = req.Clone(ctx)
+			attempt.Body, _ = req.GetBody()
+		}
+		resp, err := c.do(ctx, attempt, v)
+		if err == nil || retry >= policy.MaxRetries || req.Body != nil && req.GetBody == nil {
+			return resp, err
+		}
+
+		class := classifier.Classify(err)
+		if class == RetryClassFatal || class == RetryClassRetryable && !isIdempotent(req.Method) {
+			return resp, err
+		}
+		now := c.now()
+		if err := c.sleepUntil(ctx, now.Add(policy.backoff(retry, class, err, now))); err != nil {
+			return resp, err
+		}
+	}
+}
+
+// retryClassifier returns the classifier of the Retry policy of the client, or the
+// DefaultRetryClassifier() if it has none.
+func (c *Client) retryClassifier() *RetryClassifier {
+	if c == nil || c.Retry == nil || c.Retry.Classifier == nil {
+		return DefaultRetryClassifier()
+	}
+	return c.Retry.Classifier
+}
+
+// retries reports whether the Retry policy of the client retries the failed requests.
+func (c *Client) retries() bool {
+	return c != nil && c.Retry != nil && c.Retry.MaxRetries > 0
+}
+
+// isIdempotent reports whether requests of method can be repeated safely.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
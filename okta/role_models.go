@@ -0,0 +1,90 @@
+package okta
+
+// RoleType is a type for the RoleType enum of the standard administrator roles.
+//
+// https://developer.okta.com/docs/reference/api/roles/#role-types
+type RoleType string
+
+// RoleType Constants
+//
+// https://developer.okta.com/docs/reference/api/roles/#role-types
+const (
+	RoleTypeSuperAdmin               RoleType = "SUPER_ADMIN"
+	RoleTypeOrgAdmin                          = "ORG_ADMIN"
+	RoleTypeAppAdmin                          = "APP_ADMIN"
+	RoleTypeUserAdmin                         = "USER_ADMIN"
+	RoleTypeHelpDeskAdmin                     = "HELP_DESK_ADMIN"
+	RoleTypeReadOnlyAdmin                     = "READ_ONLY_ADMIN"
+	RoleTypeMobileAdmin                       = "MOBILE_ADMIN"
+	RoleTypeAPIAccessManagementAdmin          = "API_ACCESS_MANAGEMENT_ADMIN"
+	RoleTypeReportAdmin                       = "REPORT_ADMIN"
+	RoleTypeGroupMembershipAdmin              = "GROUP_MEMBERSHIP_ADMIN"
+	RoleTypeCustom                            = "CUSTOM"
+)
+
+// StandardRoleTypes is the catalog of the standard administrator roles available in every org.
+var StandardRoleTypes = []RoleType{
+	RoleTypeSuperAdmin,
+	RoleTypeOrgAdmin,
+	RoleTypeAppAdmin,
+	RoleTypeUserAdmin,
+	RoleTypeHelpDeskAdmin,
+	RoleTypeReadOnlyAdmin,
+	RoleTypeMobileAdmin,
+	RoleTypeAPIAccessManagementAdmin,
+	RoleTypeReportAdmin,
+	RoleTypeGroupMembershipAdmin,
+}
+
+// CustomRole represents a custom administrator role.
+//
+// https://developer.okta.com/docs/reference/api/roles/#custom-role-object
+type CustomRole struct {
+	RawJSON
+	ID          string      `json:"id,omitempty"`
+	Label       string      `json:"label"`
+	Description string      `json:"description"`
+	Permissions []string    `json:"permissions,omitempty"`
+	Created     Timestamp   `json:"created,omitempty"`
+	LastUpdated Timestamp   `json:"lastUpdated,omitempty"`
+	Links       interface{} `json:"_links,omitempty"`
+}
+
+// RoleAssignment represents an administrator role assigned to a user, either a standard role or a
+// custom role bound to a resource set.
+//
+// https://developer.okta.com/docs/reference/api/roles/#role-object
+type RoleAssignment struct {
+	RawJSON
+	ID    string   `json:"id"`
+	Label string   `json:"label"`
+	Type  RoleType `json:"type"`
+	// Status has possible values of: ACTIVE, INACTIVE
+	Status string `json:"status"`
+	// AssignmentType has possible values of: USER, GROUP
+	AssignmentType string `json:"assignmentType"`
+	// Role is the ID of the custom role, for custom roles.
+	Role string `json:"role,omitempty"`
+	// ResourceSet is the ID of the resource set the custom role is bound to, for custom roles.
+	ResourceSet string      `json:"resource-set,omitempty"`
+	Created     Timestamp   `json:"created,omitempty"`
+	LastUpdated Timestamp   `json:"lastUpdated,omitempty"`
+	Links       interface{} `json:"_links,omitempty"`
+}
+
+// RolePermission represents a permission granted by a custom role, e.g. "okta.users.manage".
+//
+// https://developer.okta.com/docs/reference/api/roles/#permission-object
+type RolePermission struct {
+	Label       string      `json:"label"`
+	Created     Timestamp   `json:"created,omitempty"`
+	LastUpdated Timestamp   `json:"lastUpdated,omitempty"`
+	Links       interface{} `json:"_links,omitempty"`
+}
+
+// iamLinks is a helper struct for the IAM endpoints, which return the pagination links in the body.
+type iamLinks struct {
+	Next struct {
+		Link string `json:"href"`
+	} `json:"next"`
+}
@@ -0,0 +1,128 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// RolesService is the service providing access to the custom administrator roles (IAM) in the Okta API
+type RolesService service
+
+// List fetches the first page of custom roles. The standard roles are listed in StandardRoleTypes.
+//
+// https://developer.okta.com/docs/reference/api/roles/#list-roles
+func (s *RolesService) List(ctx context.Context) (*Collection[*CustomRole], *Response, error) {
+	path := "iam/roles"
+	return listIAMPage[*CustomRole](ctx, s.client, "roles", path)
+}
+
+// GetByID fetches a custom role by ID or label.
+//
+// https://developer.okta.com/docs/reference/api/roles/#get-role
+func (s *RolesService) GetByID(ctx context.Context, id string) (*CustomRole, *Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("iam/roles/%s", id), nil)
+}
+
+// Add creates a new custom role with its permissions.
+//
+// https://developer.okta.com/docs/reference/api/roles/#create-role
+func (s *RolesService) Add(ctx context.Context, roleIn *CustomRole) (*CustomRole, *Response, error) {
+	return s.do(ctx, "POST", "iam/roles", roleIn)
+}
+
+// Update modifies the label and description of a custom role. Permissions are managed
+// with AddPermission() and RemovePermission().
+//
+// https://developer.okta.com/docs/reference/api/roles/#update-role
+func (s *RolesService) Update(ctx context.Context, id string, roleIn *CustomRole) (*CustomRole, *Response, error) {
+	body := &CustomRole{Label: roleIn.Label, Description: roleIn.Description}
+	return s.do(ctx, "PUT", fmt.Sprintf("iam/roles/%s", id), body)
+}
+
+// Remove deletes a custom role.
+//
+// https://developer.okta.com/docs/reference/api/roles/#delete-role
+func (s *RolesService) Remove(ctx context.Context, id string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("iam/roles/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// do is a helper function for the single role operations.
+func (s *RolesService) do(ctx context.Context, method string, path string, roleIn *CustomRole) (*CustomRole, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if roleIn != nil {
+		body = roleIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	roleOut := new(CustomRole)
+	resp, err := s.client.Do(ctx, req, roleOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return roleOut, resp, nil
+}
+
+// ListPermissions fetches the permissions of a custom role.
+//
+// https://developer.okta.com/docs/reference/api/roles/#list-permissions
+func (s *RolesService) ListPermissions(ctx context.Context, id string) ([]*RolePermission, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("iam/roles/%s/permissions", id)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var page struct {
+		Permissions []*RolePermission `json:"permissions"`
+	}
+	resp, err := s.client.Do(ctx, req, &page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return page.Permissions, resp, nil
+}
+
+// AddPermission grants a permission, e.g. "okta.users.manage", to a custom role.
+//
+// https://developer.okta.com/docs/reference/api/roles/#add-permission
+func (s *RolesService) AddPermission(ctx context.Context, id string, permission string) (*Response, error) {
+	return s.permission(ctx, "POST", id, permission)
+}
+
+// RemovePermission removes a permission from a custom role.
+//
+// https://developer.okta.com/docs/reference/api/roles/#delete-permission
+func (s *RolesService) RemovePermission(ctx context.Context, id string, permission string) (*Response, error) {
+	return s.permission(ctx, "DELETE", id, permission)
+}
+
+// permission is a helper function for the permission operations.
+func (s *RolesService) permission(ctx context.Context, method string, id string, permission string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("iam/roles/%s/permissions/%s", id, permission)
+
+	req, err := s.client.NewRequest(method, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
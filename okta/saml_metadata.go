@@ -0,0 +1,249 @@
+package okta
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SAML binding Constants
+const (
+	SAMLBindingHTTPPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	SAMLBindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+)
+
+// SAMLIdPMetadata represents the parts of the SAML 2.0 metadata of an Identity Provider needed to
+// federate with it.
+//
+// https://docs.oasis-open.org/security/saml/v2.0/saml-metadata-2.0-os.pdf
+type SAMLIdPMetadata struct {
+	EntityID string
+	// SSOPostURL and SSORedirectURL are the SingleSignOnService locations for the HTTP-POST and
+	// HTTP-Redirect bindings. At least one of them is set.
+	SSOPostURL     string
+	SSORedirectURL string
+	// SLOURL is the SingleLogoutService location for the HTTP-POST, or else HTTP-Redirect, binding.
+	SLOURL string
+	// SigningCertificates are the base64 encoded DER signing certificates.
+	SigningCertificates     []string
+	NameIDFormats           []string
+	WantAuthnRequestsSigned bool
+}
+
+// samlEntityDescriptor is the XML structure of SAML metadata.
+type samlEntityDescriptor struct {
+	XMLName  xml.Name              `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID string                `xml:"entityID,attr"`
+	IDP      *samlIDPSSODescriptor `xml:"IDPSSODescriptor"`
+}
+
+// samlEntitiesDescriptor is the XML structure of aggregated SAML metadata.
+type samlEntitiesDescriptor struct {
+	XMLName           xml.Name               `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntitiesDescriptor"`
+	EntityDescriptors []samlEntityDescriptor `xml:"EntityDescriptor"`
+}
+
+// samlIDPSSODescriptor is the XML structure of the Identity Provider role of an entity.
+type samlIDPSSODescriptor struct {
+	WantAuthnRequestsSigned    bool                `xml:"WantAuthnRequestsSigned,attr,omitempty"`
+	ProtocolSupportEnumeration string              `xml:"protocolSupportEnumeration,attr"`
+	KeyDescriptors             []samlKeyDescriptor `xml:"KeyDescriptor"`
+	SLO                        []samlEndpoint      `xml:"SingleLogoutService"`
+	NameIDFormats              []string            `xml:"NameIDFormat"`
+	SSO                        []samlEndpoint      `xml:"SingleSignOnService"`
+}
+
+// samlKeyDescriptor is the XML structure of a metadata key.
+type samlKeyDescriptor struct {
+	Use     string `xml:"use,attr,omitempty"`
+	KeyInfo struct {
+		XMLName     xml.Name `xml:"http://www.w3.org/2000/09/xmldsig# KeyInfo"`
+		Certificate string   `xml:"http://www.w3.org/2000/09/xmldsig# X509Data>X509Certificate"`
+	}
+}
+
+// samlEndpoint is the XML structure of a metadata endpoint.
+type samlEndpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+// ParseSAMLIdPMetadata parses SAML 2.0 Identity Provider metadata. When data is an aggregate,
+// an EntitiesDescriptor, the first entity with an IDPSSODescriptor is used.
+func ParseSAMLIdPMetadata(data []byte) (*SAMLIdPMetadata, error) {
+	var entity *samlEntityDescriptor
+
+	single := new(samlEntityDescriptor)
+	if err := xml.Unmarshal(data, single); err == nil {
+		entity = single
+	} else {
+		aggregate := new(samlEntitiesDescriptor)
+		if err := xml.Unmarshal(data, aggregate); err != nil {
+			return nil, fmt.Errorf("invalid SAML metadata: %v", err)
+		}
+		for i := range aggregate.EntityDescriptors {
+			if aggregate.EntityDescriptors[i].IDP != nil {
+				entity = &aggregate.EntityDescriptors[i]
+				break
+			}
+		}
+	}
+	if entity == nil || entity.IDP == nil {
+		return nil, errors.New("SAML metadata has no IDPSSODescriptor")
+	}
+
+	m := &SAMLIdPMetadata{
+		EntityID:                entity.EntityID,
+		NameIDFormats:           entity.IDP.NameIDFormats,
+		WantAuthnRequestsSigned: entity.IDP.WantAuthnRequestsSigned,
+	}
+	for _, kd := range entity.IDP.KeyDescriptors {
+		cert := strings.Join(strings.Fields(kd.KeyInfo.Certificate), "")
+		if cert != "" && (kd.Use == "" || kd.Use == "signing") {
+			m.SigningCertificates = append(m.SigningCertificates, cert)
+		}
+	}
+	for _, sso := range entity.IDP.SSO {
+		switch sso.Binding {
+		case SAMLBindingHTTPPost:
+			m.SSOPostURL = sso.Location
+		case SAMLBindingHTTPRedirect:
+			m.SSORedirectURL = sso.Location
+		}
+	}
+	for _, slo := range entity.IDP.SLO {
+		if slo.Binding == SAMLBindingHTTPPost || (slo.Binding == SAMLBindingHTTPRedirect && m.SLOURL == "") {
+			m.SLOURL = slo.Location
+		}
+	}
+
+	if m.EntityID == "" {
+		return nil, errors.New("SAML metadata has no entityID")
+	}
+	if m.SSOPostURL == "" && m.SSORedirectURL == "" {
+		return nil, errors.New("SAML metadata has no HTTP-POST or HTTP-Redirect SingleSignOnService")
+	}
+	if len(m.SigningCertificates) == 0 {
+		return nil, errors.New("SAML metadata has no signing certificate")
+	}
+	return m, nil
+}
+
+// Encode returns the metadata as a SAML 2.0 EntityDescriptor XML document.
+func (m *SAMLIdPMetadata) Encode() ([]byte, error) {
+	entity := &samlEntityDescriptor{EntityID: m.EntityID}
+	entity.IDP = &samlIDPSSODescriptor{
+		WantAuthnRequestsSigned:    m.WantAuthnRequestsSigned,
+		ProtocolSupportEnumeration: "urn:oasis:names:tc:SAML:2.0:protocol",
+		NameIDFormats:              m.NameIDFormats,
+	}
+
+	for _, cert := range m.SigningCertificates {
+		kd := samlKeyDescriptor{Use: "signing"}
+		kd.KeyInfo.Certificate = cert
+		entity.IDP.KeyDescriptors = append(entity.IDP.KeyDescriptors, kd)
+	}
+	if m.SLOURL != "" {
+		entity.IDP.SLO = append(entity.IDP.SLO, samlEndpoint{Binding: SAMLBindingHTTPPost, Location: m.SLOURL})
+	}
+	if m.SSOPostURL != "" {
+		entity.IDP.SSO = append(entity.IDP.SSO, samlEndpoint{Binding: SAMLBindingHTTPPost, Location: m.SSOPostURL})
+	}
+	if m.SSORedirectURL != "" {
+		entity.IDP.SSO = append(entity.IDP.SSO, samlEndpoint{Binding: SAMLBindingHTTPRedirect, Location: m.SSORedirectURL})
+	}
+
+	buf := bytes.NewBufferString(xml.Header)
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(entity); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// IdentityProvider returns an inbound SAML Identity Provider trusting the metadata. kid is the ID
+// of the signing certificate in the Identity Provider key store, see AddKey(). audience is the
+// audience Okta expects in assertions, usually the entity ID the Identity Provider knows Okta by.
+//
+// Users are provisioned and linked automatically, matched by the NameID of assertions.
+func (m *SAMLIdPMetadata) IdentityProvider(name, kid, audience string) *IdentityProvider {
+	sso := &IdentityProviderEndpoint{Binding: "HTTP-POST", URL: m.SSOPostURL, Destination: m.SSOPostURL}
+	if m.SSOPostURL == "" {
+		sso = &IdentityProviderEndpoint{Binding: "HTTP-REDIRECT", URL: m.SSORedirectURL, Destination: m.SSORedirectURL}
+	}
+
+	idp := &IdentityProvider{
+		Type: IdentityProviderTypeSAML2,
+		Name: name,
+		Protocol: IdentityProviderProtocol{
+			Type: "SAML2",
+			Endpoints: &IdentityProviderEndpoints{
+				SSO: sso,
+				ACS: &IdentityProviderEndpoint{Binding: "HTTP-POST", Type: "INSTANCE"},
+			},
+			Credentials: &IdentityProviderProtocolCredentials{
+				Trust: &IdentityProviderTrustCredential{
+					Issuer:   m.EntityID,
+					Audience: audience,
+					KID:      kid,
+				},
+			},
+		},
+	}
+	if len(m.NameIDFormats) > 0 {
+		idp.Protocol.Settings = &IdentityProviderProtocolSettings{NameFormat: m.NameIDFormats[0]}
+	}
+
+	idp.Policy.Provisioning.Action = "AUTO"
+	idp.Policy.Provisioning.Groups.Action = "NONE"
+	idp.Policy.Provisioning.Conditions.Deprovisioned.Action = "NONE"
+	idp.Policy.Provisioning.Conditions.Suspended.Action = "NONE"
+	idp.Policy.AccountLink.Action = "AUTO"
+	idp.Policy.Subject.UserNameTemplate.Template = "idpuser.subjectNameId"
+	idp.Policy.Subject.MatchType = "USERNAME"
+	idp.Policy.MaxClockSkew = 120000
+	return idp
+}
+
+// ImportSAMLMetadata creates an inbound SAML Identity Provider from SAML 2.0 Identity Provider
+// metadata, adding its first signing certificate to the key store. See SAMLIdPMetadata.IdentityProvider().
+func (s *IdentityProvidersService) ImportSAMLMetadata(ctx context.Context, name, audience string, metadata []byte) (*IdentityProvider, *Response, error) {
+	m, err := ParseSAMLIdPMetadata(metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, resp, err := s.AddKey(ctx, m.SigningCertificates[0])
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return s.Add(ctx, m.IdentityProvider(name, key.KID, audience))
+}
+
+// GetSAMLMetadata fetches the SAML 2.0 Service Provider metadata of an inbound SAML Identity
+// Provider, to be imported into the Identity Provider.
+//
+// https://developer.okta.com/docs/reference/api/idps/#get-saml-metadata-for-identity-provider
+func (s *IdentityProvidersService) GetSAMLMetadata(ctx context.Context, id string) ([]byte, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("idps/%s/metadata.xml", id)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "application/xml")
+
+	metadata := new(bytes.Buffer)
+	resp, err := s.client.Do(ctx, req, metadata)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return metadata.Bytes(), resp, nil
+}
@@ -0,0 +1,110 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SessionsService is the service providing access to the Sessions Resource in the Okta API
+type SessionsService service
+
+// SessionStatus is a type for the SessionStatus enum, the state of a Session.
+//
+// https://developer.okta.com/docs/reference/api/sessions/#session-status
+type SessionStatus string
+
+// SessionStatus Constants
+const (
+	SessionStatusActive      SessionStatus = "ACTIVE"
+	SessionStatusMFARequired               = "MFA_REQUIRED"
+	SessionStatusMFAEnroll                 = "MFA_ENROLL"
+)
+
+// SessionStatuses is the list of the valid SessionStatus values.
+var SessionStatuses = []SessionStatus{SessionStatusActive, SessionStatusMFARequired, SessionStatusMFAEnroll}
+
+// Valid reports whether s is a known session status.
+func (s SessionStatus) Valid() bool {
+	for _, v := range SessionStatuses {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Session represents an Okta session of a user, the session the SSO to the applications rides on.
+//
+// https://developer.okta.com/docs/reference/api/sessions/#session-object
+type Session struct {
+	RawJSON
+	ID                       string        `json:"id"`
+	UserID                   string        `json:"userId"`
+	Login                    string        `json:"login"`
+	CreatedAt                time.Time     `json:"createdAt"`
+	ExpiresAt                time.Time     `json:"expiresAt"`
+	Status                   SessionStatus `json:"status"`
+	LastPasswordVerification *time.Time    `json:"lastPasswordVerification,omitempty"`
+	LastFactorVerification   *time.Time    `json:"lastFactorVerification,omitempty"`
+	// AMR are the authentication methods used, e.g. "pwd" or "mfa".
+	AMR []string `json:"amr"`
+	IDP struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	} `json:"idp"`
+	MFAActive bool `json:"mfaActive"`
+}
+
+// Get fetches a session.
+//
+// https://developer.okta.com/docs/reference/api/sessions/#get-session
+func (s *SessionsService) Get(ctx context.Context, id string) (*Session, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategorySessions)
+	path := fmt.Sprintf("sessions/%s", id)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	session := new(Session)
+	resp, err := s.client.Do(ctx, req, session)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return session, resp, nil
+}
+
+// Close closes a session, logging the user out of Okta. The sessions of the applications the
+// user signed in to through it aren't closed, unless they participate in single logout.
+//
+// https://developer.okta.com/docs/reference/api/sessions/#close-session
+func (s *SessionsService) Close(ctx context.Context, id string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategorySessions)
+	path := fmt.Sprintf("sessions/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// CloseUserSessions closes all the sessions of a user, and revokes the OAuth tokens issued to the
+// user too if revokeOAuthTokens is set.
+//
+// https://developer.okta.com/docs/reference/api/users/#clear-user-sessions
+func (s *SessionsService) CloseUserSessions(ctx context.Context, userID string, revokeOAuthTokens bool) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryUsersCreateUpdateDeleteByID)
+	path := fmt.Sprintf("users/%s/sessions?oauthTokens=%t", userID, revokeOAuthTokens)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,178 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// statsConcurrency is the number of listings Stats() runs at once.
+const statsConcurrency = 4
+
+// userStatuses are the statuses of users counted by Stats().
+//
+// https://developer.okta.com/docs/reference/api/users/#user-status
+var userStatuses = []string{
+	"STAGED",
+	"PROVISIONED",
+	"ACTIVE",
+	"RECOVERY",
+	"LOCKED_OUT",
+	"PASSWORD_EXPIRED",
+	"SUSPENDED",
+	"DEPROVISIONED",
+}
+
+// OrgStats represents the counts of the users, groups and applications of an org, see
+// Client.Stats().
+type OrgStats struct {
+	// UsersByStatus are the numbers of users by status, including the deprovisioned ones.
+	UsersByStatus map[string]int
+	// GroupsByType are the numbers of groups by type.
+	GroupsByType map[GroupType]int
+	// AppsByStatus are the numbers of applications by status.
+	AppsByStatus map[AppStatus]int
+	// AppsBySignOnMode are the numbers of applications by sign on mode.
+	AppsBySignOnMode map[AppSignOnMode]int
+	// Collected is the time the counting started.
+	Collected time.Time
+}
+
+// Users returns the total number of users.
+func (s *OrgStats) Users() int {
+	return sum(s.UsersByStatus)
+}
+
+// Groups returns the total number of groups.
+func (s *OrgStats) Groups() int {
+	return sum(s.GroupsByType)
+}
+
+// Apps returns the total number of applications.
+func (s *OrgStats) Apps() int {
+	return sum(s.AppsByStatus)
+}
+
+// Stats counts the users by status, the groups by type and the applications by status and sign on
+// mode, e.g. for a dashboard or to compare orgs. The API has no counts, so every user, group and
+// application is listed, with one filtered listing per status or type, a few of them at once.
+// Expect it to take a while, and to use the list rate limits, on large orgs.
+//
+// If a listing fails, or ctx is done, the error is a *PartialResultError[*OrgStats] whose only
+// item are the counts so far, those of the listings that didn't complete being lower bounds.
+func (c *Client) Stats(ctx context.Context) (*OrgStats, error) {
+	stats := &OrgStats{
+		UsersByStatus:    make(map[string]int),
+		GroupsByType:     make(map[GroupType]int),
+		AppsByStatus:     make(map[AppStatus]int),
+		AppsBySignOnMode: make(map[AppSignOnMode]int),
+		Collected:        c.now(),
+	}
+	var mu sync.Mutex
+
+	var tasks []func(ctx context.Context) error
+	for _, status := range userStatuses {
+		status := status
+		tasks = append(tasks, func(ctx context.Context) error {
+			users, err := all(ctx, func(ctx context.Context) (*Collection[*User], *Response, error) {
+				return c.Users.List(ctx, &UserListParams{Filter: fmt.Sprintf("status eq %q", status)})
+			})
+			mu.Lock()
+			stats.UsersByStatus[status] = len(users)
+			mu.Unlock()
+			return err
+		})
+	}
+	for _, groupType := range GroupTypes {
+		groupType := groupType
+		tasks = append(tasks, func(ctx context.Context) error {
+			groups, err := all(ctx, func(ctx context.Context) (*Collection[*Group], *Response, error) {
+				return c.Groups.List(ctx, &GroupListParams{Type: groupType})
+			})
+			mu.Lock()
+			stats.GroupsByType[groupType] = len(groups)
+			mu.Unlock()
+			return err
+		})
+	}
+	for _, status := range []AppStatus{AppStatusActive, AppStatusInactive} {
+		status := status
+		tasks = append(tasks, func(ctx context.Context) error {
+			apps, err := all(ctx, func(ctx context.Context) (*Collection[*App], *Response, error) {
+				return c.Apps.List(ctx, &AppListParams{Status: status})
+			})
+			mu.Lock()
+			stats.AppsByStatus[status] = len(apps)
+			for _, app := range apps {
+				stats.AppsBySignOnMode[app.SignOnMode]++
+			}
+			mu.Unlock()
+			return err
+		})
+	}
+
+	if err := runConcurrently(ctx, statsConcurrency, tasks); err != nil {
+		return nil, partialResult([]*OrgStats{stats}, err)
+	}
+	return stats, nil
+}
+
+// all is a helper function that returns all the items of the collection returned by list, or
+// those listed until an error.
+func all[T any](ctx context.Context, list func(ctx context.Context) (*Collection[T], *Response, error)) ([]T, error) {
+	page, _, err := list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items, _, err := page.All(ctx)
+	if err != nil {
+		return partialItems[T](err), err
+	}
+	return items, nil
+}
+
+// runConcurrently runs tasks, concurrency of them at once, and returns the first error. The
+// context of the tasks is canceled on the first error, so that the others stop early; the tasks
+// not started once the context is done aren't run.
+func runConcurrently(ctx context.Context, concurrency int, tasks []func(ctx context.Context) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var once sync.Once
+	var firstErr error
+	queue := make(chan func(ctx context.Context) error)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range queue {
+				err := ctx.Err()
+				if err == nil {
+					err = task(ctx)
+				}
+				if err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+	for _, task := range tasks {
+		queue <- task
+	}
+	close(queue)
+	wg.Wait()
+	return firstErr
+}
+
+func sum[K comparable](counts map[K]int) int {
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	return total
+}
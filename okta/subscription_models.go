@@ -0,0 +1,42 @@
+package okta
+
+// Subscription represents the subscription of an admin role, or a user, to a notification type.
+//
+// https://developer.okta.com/docs/reference/api/admin-notifications/#subscription-object
+type Subscription struct {
+	RawJSON
+	NotificationType NotificationType `json:"notificationType"`
+	Channels         []string         `json:"channels"`
+	// Status has possible values of: "subscribed", "unsubscribed"
+	Status string      `json:"status"`
+	Links  interface{} `json:"_links,omitempty"`
+}
+
+// NotificationType is a type for the NotificationType enum.
+//
+// https://developer.okta.com/docs/reference/api/admin-notifications/#notification-types
+type NotificationType string
+
+// NotificationType Constants
+//
+// https://developer.okta.com/docs/reference/api/admin-notifications/#notification-types
+const (
+	NotificationTypeConnectorAgent           NotificationType = "CONNECTOR_AGENT"
+	NotificationTypeUserLockedOut                             = "USER_LOCKED_OUT"
+	NotificationTypeAppImport                                 = "APP_IMPORT"
+	NotificationTypeLDAPAgent                                 = "LDAP_AGENT"
+	NotificationTypeADAgent                                   = "AD_AGENT"
+	NotificationTypeOktaAnnouncement                          = "OKTA_ANNOUNCEMENT"
+	NotificationTypeOktaIssue                                 = "OKTA_ISSUE"
+	NotificationTypeOktaUpdate                                = "OKTA_UPDATE"
+	NotificationTypeIWAAgent                                  = "IWA_AGENT"
+	NotificationTypeUserDeprovision                           = "USER_DEPROVISION"
+	NotificationTypeReportSuspiciousActivity                  = "REPORT_SUSPICIOUS_ACTIVITY"
+	NotificationTypeRateLimitNotification                     = "RATELIMIT_NOTIFICATION"
+)
+
+// Subscription Status Constants
+const (
+	SubscriptionStatusSubscribed   = "subscribed"
+	SubscriptionStatusUnsubscribed = "unsubscribed"
+)
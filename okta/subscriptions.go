@@ -0,0 +1,93 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubscriptionsService is the service providing access to the Subscriptions Resource in the Okta API
+type SubscriptionsService service
+
+// ListForRole fetches the notification subscriptions of an admin role. roleRef is either a standard
+// RoleType, such as "SUPER_ADMIN", or the ID of a custom role.
+//
+// https://developer.okta.com/docs/reference/api/admin-notifications/#list-subscriptions-of-a-custom-role-or-role-type
+func (s *SubscriptionsService) ListForRole(ctx context.Context, roleRef string) ([]*Subscription, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("roles/%s/subscriptions", roleRef)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var subscriptions []*Subscription
+	resp, err := s.client.Do(ctx, req, &subscriptions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return subscriptions, resp, nil
+}
+
+// GetForRole fetches the subscription of an admin role to a notification type.
+//
+// https://developer.okta.com/docs/reference/api/admin-notifications/#get-subscriptions-of-a-custom-role-or-role-type-with-a-specific-notification-type
+func (s *SubscriptionsService) GetForRole(ctx context.Context, roleRef string, notificationType NotificationType) (*Subscription, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("roles/%s/subscriptions/%s", roleRef, notificationType)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subscriptionOut := new(Subscription)
+	resp, err := s.client.Do(ctx, req, subscriptionOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return subscriptionOut, resp, nil
+}
+
+// SubscribeRole subscribes an admin role to a notification type.
+//
+// https://developer.okta.com/docs/reference/api/admin-notifications/#subscribe-a-custom-role-or-role-type-to-a-specific-notification-type
+func (s *SubscriptionsService) SubscribeRole(ctx context.Context, roleRef string, notificationType NotificationType) (*Response, error) {
+	return s.lifecycle(ctx, roleRef, notificationType, "subscribe")
+}
+
+// UnsubscribeRole unsubscribes an admin role from a notification type.
+//
+// https://developer.okta.com/docs/reference/api/admin-notifications/#unsubscribe-a-custom-role-or-role-type-from-a-specific-notification-type
+func (s *SubscriptionsService) UnsubscribeRole(ctx context.Context, roleRef string, notificationType NotificationType) (*Response, error) {
+	return s.lifecycle(ctx, roleRef, notificationType, "unsubscribe")
+}
+
+// SetForRole subscribes or unsubscribes an admin role to or from a notification type, so that
+// callers asserting a notification posture don't have to branch on the desired state.
+func (s *SubscriptionsService) SetForRole(ctx context.Context, roleRef string, notificationType NotificationType, subscribed bool) (*Response, error) {
+	if subscribed {
+		return s.SubscribeRole(ctx, roleRef, notificationType)
+	}
+	return s.UnsubscribeRole(ctx, roleRef, notificationType)
+}
+
+// lifecycle is a helper function for the subscribe and unsubscribe operations.
+func (s *SubscriptionsService) lifecycle(ctx context.Context, roleRef string, notificationType NotificationType, operation string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("roles/%s/subscriptions/%s/%s", roleRef, notificationType, operation)
+
+	req, err := s.client.NewRequest("POST", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// IsSubscribed reports whether the subscription is active.
+func (sub *Subscription) IsSubscribed() bool {
+	return sub.Status == SubscriptionStatusSubscribed
+}
@@ -0,0 +1,31 @@
+package okta
+
+import (
+	"context"
+	"time"
+)
+
+const timeoutCtxKey contextKey = "timeout"
+
+// WithTimeout returns a copy of ctx with which the requests of a Client time out after d,
+// overriding Client.Timeout. A d of zero disables the timeout. The timeout bounds each request,
+// not the calls that make several, such as Collection.All(); use context.WithTimeout() for that.
+func WithTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, timeoutCtxKey, d)
+}
+
+// requestContext returns ctx bounded by the timeout of a request: the one set by WithTimeout(),
+// otherwise Client.Timeout if ctx has no deadline of its own.
+func (c *Client) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout, ok := ctx.Value(timeoutCtxKey).(time.Duration)
+	if !ok {
+		if _, hasDeadline := ctx.Deadline(); hasDeadline {
+			return ctx, func() {}
+		}
+		timeout = c.Timeout
+	}
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,188 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TokenTypeHint is a type for the token_type_hint parameter of the introspection and revocation
+// endpoints.
+//
+// https://developer.okta.com/docs/reference/api/oidc/#introspect
+type TokenTypeHint string
+
+// TokenTypeHint Constants
+const (
+	TokenTypeHintNone         TokenTypeHint = ""
+	TokenTypeHintAccessToken                = "access_token"
+	TokenTypeHintRefreshToken               = "refresh_token"
+	TokenTypeHintIDToken                    = "id_token"
+	TokenTypeHintDeviceSecret               = "device_secret"
+)
+
+// TokenIntrospection represents the response of the introspection endpoint. Only Active is set for
+// a token that is expired, revoked or unknown.
+//
+// https://developer.okta.com/docs/reference/api/oidc/#response-properties-3
+type TokenIntrospection struct {
+	Active    bool          `json:"active"`
+	TokenType string        `json:"token_type,omitempty"`
+	Scope     string        `json:"scope,omitempty"`
+	ClientID  string        `json:"client_id,omitempty"`
+	Username  string        `json:"username,omitempty"`
+	ExpiresAt int64         `json:"exp,omitempty"`
+	IssuedAt  int64         `json:"iat,omitempty"`
+	NotBefore int64         `json:"nbf,omitempty"`
+	Subject   string        `json:"sub,omitempty"`
+	Audience  claimAudience `json:"aud,omitempty"`
+	Issuer    string        `json:"iss,omitempty"`
+	JTI       string        `json:"jti,omitempty"`
+	UID       string        `json:"uid,omitempty"`
+	DeviceID  string        `json:"device_id,omitempty"`
+	// Raw holds all the properties, including the custom claims of the token.
+	Raw map[string]interface{} `json:"-"`
+}
+
+// Scopes returns the scopes of the token.
+func (t *TokenIntrospection) Scopes() []string {
+	return strings.Fields(t.Scope)
+}
+
+// HasScope reports whether the token was granted scope.
+func (t *TokenIntrospection) HasScope(scope string) bool {
+	for _, s := range t.Scopes() {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Expires returns the expiration time of the token, or the zero time if it's not active.
+func (t *TokenIntrospection) Expires() time.Time {
+	if t.ExpiresAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(t.ExpiresAt, 0)
+}
+
+// OAuthError represents an error response of an endpoint of an authorization server.
+//
+// https://developer.okta.com/docs/reference/api/oidc/#response-example-error-3
+type OAuthError struct {
+	// StatusCode is the status code of the response, 0 for an error of the authorization response.
+	StatusCode  int
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (e *OAuthError) Error() string {
+	if e.StatusCode == 0 {
+		// An error of the authorization response, see AuthorizeRequest.Callback().
+		return fmt.Sprintf("okta: %s - %s", e.Code, e.Description)
+	}
+	return fmt.Sprintf("okta: (%d) %s - %s", e.StatusCode, e.Code, e.Description)
+}
+
+// TokenClient introspects and revokes the tokens of an authorization server, authenticating as an
+// OAuth client with its client ID and secret, e.g. a resource server receiving opaque tokens, or
+// tokens it must check haven't been revoked. Use AccessTokenVerifier to validate JWT access
+// tokens locally instead.
+type TokenClient struct {
+	// Issuer is the issuer of the authorization server, e.g.
+	// https://example.okta.com/oauth2/default, or the org URL for the org authorization server.
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// HTTPClient makes the requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// NewTokenClient is a helper method to create a new TokenClient of the authorization server whose
+// issuer is issuer, authenticating as the client clientID.
+func NewTokenClient(issuer, clientID, clientSecret string) *TokenClient {
+	return &TokenClient{
+		Issuer:       strings.TrimSuffix(issuer, "/"),
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+	}
+}
+
+// Introspect returns the state of token, and its claims if it's active. hint is the type of the
+// token, if known, which speeds up the lookup. An inactive token isn't an error.
+//
+// https://developer.okta.com/docs/reference/api/oidc/#introspect
+func (c *TokenClient) Introspect(ctx context.Context, token string, hint TokenTypeHint) (*TokenIntrospection, error) {
+	data, err := c.post(ctx, "introspect", token, hint)
+	if err != nil {
+		return nil, err
+	}
+
+	introspection := new(TokenIntrospection)
+	if err := json.Unmarshal(data, introspection); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &introspection.Raw); err != nil {
+		return nil, err
+	}
+	return introspection, nil
+}
+
+// Revoke revokes token, an access or refresh token issued to the client. Revoking a token that is
+// already invalid isn't an error.
+//
+// https://developer.okta.com/docs/reference/api/oidc/#revoke
+func (c *TokenClient) Revoke(ctx context.Context, token string, hint TokenTypeHint) error {
+	_, err := c.post(ctx, "revoke", token, hint)
+	return err
+}
+
+// post posts token to the endpoint of the authorization server, and returns the body of the
+// response, or an *OAuthError.
+func (c *TokenClient) post(ctx context.Context, endpoint string, token string, hint TokenTypeHint) ([]byte, error) {
+	form := url.Values{"token": {token}}
+	if hint != TokenTypeHintNone {
+		form.Set("token_type_hint", string(hint))
+	}
+	return postOAuthForm(ctx, c.HTTPClient, oauthEndpoint(c.Issuer, endpoint), form, func(req *http.Request) {
+		req.SetBasicAuth(url.QueryEscape(c.ClientID), url.QueryEscape(c.ClientSecret))
+	})
+}
+
+// postOAuthForm posts form to the endpoint of an authorization server at endpointURL, with the
+// client authentication set by auth, and returns the body of the response, or an *OAuthError.
+func postOAuthForm(ctx context.Context, httpClient *http.Client, endpointURL string, form url.Values, auth func(req *http.Request)) ([]byte, error) {
+	req, err := http.NewRequest("POST", endpointURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	auth(req)
+
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		oauthErr := &OAuthError{StatusCode: resp.StatusCode}
+		json.Unmarshal(data, oauthErr)
+		return nil, oauthErr
+	}
+	return data, nil
+}
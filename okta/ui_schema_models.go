@@ -0,0 +1,43 @@
+package okta
+
+// UISchema represents the layout of a self-service registration or profile enrollment form. A
+// UI schema is attached to a profile enrollment policy rule by its ID.
+//
+// https://developer.okta.com/docs/reference/api/ui-schemas/#ui-schema-object
+type UISchema struct {
+	RawJSON
+	ID          string          `json:"id,omitempty"`
+	UISchema    UISchemaElement `json:"uiSchema"`
+	Created     Timestamp       `json:"created,omitempty"`
+	LastUpdated Timestamp       `json:"lastUpdated,omitempty"`
+	Links       interface{}     `json:"_links,omitempty"`
+}
+
+// UISchemaElement represents the form itself, or a single input of a form. Controls reference a
+// property of the user profile schema in Scope, e.g. "#/properties/firstName".
+//
+// https://developer.okta.com/docs/reference/api/ui-schemas/#ui-schema-body-object
+type UISchemaElement struct {
+	// Type has possible values of: "Group", "Control"
+	Type        string             `json:"type"`
+	Label       string             `json:"label,omitempty"`
+	ButtonLabel string             `json:"buttonLabel,omitempty"`
+	Scope       string             `json:"scope,omitempty"`
+	Options     *UISchemaOptions   `json:"options,omitempty"`
+	Elements    []*UISchemaElement `json:"elements,omitempty"`
+}
+
+// UISchemaOptions is a helper struct.
+type UISchemaOptions struct {
+	// Format has possible values of: "text", "radio", "select", "checkbox"
+	Format string `json:"format,omitempty"`
+}
+
+// NewUISchemaControl is a helper method to create a form input for the named user profile property.
+func NewUISchemaControl(property, label string) *UISchemaElement {
+	return &UISchemaElement{
+		Type:  "Control",
+		Scope: "#/properties/" + property,
+		Label: label,
+	}
+}
@@ -0,0 +1,107 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// UISchemasService is the service providing access to the UI Schemas Resource in the Okta API
+type UISchemasService service
+
+// List fetches all UI schemas.
+//
+// https://developer.okta.com/docs/reference/api/ui-schemas/#get-all-ui-schemas
+func (s *UISchemasService) List(ctx context.Context) ([]*UISchema, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := "meta/uischemas"
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var schemas []*UISchema
+	resp, err := s.client.Do(ctx, req, &schemas)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return schemas, resp, nil
+}
+
+// GetByID fetches a UI schema by ID.
+//
+// When Client.Cache is set, a cached UI schema is returned with a Response of status 200 OK and
+// no headers, see Cache.
+//
+// https://developer.okta.com/docs/reference/api/ui-schemas/#get-a-ui-schema
+func (s *UISchemasService) GetByID(ctx context.Context, id string) (*UISchema, *Response, error) {
+	schemaOut := new(UISchema)
+	if resp := s.client.cached(CacheResourceUISchemas, id, schemaOut); resp != nil {
+		return schemaOut, resp, nil
+	}
+
+	schemaOut, resp, err := s.do(ctx, "GET", fmt.Sprintf("meta/uischemas/%s", id), nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	s.client.cache(CacheResourceUISchemas, id, schemaOut)
+	return schemaOut, resp, nil
+}
+
+// Add creates a new UI schema.
+//
+// https://developer.okta.com/docs/reference/api/ui-schemas/#create-a-ui-schema
+func (s *UISchemasService) Add(ctx context.Context, schemaIn *UISchema) (*UISchema, *Response, error) {
+	return s.do(ctx, "POST", "meta/uischemas", schemaIn)
+}
+
+// Update modifies a UI schema.
+//
+// Note that delta updates are not supported. You must pass a full UISchema object.
+//
+// https://developer.okta.com/docs/reference/api/ui-schemas/#update-a-ui-schema
+func (s *UISchemasService) Update(ctx context.Context, id string, schemaIn *UISchema) (*UISchema, *Response, error) {
+	defer s.client.invalidate(CacheResourceUISchemas, id)
+	return s.do(ctx, "PUT", fmt.Sprintf("meta/uischemas/%s", id), schemaIn)
+}
+
+// Remove deletes a UI schema. UI schemas in use by a profile enrollment policy can't be deleted.
+//
+// https://developer.okta.com/docs/reference/api/ui-schemas/#delete-a-ui-schema
+func (s *UISchemasService) Remove(ctx context.Context, id string) (*Response, error) {
+	defer s.client.invalidate(CacheResourceUISchemas, id)
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("meta/uischemas/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// do is a helper function for the requests returning a UISchema.
+func (s *UISchemasService) do(ctx context.Context, method, path string, schemaIn *UISchema) (*UISchema, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+
+	var body interface{}
+	if schemaIn != nil {
+		body = schemaIn
+	}
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schemaOut := new(UISchema)
+	resp, err := s.client.Do(ctx, req, schemaOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return schemaOut, resp, nil
+}
@@ -0,0 +1,133 @@
+package okta
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// UnknownEnumFunc is called with the values of the enums of a response the package doesn't know,
+// e.g. a sign on mode or a factor type Okta added since, so that consumers notice them before
+// they break the logic relying on the known values. It's called for every occurrence, e.g. for
+// every user of a page; it must not block, nor modify the value.
+type UnknownEnumFunc func(u *UnknownEnumValue)
+
+// UnknownEnumValue represents a value of an enum which isn't one of its constants.
+type UnknownEnumValue struct {
+	// Enum is the name of the enum, e.g. "AppSignOnMode" or "UserStatus".
+	Enum  string
+	Value string
+	// Path is the path of the attribute in the JSON of the response, e.g. "[3].signOnMode".
+	Path string
+	// Request is the request of the response.
+	Request *http.Request
+}
+
+func (u *UnknownEnumValue) String() string {
+	return fmt.Sprintf("unknown %s %q at %s of %s %s", u.Enum, u.Value, u.Path, u.Request.Method, u.Request.URL)
+}
+
+// enumValue is implemented by the enum types, e.g. AppStatus.
+type enumValue interface {
+	Valid() bool
+}
+
+// stringEnum is an enum whose attributes are plain strings in the models.
+type stringEnum struct {
+	name   string
+	values []string
+}
+
+// valid reports whether value is one of the values of e.
+func (e stringEnum) valid(value string) bool {
+	for _, v := range e.values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+// factorStatuses are the statuses of factors.
+//
+// https://developer.okta.com/docs/reference/api/factors/#factor-status
+var factorStatuses = []string{"NOT_SETUP", "PENDING_ACTIVATION", "ENROLLED", "ACTIVE", "INACTIVE", "EXPIRED"}
+
+// stringEnums are the enums of the attributes of models which are plain strings, by model and
+// field.
+var stringEnums = map[reflect.Type]map[string]stringEnum{
+	reflect.TypeOf(User{}):   {"Status": {"UserStatus", userStatuses}},
+	reflect.TypeOf(Factor{}): {"Status": {"FactorStatus", factorStatuses}},
+}
+
+// checkEnums calls the OnUnknownEnum hook of the client, if set, with the unknown enum values of
+// v, decoded from the response to req.
+func (c *Client) checkEnums(req *http.Request, v interface{}) {
+	if c.OnUnknownEnum == nil {
+		return
+	}
+	walkEnums(reflect.ValueOf(v), "", func(enum, value, path string) {
+		c.OnUnknownEnum(&UnknownEnumValue{Enum: enum, Value: value, Path: path, Request: req})
+	})
+}
+
+// walkEnums calls report with the enum values of v, at path, which aren't valid.
+func walkEnums(v reflect.Value, path string, report func(enum, value, path string)) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			walkEnums(v.Elem(), path, report)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		enums := stringEnums[t]
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			if field.Anonymous {
+				walkEnums(v.Field(i), path, report)
+				continue
+			}
+			fieldPath := attributePath(path, field)
+			if enum, ok := enums[field.Name]; ok {
+				if value := v.Field(i).String(); value != "" && !enum.valid(value) {
+					report(enum.name, value, fieldPath)
+				}
+				continue
+			}
+			walkEnums(v.Field(i), fieldPath, report)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			walkEnums(v.Index(i), fmt.Sprintf("%s[%d]", path, i), report)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			walkEnums(iter.Value(), joinPath(path, fmt.Sprint(iter.Key().Interface())), report)
+		}
+	case reflect.String:
+		if e, ok := v.Interface().(enumValue); ok && v.String() != "" && !e.Valid() {
+			report(v.Type().Name(), v.String(), path)
+		}
+	}
+}
+
+// attributePath returns the path of the JSON attribute of field, in the object at path.
+func attributePath(path string, field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" || name == "-" {
+		name = field.Name
+	}
+	return joinPath(path, name)
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,47 @@
+package okta
+
+import (
+	"context"
+	"strings"
+)
+
+// SetUserAgentSuffix identifies the caller in the User-Agent of the requests of the client, after
+// the go-okta one, e.g. "go-okta my-sync/1.2.0" for the suffix "my-sync/1.2.0", which Okta support
+// asks for when diagnosing the traffic of an integration. Calling it again replaces the suffix;
+// an empty suffix restores the go-okta User-Agent.
+func (c *Client) SetUserAgentSuffix(suffix string) {
+	c.UserAgent = strings.TrimSpace(userAgent + " " + suffix)
+}
+
+// ProductInfo returns the product token name/version of the User-Agent format, e.g.
+// "my-sync/1.2.0", for SetUserAgentSuffix(). The characters a token can't hold are replaced with
+// "-", and the version is omitted if empty.
+//
+// https://www.rfc-editor.org/rfc/rfc9110#name-user-agent
+func ProductInfo(name, version string) string {
+	product := productToken(name)
+	if version != "" {
+		product += "/" + productToken(version)
+	}
+	return product
+}
+
+// WithProductInfo returns a copy of ctx with which the requests of a Client identify the product
+// name/version too in their User-Agent, after the one of the client, e.g. for a component of a
+// larger tool sharing its client. Calling it again adds another product.
+func WithProductInfo(ctx context.Context, name, version string) context.Context {
+	opts := optionsFrom(ctx)
+	opts.products = append(opts.products[:len(opts.products):len(opts.products)], ProductInfo(name, version))
+	return context.WithValue(ctx, requestOptionsCtxKey, opts)
+}
+
+// productToken replaces the characters of s which aren't allowed in a token of a User-Agent with
+// "-".
+func productToken(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r > ' ' && r < 0x7f && !strings.ContainsRune(`"(),/:;<=>?@[\]{}`, r) {
+			return r
+		}
+		return '-'
+	}, s)
+}
@@ -0,0 +1,85 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// PasswordHash represents the hash of a password imported with a user, see UsersService.Import().
+//
+// https://developer.okta.com/docs/reference/api/users/#hashed-password-object
+type PasswordHash struct {
+	// Algorithm has possible values of: "BCRYPT", "SHA-512", "SHA-256", "SHA-1", "MD5", "PBKDF2"
+	Algorithm string `json:"algorithm"`
+	// WorkFactor is the cost of a BCRYPT hash.
+	WorkFactor int    `json:"workFactor,omitempty"`
+	Salt       string `json:"salt,omitempty"`
+	// SaltOrder has possible values of: "PREFIX", "POSTFIX", for the SHA and MD5 hashes.
+	SaltOrder string `json:"saltOrder,omitempty"`
+	Value     string `json:"value"`
+	// DigestAlgorithm, IterationCount and KeySize are the parameters of a PBKDF2 hash.
+	DigestAlgorithm string `json:"digestAlgorithm,omitempty"`
+	IterationCount  int    `json:"iterationCount,omitempty"`
+	KeySize         int    `json:"keySize,omitempty"`
+}
+
+// String returns the hash with its value and salt redacted.
+func (h PasswordHash) String() string {
+	h.Salt = redact(h.Salt)
+	h.Value = redact(h.Value)
+	type passwordHash PasswordHash
+	return fmt.Sprintf("%+v", passwordHash(h))
+}
+
+// UserImportParams is a helper struct for calling Import(). At most one of PasswordHash and
+// PasswordHook should be set.
+type UserImportParams struct {
+	Profile *UserProfile
+	// PasswordHash, if set, is the hash of the password of the user in the system it comes from.
+	PasswordHash *PasswordHash
+	// PasswordHook imports the password of the user at its first sign-in, verified by the password
+	// import inline hook of the org.
+	PasswordHook bool
+	// Activate activates the user. A user imported with a password doesn't receive an activation
+	// email.
+	Activate bool
+}
+
+// Import creates a new user migrated from another system, with the hash of its password or to
+// have its password imported by the password import inline hook. Empty profile attributes are not
+// sent.
+//
+// https://developer.okta.com/docs/reference/api/users/#create-user-with-imported-hashed-password
+func (s *UsersService) Import(ctx context.Context, params *UserImportParams) (*User, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryUsersCreateList)
+	path := fmt.Sprintf("users?activate=%t", params.Activate)
+
+	profileIn, err := nonEmptyAttributes(params.Profile)
+	if err != nil {
+		return nil, nil, err
+	}
+	body := map[string]interface{}{"profile": profileIn}
+	switch {
+	case params.PasswordHash != nil:
+		body["credentials"] = map[string]interface{}{
+			"password": map[string]interface{}{"hash": params.PasswordHash},
+		}
+	case params.PasswordHook:
+		body["credentials"] = map[string]interface{}{
+			"password": map[string]interface{}{"hook": map[string]string{"type": "default"}},
+		}
+	}
+
+	req, err := s.client.NewRequest("POST", path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userOut := new(User)
+	resp, err := s.client.Do(ctx, req, userOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return userOut, resp, nil
+}
@@ -0,0 +1,111 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// InactiveUserReport represents the active users who didn't sign in for some time, see
+// UsersService.Inactive().
+type InactiveUserReport struct {
+	// Since is the start of the window searched for sign-ins.
+	Since time.Time
+	// Checked is the number of users checked.
+	Checked int
+	// Inactive are the users without a sign-in since Since, in the order they were listed. Their
+	// LastLogin is zero if they never signed in.
+	Inactive []*User
+}
+
+// Inactive returns the active users who didn't sign in for inactiveFor, e.g. to reclaim licenses
+// or to review dormant accounts. Users created less than inactiveFor ago aren't reported.
+//
+// The lastLogin of a user is only updated when the user signs in to Okta, not when it uses an
+// existing session to sign in to applications, so the users whose lastLogin is older than the
+// window are cross-checked with the user.session.start and user.authentication.sso events of the
+// System Log. The System Log retains 90 days of events: for a longer inactiveFor, only the last 90
+// days are cross-checked.
+//
+// Okta can't search the users who never signed in, so the users created before the window are
+// listed, and their lastLogin checked.
+//
+// https://developer.okta.com/docs/reference/api/event-types/#catalog
+func (s *UsersService) Inactive(ctx context.Context, inactiveFor time.Duration) (*InactiveUserReport, error) {
+	if inactiveFor <= 0 {
+		return nil, fmt.Errorf("inactiveFor must be positive, not %v", inactiveFor)
+	}
+	now := s.client.now()
+	since := now.Add(-inactiveFor)
+
+	page, _, err := s.List(ctx, &UserListParams{
+		Search: fmt.Sprintf(`status eq "ACTIVE" and created lt %q`, since.UTC().Format("2006-01-02T15:04:05.000Z")),
+	})
+	if err != nil {
+		return nil, err
+	}
+	users, _, err := page.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &InactiveUserReport{Since: since, Checked: len(users)}
+	for _, user := range users {
+		if user.LastLogin.After(since) {
+			continue
+		}
+		report.Inactive = append(report.Inactive, user)
+	}
+	if len(report.Inactive) == 0 {
+		return report, nil
+	}
+
+	logSince := since
+	if retained := now.Add(-logRetention); logSince.Before(retained) {
+		logSince = retained
+	}
+	lastSignIns, err := s.lastSignIns(ctx, logSince, now)
+	if err != nil {
+		return nil, err
+	}
+	inactive := report.Inactive[:0]
+	for _, user := range report.Inactive {
+		if _, ok := lastSignIns[user.ID]; !ok {
+			inactive = append(inactive, user)
+		}
+	}
+	report.Inactive = inactive
+	return report, nil
+}
+
+// lastSignIns returns the time of the last sign-in to Okta, or to an application, between since
+// and until, by user ID.
+func (s *UsersService) lastSignIns(ctx context.Context, since time.Time, until time.Time) (map[string]time.Time, error) {
+	params := &LogListParams{
+		Since:     since,
+		Until:     until,
+		Filter:    `(eventType eq "user.session.start" or eventType eq "user.authentication.sso") and outcome.result eq "SUCCESS"`,
+		SortOrder: "ASCENDING",
+		Limit:     1000,
+	}
+
+	last := make(map[string]time.Time)
+	events, resp, err := s.client.Logs.List(ctx, params)
+	for {
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range events {
+			if event.Published.After(last[event.Actor.ID]) {
+				last[event.Actor.ID] = event.Published.Time
+			}
+		}
+		if len(events) == 0 || resp.Pagination.Next == "" {
+			return last, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		events, resp, err = s.client.Logs.ListNext(ctx, resp.Pagination.Next)
+	}
+}
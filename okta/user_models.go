@@ -1,6 +1,9 @@
 package okta
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 /*
 {
@@ -77,6 +80,7 @@ import "time"
 //
 // https://developer.okta.com/docs/api/resources/users#user-model
 type User struct {
+	RawJSON
 	ID              string    `json:"id"`
 	Status          string    `json:"status"`
 	Created         time.Time `json:"created"`
@@ -86,6 +90,11 @@ type User struct {
 	LastUpdated     time.Time `json:"lastUpdated"`
 	PasswordChanged time.Time `json:"passwordChanged"`
 
+	// Type is the user type of the user, see https://developer.okta.com/docs/reference/api/user-types/
+	Type *struct {
+		ID string `json:"id"`
+	} `json:"type,omitempty"`
+
 	Profile UserProfile `json:"profile"`
 
 	Credentials UserCredentials `json:"credentials"`
@@ -142,6 +151,69 @@ type UserProfile struct {
 	State             string `json:"state"`
 	ZipCode           string `json:"zipCode"`
 	CountryCode       string `json:"countryCode"`
+
+	// Custom holds the custom attributes of the profile, the attributes added to the user schema,
+	// keyed by attribute name.
+	Custom map[string]interface{} `json:"-"`
+}
+
+// userProfile is UserProfile without its JSON methods.
+type userProfile UserProfile
+
+// MarshalJSON implements the json.Marshaler interface, adding the custom attributes to the base
+// attributes.
+func (p UserProfile) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(userProfile(p))
+	if err != nil || len(p.Custom) == 0 {
+		return data, err
+	}
+
+	attributes := make(map[string]interface{})
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return nil, err
+	}
+	for k, v := range p.Custom {
+		if _, ok := attributes[k]; !ok {
+			attributes[k] = v
+		}
+	}
+	return json.Marshal(attributes)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, collecting the attributes that aren't
+// base attributes into Custom.
+func (p *UserProfile) UnmarshalJSON(data []byte) error {
+	base := userProfile(*p)
+	if err := json.Unmarshal(data, &base); err != nil {
+		return err
+	}
+
+	var attributes map[string]interface{}
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return err
+	}
+	known, err := json.Marshal(userProfile{})
+	if err != nil {
+		return err
+	}
+	var knownAttributes map[string]interface{}
+	if err := json.Unmarshal(known, &knownAttributes); err != nil {
+		return err
+	}
+
+	base.Custom = nil
+	for k, v := range attributes {
+		if _, ok := knownAttributes[k]; ok {
+			continue
+		}
+		if base.Custom == nil {
+			base.Custom = make(map[string]interface{})
+		}
+		base.Custom[k] = v
+	}
+
+	*p = UserProfile(base)
+	return nil
 }
 
 // UserCredentials represents the credentials object in Okta.
@@ -0,0 +1,60 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListRefreshTokens fetches the first page of the refresh tokens issued to a client on behalf of a user.
+//
+// https://developer.okta.com/docs/reference/api/users/#list-refresh-tokens
+func (s *UsersService) ListRefreshTokens(ctx context.Context, userID string, clientID string) (*Collection[*OAuth2RefreshToken], *Response, error) {
+	path := fmt.Sprintf("users/%s/clients/%s/tokens?limit=%d", userID, clientID, 200)
+	return listPage[*OAuth2RefreshToken](ctx, s.client, RateLimitCategoryCore, path)
+}
+
+// RevokeRefreshToken revokes a single refresh token issued to a client on behalf of a user.
+//
+// https://developer.okta.com/docs/reference/api/users/#revoke-token-for-user-and-client
+func (s *UsersService) RevokeRefreshToken(ctx context.Context, userID string, clientID string, tokenID string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("users/%s/clients/%s/tokens/%s", userID, clientID, tokenID)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RevokeRefreshTokens revokes all refresh tokens issued to a client on behalf of a user.
+//
+// https://developer.okta.com/docs/reference/api/users/#revoke-all-refresh-tokens-for-user-and-client
+func (s *UsersService) RevokeRefreshTokens(ctx context.Context, userID string, clientID string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("users/%s/clients/%s/tokens", userID, clientID)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RevokeGrants revokes all the grants a user gave to clients, the consents to the scopes they
+// requested.
+//
+// https://developer.okta.com/docs/reference/api/users/#revoke-all-grants-for-a-user
+func (s *UsersService) RevokeGrants(ctx context.Context, userID string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("users/%s/grants", userID)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -2,7 +2,9 @@ package okta
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // UsersService is the service providing access to the Users Resource in the Okta API
@@ -12,7 +14,7 @@ type UsersService service
 //
 // https://developer.okta.com/docs/api/resources/users#get-user-with-id
 func (s *UsersService) GetByID(ctx context.Context, id string) (*User, *Response, error) {
-	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, rateLimitUsersGetByIDCategory)
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryUsersGetByID)
 	path := fmt.Sprintf("users/%s", id)
 
 	req, err := s.client.NewRequest("GET", path, nil)
@@ -29,3 +31,168 @@ func (s *UsersService) GetByID(ctx context.Context, id string) (*User, *Response
 	return userOut, resp, nil
 
 }
+
+// UserListParams is a helper struct for calling List(). At most one of Q, Filter and Search
+// should be set.
+//
+// https://developer.okta.com/docs/reference/api/users/#list-users
+type UserListParams struct {
+	// Q matches the beginning of the login, first name, last name or email of users.
+	Q string
+	// Filter is a filter expression on a limited set of attributes, e.g. `status eq "ACTIVE"`.
+	Filter string
+	// Search is a search expression on any user attribute, e.g. `profile.department eq "Engineering"`.
+	Search string
+}
+
+// List fetches the first page of users, optionally filtered by params.
+//
+// https://developer.okta.com/docs/reference/api/users/#list-users
+func (s *UsersService) List(ctx context.Context, params *UserListParams) (*Collection[*User], *Response, error) {
+	query := url.Values{}
+	query.Set("limit", "200")
+	if params != nil {
+		if params.Q != "" {
+			query.Set("q", params.Q)
+		}
+		if params.Filter != "" {
+			query.Set("filter", params.Filter)
+		}
+		if params.Search != "" {
+			query.Set("search", params.Search)
+		}
+	}
+	path := fmt.Sprintf("users?%s", query.Encode())
+
+	return listPage[*User](ctx, s.client, RateLimitCategoryUsersCreateList, path)
+}
+
+// Add creates a new user without credentials. Empty profile attributes are not sent. If activate
+// is true the user is activated, and receives an activation email.
+//
+// https://developer.okta.com/docs/reference/api/users/#create-user-without-credentials
+func (s *UsersService) Add(ctx context.Context, profile *UserProfile, activate bool) (*User, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryUsersCreateList)
+	path := fmt.Sprintf("users?activate=%t", activate)
+
+	profileIn, err := nonEmptyAttributes(profile)
+	if err != nil {
+		return nil, nil, err
+	}
+	body := map[string]interface{}{"profile": profileIn}
+
+	req, err := s.client.NewRequest("POST", path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userOut := new(User)
+	resp, err := s.client.Do(ctx, req, userOut)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return userOut, resp, nil
+}
+
+// Deactivate deactivates a user. The user loses access to Okta and its applications, and is
+// deprovisioned from them.
+//
+// https://developer.okta.com/docs/reference/api/users/#deactivate-user
+func (s *UsersService) Deactivate(ctx context.Context, id string) (*Response, error) {
+	return s.lifecycle(ctx, id, "deactivate")
+}
+
+// Suspend suspends an active user. The user can't sign in until unsuspended, but keeps its
+// application assignments.
+//
+// https://developer.okta.com/docs/reference/api/users/#suspend-user
+func (s *UsersService) Suspend(ctx context.Context, id string) (*Response, error) {
+	return s.lifecycle(ctx, id, "suspend")
+}
+
+// ResetFactors resets all the factors enrolled by a user, who enrolls them again at the next
+// sign-in.
+//
+// https://developer.okta.com/docs/reference/api/users/#reset-factors
+func (s *UsersService) ResetFactors(ctx context.Context, id string) (*Response, error) {
+	return s.lifecycle(ctx, id, "reset_factors")
+}
+
+// lifecycle is a helper function for the lifecycle operations.
+func (s *UsersService) lifecycle(ctx context.Context, id string, operation string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryUsersCreateUpdateDeleteByID)
+	path := fmt.Sprintf("users/%s/lifecycle/%s", id, operation)
+
+	req, err := s.client.NewRequest("POST", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Remove deletes a deactivated user. Calling Remove on a user that isn't deactivated deactivates
+// it instead, so it takes two calls to delete an active user.
+//
+// https://developer.okta.com/docs/reference/api/users/#delete-user
+func (s *UsersService) Remove(ctx context.Context, id string) (*Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryUsersCreateUpdateDeleteByID)
+	path := fmt.Sprintf("users/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListRoles fetches the administrator roles assigned to a user, directly or through a group.
+//
+// https://developer.okta.com/docs/reference/api/roles/#list-roles-assigned-to-a-user
+func (s *UsersService) ListRoles(ctx context.Context, id string) ([]*RoleAssignment, *Response, error) {
+	ctx = context.WithValue(ctx, rateLimitCategoryCtxKey, RateLimitCategoryCore)
+	path := fmt.Sprintf("users/%s/roles", id)
+
+	req, err := s.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*RoleAssignment
+	resp, err := s.client.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return roles, resp, nil
+}
+
+// ListGroups fetches the first page of the groups a user is a member of.
+//
+// https://developer.okta.com/docs/reference/api/users/#get-user-s-groups
+func (s *UsersService) ListGroups(ctx context.Context, id string) (*Collection[*Group], *Response, error) {
+	path := fmt.Sprintf("users/%s/groups?limit=%d", id, 200)
+	return listPage[*Group](ctx, s.client, RateLimitCategoryCore, path)
+}
+
+// nonEmptyAttributes converts a profile struct into a map, leaving out the empty string attributes.
+func nonEmptyAttributes(profile interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make(map[string]interface{})
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return nil, err
+	}
+	for k, v := range attributes {
+		if v == "" {
+			delete(attributes, k)
+		}
+	}
+
+	return attributes, nil
+}
@@ -0,0 +1,122 @@
+package okta
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// EncodeWebAuthn encodes binary WebAuthn data, e.g. the clientDataJSON or the attestationObject
+// returned by the browser, in the unpadded base64url encoding Okta expects.
+func EncodeWebAuthn(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeWebAuthn decodes the base64url encoded WebAuthn data returned by Okta, e.g. the challenge
+// of a factor activation or verification, padded or not. The standard base64 encoding is
+// accepted too.
+func DecodeWebAuthn(s string) ([]byte, error) {
+	s = strings.TrimRight(s, "=")
+	if strings.ContainsAny(s, "+/") {
+		return base64.RawStdEncoding.DecodeString(s)
+	}
+	return base64.RawURLEncoding.DecodeString(s)
+}
+
+// NewWebAuthnActivation is a helper method to create a new FactorActivationRequest activating a
+// webauthn factor, from the attestationObject and clientDataJSON of the credential created by the
+// browser.
+func NewWebAuthnActivation(attestationObject []byte, clientDataJSON []byte) *FactorActivationRequest {
+	return &FactorActivationRequest{
+		Attestation: EncodeWebAuthn(attestationObject),
+		ClientData:  EncodeWebAuthn(clientDataJSON),
+	}
+}
+
+// NewWebAuthnAssertion is a helper method to create a new FactorVerifyRequest verifying a
+// webauthn factor, from the clientDataJSON, authenticatorData and signature of the assertion
+// returned by the browser.
+func NewWebAuthnAssertion(clientDataJSON []byte, authenticatorData []byte, signature []byte) *FactorVerifyRequest {
+	return &FactorVerifyRequest{
+		ClientData:        EncodeWebAuthn(clientDataJSON),
+		AuthenticatorData: EncodeWebAuthn(authenticatorData),
+		SignatureData:     EncodeWebAuthn(signature),
+	}
+}
+
+// WebAuthnClientData represents the clientDataJSON of a WebAuthn credential or assertion.
+//
+// https://www.w3.org/TR/webauthn-2/#dictionary-client-data
+type WebAuthnClientData struct {
+	// Type has possible values of: webauthn.create, webauthn.get
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// CheckWebAuthnClientData decodes clientDataJSON and checks that it's of type typ, and answers
+// challenge, as returned by Okta. It catches a stale or mixed up challenge before Okta rejects the
+// activation or verification with a less specific error.
+func CheckWebAuthnClientData(clientDataJSON []byte, typ string, challenge string) (*WebAuthnClientData, error) {
+	clientData := new(WebAuthnClientData)
+	if err := json.Unmarshal(clientDataJSON, clientData); err != nil {
+		return nil, fmt.Errorf("decoding the client data: %v", err)
+	}
+	if clientData.Type != typ {
+		return nil, fmt.Errorf("client data is of type %q, not %q", clientData.Type, typ)
+	}
+
+	want, err := DecodeWebAuthn(challenge)
+	if err != nil {
+		return nil, fmt.Errorf("decoding the challenge: %v", err)
+	}
+	got, err := DecodeWebAuthn(clientData.Challenge)
+	if err != nil || string(got) != string(want) {
+		return nil, errors.New("client data doesn't answer the challenge")
+	}
+	return clientData, nil
+}
+
+// EnrollWebAuthn enrolls a webauthn factor for a user. The returned factor is pending activation,
+// with the credential creation options to pass to the browser in Embedded.Activation; activate it
+// with ActivateWebAuthn().
+//
+// https://developer.okta.com/docs/reference/api/factors/#enroll-webauthn-factor
+func (s *FactorsService) EnrollWebAuthn(ctx context.Context, userID string) (*Factor, *Response, error) {
+	return s.Enroll(ctx, userID, &Factor{FactorType: FactorTypeWebAuthn, Provider: FactorProviderFIDO}, nil)
+}
+
+// ActivateWebAuthn activates a webauthn factor with the attestationObject and clientDataJSON of
+// the credential created by the browser.
+//
+// https://developer.okta.com/docs/reference/api/factors/#activate-webauthn-factor
+func (s *FactorsService) ActivateWebAuthn(ctx context.Context, userID string, factorID string, attestationObject []byte, clientDataJSON []byte) (*Factor, *Response, error) {
+	return s.Activate(ctx, userID, factorID, NewWebAuthnActivation(attestationObject, clientDataJSON))
+}
+
+// ChallengeWebAuthn starts the verification of a webauthn factor, and returns the challenge to
+// pass to the browser; answer it with VerifyWebAuthn().
+//
+// https://developer.okta.com/docs/reference/api/factors/#verify-webauthn-factor
+func (s *FactorsService) ChallengeWebAuthn(ctx context.Context, userID string, factorID string) (*WebAuthnChallenge, *Response, error) {
+	verification, resp, err := s.Verify(ctx, userID, factorID, new(FactorVerifyRequest))
+	if err != nil {
+		return nil, resp, err
+	}
+	if verification.Embedded == nil || verification.Embedded.Challenge == nil {
+		return nil, resp, fmt.Errorf("factor verification returned %s without a challenge", verification.FactorResult)
+	}
+
+	return verification.Embedded.Challenge, resp, nil
+}
+
+// VerifyWebAuthn verifies a webauthn factor with the clientDataJSON, authenticatorData and
+// signature of the assertion returned by the browser for the challenge of ChallengeWebAuthn().
+//
+// https://developer.okta.com/docs/reference/api/factors/#verify-webauthn-factor
+func (s *FactorsService) VerifyWebAuthn(ctx context.Context, userID string, factorID string, clientDataJSON []byte, authenticatorData []byte, signature []byte) (*FactorVerification, *Response, error) {
+	return s.Verify(ctx, userID, factorID, NewWebAuthnAssertion(clientDataJSON, authenticatorData, signature))
+}